@@ -0,0 +1,744 @@
+package css
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/tdewolff/parse/v2"
+)
+
+// CalcNodeType determines the type of a node in a math expression.
+type CalcNodeType int
+
+// CalcNodeType values.
+const (
+	CalcValueNode    CalcNodeType = iota // number, dimension, or percentage
+	CalcSumNode                          // sum of the children
+	CalcProductNode                      // product of the children
+	CalcNegateNode                       // negation of the child
+	CalcInvertNode                       // reciprocal of the child
+	CalcFunctionNode                     // calc(), min(), max(), or clamp() with its arguments as children
+	CalcVarNode                          // var(), env(), or attr() whose value is unknown
+)
+
+func (t CalcNodeType) String() string {
+	switch t {
+	case CalcValueNode:
+		return "Value"
+	case CalcSumNode:
+		return "Sum"
+	case CalcProductNode:
+		return "Product"
+	case CalcNegateNode:
+		return "Negate"
+	case CalcInvertNode:
+		return "Invert"
+	case CalcFunctionNode:
+		return "Function"
+	case CalcVarNode:
+		return "Var"
+	}
+	return "Invalid(" + strconv.Itoa(int(t)) + ")"
+}
+
+// CalcNode is a node of a math expression such as calc(100% - 2*10px), see https://www.w3.org/TR/css-values-4/#calc-func. Subtraction and division are represented as the sum with a negation and the product with a reciprocal respectively, and parentheses are implied by the tree.
+type CalcNode struct {
+	Type     CalcNodeType
+	Num      float64     // for CalcValueNode
+	Unit     []byte      // lowercase unit for CalcValueNode, which is % for percentages and nil for numbers
+	Name     []byte      // lowercase function name for CalcFunctionNode
+	Children []*CalcNode // operands or arguments
+	Tokens   []Token     // for CalcVarNode, including the function and closing parenthesis
+}
+
+// CalcBaseType is a base type of the CSS type system.
+type CalcBaseType int
+
+// CalcBaseType values.
+const (
+	CalcLength CalcBaseType = iota
+	CalcAngle
+	CalcTime
+	CalcFrequency
+	CalcResolution
+	CalcFlex
+	CalcPercent
+)
+
+var calcBaseTypeNames = []string{"length", "angle", "time", "frequency", "resolution", "flex", "percentage"}
+
+func (t CalcBaseType) String() string {
+	if 0 <= t && int(t) < len(calcBaseTypeNames) {
+		return calcBaseTypeNames[t]
+	}
+	return "Invalid(" + strconv.Itoa(int(t)) + ")"
+}
+
+// CalcType is the type of a math expression as the exponent of each base type, such that numbers have all zero exponents, lengths have a CalcLength exponent of one, and the product of two lengths has a CalcLength exponent of two, see https://www.w3.org/TR/css-values-4/#css-type.
+type CalcType [CalcPercent + 1]int
+
+// String returns the type as base types with their exponents, such as length or length^2*time^-1.
+func (t CalcType) String() string {
+	var b []byte
+	for i, exp := range t {
+		if exp != 0 {
+			if b != nil {
+				b = append(b, '*')
+			}
+			b = append(b, calcBaseTypeNames[i]...)
+			if exp != 1 {
+				b = append(b, '^')
+				b = strconv.AppendInt(b, int64(exp), 10)
+			}
+		}
+	}
+	if b == nil {
+		return "number"
+	}
+	return string(b)
+}
+
+// Base returns the base type of a type that is a single base type with exponent one. It returns false for numbers and for products of types.
+func (t CalcType) Base() (CalcBaseType, bool) {
+	base, ok := CalcBaseType(0), false
+	for i, exp := range t {
+		if exp == 1 && !ok {
+			base, ok = CalcBaseType(i), true
+		} else if exp != 0 {
+			return 0, false
+		}
+	}
+	return base, ok
+}
+
+// add returns the type of the sum of two types, where a percentage is resolved against the other type as in calc(100% - 10px).
+func (t CalcType) add(u CalcType) (CalcType, bool) {
+	if t == u {
+		return t, true
+	}
+	for _, pair := range [2][2]CalcType{{t, u}, {u, t}} {
+		p, o := pair[0], pair[1]
+		if p[CalcPercent] == 0 || o[CalcPercent] != 0 {
+			continue
+		}
+		for i := CalcLength; i < CalcPercent; i++ {
+			if o[i] != 0 {
+				q := p
+				q[i] += q[CalcPercent]
+				q[CalcPercent] = 0
+				if q == o {
+					return o, true
+				}
+			}
+		}
+	}
+	return t, false
+}
+
+type calcUnit struct {
+	base   CalcBaseType
+	factor float64 // conversion factor to the canonical unit of the base type, or zero for relative units
+}
+
+// calcUnits are the units of dimensions and their conversion to the canonical units px, deg, s, hz, and dppx, see https://www.w3.org/TR/css-values-4/#numeric-types.
+var calcUnits = map[string]calcUnit{
+	"%":     {CalcPercent, 1.0},
+	"px":    {CalcLength, 1.0},
+	"cm":    {CalcLength, 96.0 / 2.54},
+	"mm":    {CalcLength, 96.0 / 25.4},
+	"q":     {CalcLength, 96.0 / 101.6},
+	"in":    {CalcLength, 96.0},
+	"pt":    {CalcLength, 96.0 / 72.0},
+	"pc":    {CalcLength, 16.0},
+	"em":    {CalcLength, 0.0},
+	"rem":   {CalcLength, 0.0},
+	"ex":    {CalcLength, 0.0},
+	"rex":   {CalcLength, 0.0},
+	"cap":   {CalcLength, 0.0},
+	"rcap":  {CalcLength, 0.0},
+	"ch":    {CalcLength, 0.0},
+	"rch":   {CalcLength, 0.0},
+	"ic":    {CalcLength, 0.0},
+	"ric":   {CalcLength, 0.0},
+	"lh":    {CalcLength, 0.0},
+	"rlh":   {CalcLength, 0.0},
+	"vw":    {CalcLength, 0.0},
+	"vh":    {CalcLength, 0.0},
+	"vi":    {CalcLength, 0.0},
+	"vb":    {CalcLength, 0.0},
+	"vmin":  {CalcLength, 0.0},
+	"vmax":  {CalcLength, 0.0},
+	"svw":   {CalcLength, 0.0},
+	"svh":   {CalcLength, 0.0},
+	"svi":   {CalcLength, 0.0},
+	"svb":   {CalcLength, 0.0},
+	"svmin": {CalcLength, 0.0},
+	"svmax": {CalcLength, 0.0},
+	"lvw":   {CalcLength, 0.0},
+	"lvh":   {CalcLength, 0.0},
+	"lvi":   {CalcLength, 0.0},
+	"lvb":   {CalcLength, 0.0},
+	"lvmin": {CalcLength, 0.0},
+	"lvmax": {CalcLength, 0.0},
+	"dvw":   {CalcLength, 0.0},
+	"dvh":   {CalcLength, 0.0},
+	"dvi":   {CalcLength, 0.0},
+	"dvb":   {CalcLength, 0.0},
+	"dvmin": {CalcLength, 0.0},
+	"dvmax": {CalcLength, 0.0},
+	"cqw":   {CalcLength, 0.0},
+	"cqh":   {CalcLength, 0.0},
+	"cqi":   {CalcLength, 0.0},
+	"cqb":   {CalcLength, 0.0},
+	"cqmin": {CalcLength, 0.0},
+	"cqmax": {CalcLength, 0.0},
+	"deg":   {CalcAngle, 1.0},
+	"grad":  {CalcAngle, 0.9},
+	"rad":   {CalcAngle, 180.0 / math.Pi},
+	"turn":  {CalcAngle, 360.0},
+	"s":     {CalcTime, 1.0},
+	"ms":    {CalcTime, 0.001},
+	"hz":    {CalcFrequency, 1.0},
+	"khz":   {CalcFrequency, 1000.0},
+	"dppx":  {CalcResolution, 1.0},
+	"x":     {CalcResolution, 1.0},
+	"dpi":   {CalcResolution, 1.0 / 96.0},
+	"dpcm":  {CalcResolution, 2.54 / 96.0},
+	"fr":    {CalcFlex, 0.0},
+}
+
+var calcCanonicalUnits = [][]byte{[]byte("px"), []byte("deg"), []byte("s"), []byte("hz"), []byte("dppx"), nil, []byte("%")}
+
+// convertUnits returns the factors to convert values in units a and b to a common unit, which is the unit itself if they are equal or the canonical unit otherwise. It returns false if the units are incompatible or relative.
+func convertUnits(a, b []byte) (float64, float64, []byte, bool) {
+	if bytes.Equal(a, b) {
+		return 1.0, 1.0, a, true
+	}
+	ua, okA := calcUnits[string(a)]
+	ub, okB := calcUnits[string(b)]
+	if !okA || !okB || ua.base != ub.base || ua.factor == 0.0 || ub.factor == 0.0 {
+		return 0.0, 0.0, nil, false
+	}
+	return ua.factor, ub.factor, calcCanonicalUnits[ua.base], true
+}
+
+////////////////////////////////////////////////////////////////
+
+// ParseCalc parses a math function, which is one of calc(), min(), max(), and clamp(). Whitespace around the function is ignored. It returns an error when the expression is invalid or when its types are incompatible, such as when adding a length to a time, where var(), env(), and attr() are assumed to be of the right type.
+func ParseCalc(tokens []Token) (*CalcNode, error) {
+	p := &tokenParser{tokens: tokens}
+	p.skipWhitespace()
+	var n *CalcNode
+	if t := p.peek(0); t.TokenType == FunctionToken && isMathFunction(t.Data) {
+		n = p.parseCalcFunction()
+	} else {
+		p.fail("math function")
+	}
+	p.skipWhitespace()
+	if p.err == nil && p.i < len(p.tokens) {
+		p.fail("math function")
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	t, err := n.ValueType()
+	if err != nil {
+		return nil, err
+	} else if _, ok := t.Base(); !ok && t != (CalcType{}) {
+		return nil, fmt.Errorf("CSS type error: invalid type %s of %s()", t, n.Name)
+	}
+	return n, nil
+}
+
+func isMathFunction(fun []byte) bool {
+	return parse.EqualFold(fun, []byte("calc(")) || parse.EqualFold(fun, []byte("min(")) || parse.EqualFold(fun, []byte("max(")) || parse.EqualFold(fun, []byte("clamp("))
+}
+
+func (p *tokenParser) parseCalcFunction() *CalcNode {
+	fun := p.peek(0).Data
+	n := &CalcNode{Type: CalcFunctionNode, Name: parse.ToLower(parse.Copy(fun[:len(fun)-1]))}
+	in := string(n.Name) + "()"
+	p.i++
+	for {
+		p.skipWhitespace()
+		arg := p.parseCalcSum(in)
+		if arg == nil {
+			return nil
+		}
+		n.Children = append(n.Children, arg)
+		p.skipWhitespace()
+		if t := p.peek(0); t.TokenType == RightParenthesisToken {
+			p.i++
+			break
+		} else if t.TokenType != CommaToken || bytes.Equal(n.Name, []byte("calc")) {
+			p.fail(in)
+			return nil
+		}
+		p.i++
+	}
+	if bytes.Equal(n.Name, []byte("clamp")) && len(n.Children) != 3 {
+		p.err = fmt.Errorf("CSS parse error: clamp() requires three arguments")
+		return nil
+	}
+	return n
+}
+
+func (p *tokenParser) parseCalcSum(in string) *CalcNode {
+	n := p.parseCalcProduct(in)
+	if n == nil {
+		return nil
+	}
+	children := []*CalcNode{n}
+	for {
+		i := p.i
+		if !p.skipWhitespace() {
+			break
+		}
+		t := p.peek(0)
+		if !p.isDelim(t, '+') && !p.isDelim(t, '-') {
+			p.i = i
+			break
+		}
+		p.i++
+		if !p.skipWhitespace() {
+			p.fail(in) // + and - require whitespace on both sides
+			return nil
+		}
+		operand := p.parseCalcProduct(in)
+		if operand == nil {
+			return nil
+		} else if t.Data[0] == '-' {
+			operand = &CalcNode{Type: CalcNegateNode, Children: []*CalcNode{operand}}
+		}
+		children = append(children, operand)
+	}
+	if len(children) == 1 {
+		return n
+	}
+	return &CalcNode{Type: CalcSumNode, Children: children}
+}
+
+func (p *tokenParser) parseCalcProduct(in string) *CalcNode {
+	n := p.parseCalcValue(in)
+	if n == nil {
+		return nil
+	}
+	children := []*CalcNode{n}
+	for {
+		i := p.i
+		p.skipWhitespace()
+		t := p.peek(0)
+		if !p.isDelim(t, '*') && !p.isDelim(t, '/') {
+			p.i = i
+			break
+		}
+		p.i++
+		p.skipWhitespace()
+		operand := p.parseCalcValue(in)
+		if operand == nil {
+			return nil
+		} else if t.Data[0] == '/' {
+			operand = &CalcNode{Type: CalcInvertNode, Children: []*CalcNode{operand}}
+		}
+		children = append(children, operand)
+	}
+	if len(children) == 1 {
+		return n
+	}
+	return &CalcNode{Type: CalcProductNode, Children: children}
+}
+
+func (p *tokenParser) parseCalcValue(in string) *CalcNode {
+	t := p.peek(0)
+	switch t.TokenType {
+	case NumberToken, PercentageToken, DimensionToken:
+		n, _ := parse.Dimension(t.Data)
+		num, err := strconv.ParseFloat(string(t.Data[:n]), 64)
+		if err != nil {
+			break
+		}
+		value := &CalcNode{Type: CalcValueNode, Num: num}
+		if n < len(t.Data) {
+			value.Unit = parse.ToLower(parse.Copy(t.Data[n:]))
+			if _, ok := calcUnits[string(value.Unit)]; !ok {
+				break
+			}
+		}
+		p.i++
+		return value
+	case IdentToken:
+		var num float64
+		switch string(parse.ToLower(parse.Copy(t.Data))) {
+		case "e":
+			num = math.E
+		case "pi":
+			num = math.Pi
+		case "infinity":
+			num = math.Inf(1)
+		case "-infinity":
+			num = math.Inf(-1)
+		case "nan":
+			num = math.NaN()
+		default:
+			p.fail(in)
+			return nil
+		}
+		p.i++
+		return &CalcNode{Type: CalcValueNode, Num: num}
+	case LeftParenthesisToken:
+		p.i++
+		p.skipWhitespace()
+		n := p.parseCalcSum(in)
+		if n == nil {
+			return nil
+		}
+		p.skipWhitespace()
+		if p.peek(0).TokenType != RightParenthesisToken {
+			p.fail(in)
+			return nil
+		}
+		p.i++
+		return n
+	case FunctionToken:
+		if isMathFunction(t.Data) {
+			return p.parseCalcFunction()
+		} else if parse.EqualFold(t.Data, []byte("var(")) || parse.EqualFold(t.Data, []byte("env(")) || parse.EqualFold(t.Data, []byte("attr(")) {
+			start := p.i
+			level := 0
+			for ; p.i < len(p.tokens); p.i++ {
+				switch p.tokens[p.i].TokenType {
+				case FunctionToken, LeftParenthesisToken:
+					level++
+				case RightParenthesisToken:
+					level--
+				}
+				if level == 0 {
+					p.i++
+					return &CalcNode{Type: CalcVarNode, Tokens: copyTokens(p.tokens[start:p.i])}
+				}
+			}
+		}
+	}
+	p.fail(in)
+	return nil
+}
+
+////////////////////////////////////////////////////////////////
+
+// ValueType returns the type of the expression, or an error when the types of a sum or of the arguments of a function are incompatible. The values of var(), env(), and attr() are assumed to be numbers in products and to be of the right type otherwise.
+func (n *CalcNode) ValueType() (CalcType, error) {
+	t, _, err := n.valueType()
+	return t, err
+}
+
+// valueType returns the type of the expression and whether it matches any type, which is the case for var(), env(), and attr().
+func (n *CalcNode) valueType() (CalcType, bool, error) {
+	switch n.Type {
+	case CalcValueNode:
+		t := CalcType{}
+		if n.Unit != nil {
+			unit, ok := calcUnits[string(n.Unit)]
+			if !ok {
+				return t, false, fmt.Errorf("CSS type error: unknown unit %s", n.Unit)
+			}
+			t[unit.base] = 1
+		}
+		return t, false, nil
+	case CalcNegateNode, CalcInvertNode:
+		t, any, err := n.Children[0].valueType()
+		if n.Type == CalcInvertNode {
+			for i := range t {
+				t[i] = -t[i]
+			}
+		}
+		return t, any, err
+	case CalcProductNode:
+		t := CalcType{}
+		for _, child := range n.Children {
+			u, _, err := child.valueType()
+			if err != nil {
+				return t, false, err
+			}
+			for i := range t {
+				t[i] += u[i]
+			}
+		}
+		return t, false, nil
+	case CalcSumNode, CalcFunctionNode:
+		t, any := CalcType{}, true
+		for _, child := range n.Children {
+			u, anyU, err := child.valueType()
+			if err != nil {
+				return t, false, err
+			} else if anyU {
+				continue
+			} else if any {
+				t, any = u, false
+			} else if v, ok := t.add(u); ok {
+				t = v
+			} else if n.Type == CalcSumNode {
+				return t, false, fmt.Errorf("CSS type error: cannot add %s and %s", t, u)
+			} else {
+				return t, false, fmt.Errorf("CSS type error: incompatible arguments %s and %s in %s()", t, u, n.Name)
+			}
+		}
+		return t, any, nil
+	}
+	return CalcType{}, true, nil
+}
+
+////////////////////////////////////////////////////////////////
+
+// Simplify returns a simplified copy of the expression, see https://www.w3.org/TR/css-values-4/#calc-simplification. Values with the same unit or with units that convert into each other are combined, also across nested sums and their negations, where the latter are converted to the canonical unit such as px, and min(), max(), and clamp() are resolved when their arguments can be compared. The result is a single value if the expression could be fully evaluated, such as 12px for calc(10px + 2px), or a math function otherwise.
+func (n *CalcNode) Simplify() *CalcNode {
+	s := n.simplify()
+	if s.Type == CalcFunctionNode || s.Type == CalcValueNode && !math.IsInf(s.Num, 0) && !math.IsNaN(s.Num) {
+		return s
+	}
+	return &CalcNode{Type: CalcFunctionNode, Name: []byte("calc"), Children: []*CalcNode{s}}
+}
+
+func (n *CalcNode) simplify() *CalcNode {
+	switch n.Type {
+	case CalcValueNode, CalcVarNode:
+		c := *n
+		return &c
+	case CalcNegateNode:
+		return n.Children[0].simplify().negate()
+	case CalcInvertNode:
+		child := n.Children[0].simplify()
+		if child.Type == CalcValueNode && child.Unit == nil {
+			child.Num = 1.0 / child.Num
+			return child
+		} else if child.Type == CalcInvertNode {
+			return child.Children[0]
+		}
+		return &CalcNode{Type: CalcInvertNode, Children: []*CalcNode{child}}
+	case CalcSumNode:
+		children := []*CalcNode{}
+		for _, child := range n.flatten() {
+			if child.Type == CalcValueNode {
+				if i := findCompatible(children, child); i != -1 {
+					fa, fb, unit, _ := convertUnits(children[i].Unit, child.Unit)
+					children[i].Num = children[i].Num*fa + child.Num*fb
+					children[i].Unit = unit
+					continue
+				}
+			}
+			children = append(children, child)
+		}
+		if len(children) == 1 {
+			return children[0]
+		}
+		return &CalcNode{Type: CalcSumNode, Children: children}
+	case CalcProductNode:
+		num := 1.0
+		dims, others := []*CalcNode{}, []*CalcNode{}
+		for _, child := range n.flatten() {
+			if child.Type == CalcValueNode && child.Unit == nil {
+				num *= child.Num
+			} else if child.Type == CalcValueNode || child.Type == CalcInvertNode && child.Children[0].Type == CalcValueNode {
+				dims = append(dims, child)
+			} else {
+				others = append(others, child)
+			}
+		}
+		if len(dims) == 1 && dims[0].Type == CalcValueNode {
+			dims[0].Num *= num
+			num = 1.0
+		} else if len(dims) == 2 && (dims[0].Type == CalcInvertNode) != (dims[1].Type == CalcInvertNode) {
+			a, b := dims[0], dims[1]
+			if a.Type == CalcInvertNode {
+				a, b = b, a
+			}
+			b = b.Children[0]
+			if fa, fb, _, ok := convertUnits(a.Unit, b.Unit); ok {
+				num *= a.Num * fa / (b.Num * fb)
+				dims = dims[:0]
+			}
+		}
+		children := []*CalcNode{}
+		if num != 1.0 || len(dims) == 0 && len(others) == 0 {
+			children = append(children, &CalcNode{Type: CalcValueNode, Num: num})
+		}
+		children = append(append(children, dims...), others...)
+		if len(children) == 1 {
+			return children[0]
+		}
+		return &CalcNode{Type: CalcProductNode, Children: children}
+	case CalcFunctionNode:
+		args := make([]*CalcNode, len(n.Children))
+		for i, child := range n.Children {
+			args[i] = child.simplify()
+		}
+		switch string(n.Name) {
+		case "calc":
+			return args[0]
+		case "min", "max":
+			if i, ok := compareValues(args, bytes.Equal(n.Name, []byte("max"))); ok {
+				return args[i]
+			}
+		case "clamp":
+			// clamp(a, b, c) is max(a, min(b, c))
+			if i, ok := compareValues(args[1:], false); ok {
+				upper := args[1+i]
+				if j, ok := compareValues([]*CalcNode{args[0], upper}, true); ok && j == 0 {
+					return args[0]
+				} else if ok {
+					return upper
+				}
+			}
+		}
+		return &CalcNode{Type: CalcFunctionNode, Name: n.Name, Children: args}
+	}
+	return n
+}
+
+// negate returns the negation of a simplified node.
+func (n *CalcNode) negate() *CalcNode {
+	if n.Type == CalcValueNode {
+		n.Num = -n.Num
+		return n
+	} else if n.Type == CalcNegateNode {
+		return n.Children[0]
+	}
+	return &CalcNode{Type: CalcNegateNode, Children: []*CalcNode{n}}
+}
+
+// flatten returns the simplified children of a sum or product, where the children of nested sums or products respectively are included. The negation of a nested sum is distributed over its children, as in 1em - (2em - 10px).
+func (n *CalcNode) flatten() []*CalcNode {
+	children := []*CalcNode{}
+	for _, child := range n.Children {
+		if child = child.simplify(); child.Type == n.Type {
+			children = append(children, child.Children...)
+		} else if n.Type == CalcSumNode && child.Type == CalcNegateNode && child.Children[0].Type == CalcSumNode {
+			for _, grandchild := range child.Children[0].Children {
+				children = append(children, grandchild.negate())
+			}
+		} else {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// findCompatible returns the index of the value in nodes whose unit converts into the unit of value, or -1 if there is none.
+func findCompatible(nodes []*CalcNode, value *CalcNode) int {
+	for i, n := range nodes {
+		if n.Type == CalcValueNode {
+			if _, _, _, ok := convertUnits(n.Unit, value.Unit); ok {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// compareValues returns the index of the smallest or largest value, or false if not all nodes are values with compatible units.
+func compareValues(nodes []*CalcNode, largest bool) (int, bool) {
+	k := 0
+	for i, n := range nodes {
+		if n.Type != CalcValueNode {
+			return 0, false
+		}
+		fa, fb, _, ok := convertUnits(nodes[k].Unit, n.Unit)
+		if !ok {
+			return 0, false
+		} else if largest && nodes[k].Num*fa < n.Num*fb || !largest && n.Num*fb < nodes[k].Num*fa {
+			k = i
+		}
+	}
+	return k, true
+}
+
+////////////////////////////////////////////////////////////////
+
+// String returns the CSS representation of the expression.
+func (n *CalcNode) String() string {
+	return string(n.appendCSS(nil))
+}
+
+func (n *CalcNode) appendCSS(b []byte) []byte {
+	switch n.Type {
+	case CalcValueNode:
+		switch {
+		case math.IsNaN(n.Num):
+			b = append(b, "NaN"...)
+		case math.IsInf(n.Num, 1):
+			b = append(b, "infinity"...)
+		case math.IsInf(n.Num, -1):
+			b = append(b, "-infinity"...)
+		default:
+			b = appendNumber(b, n.Num, 6)
+			return append(b, n.Unit...)
+		}
+		if n.Unit != nil {
+			b = append(b, "*1"...)
+			b = append(b, n.Unit...)
+		}
+	case CalcSumNode:
+		for i, child := range n.Children {
+			if i != 0 {
+				if child.Type == CalcNegateNode {
+					b = append(b, " - "...)
+					b = child.Children[0].appendOperand(b, false)
+					continue
+				} else if child.Type == CalcValueNode && child.Num < 0.0 {
+					b = append(b, " - "...)
+					b = (&CalcNode{Type: CalcValueNode, Num: -child.Num, Unit: child.Unit}).appendCSS(b)
+					continue
+				}
+				b = append(b, " + "...)
+			}
+			b = child.appendCSS(b)
+		}
+	case CalcProductNode:
+		for i, child := range n.Children {
+			if i != 0 {
+				if child.Type == CalcInvertNode {
+					b = append(b, '/')
+					b = child.Children[0].appendOperand(b, true)
+					continue
+				}
+				b = append(b, '*')
+			}
+			b = child.appendOperand(b, false)
+		}
+	case CalcNegateNode:
+		b = append(b, "-1*"...)
+		b = n.Children[0].appendOperand(b, false)
+	case CalcInvertNode:
+		b = append(b, "1/"...)
+		b = n.Children[0].appendOperand(b, true)
+	case CalcFunctionNode:
+		b = append(b, n.Name...)
+		b = append(b, '(')
+		for i, child := range n.Children {
+			if i != 0 {
+				b = append(b, ',')
+			}
+			b = child.appendCSS(b)
+		}
+		b = append(b, ')')
+	case CalcVarNode:
+		b = appendTokens(b, n.Tokens)
+	}
+	return b
+}
+
+// appendOperand appends the node as an operand of a product, with parentheses around sums and, for divisors, around products.
+func (n *CalcNode) appendOperand(b []byte, divisor bool) []byte {
+	group := n.Type == CalcSumNode
+	if divisor {
+		group = group || n.Type == CalcProductNode || n.Type == CalcNegateNode || n.Type == CalcInvertNode || n.Type == CalcValueNode && n.Unit != nil && (math.IsInf(n.Num, 0) || math.IsNaN(n.Num))
+	}
+	if !group {
+		return n.appendCSS(b)
+	}
+	b = append(b, '(')
+	b = n.appendCSS(b)
+	return append(b, ')')
+}
@@ -0,0 +1,137 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/tdewolff/test"
+)
+
+func TestParseCalc(t *testing.T) {
+	var tests = []struct {
+		calc       string
+		expected   string
+		simplified string
+		typ        string
+	}{
+		{"calc(10px + 2px)", "calc(10px + 2px)", "12px", "length"},
+		{"calc(10px - 2px)", "calc(10px - 2px)", "8px", "length"},
+		{"CALC( 1in + 4PX )", "calc(1in + 4px)", "100px", "length"},
+		{"calc(1s + 500ms)", "calc(1s + 500ms)", "1.5s", "time"},
+		{"calc(100% - 10px)", "calc(100% - 10px)", "calc(100% - 10px)", "length"},
+		{"calc(100% - 10px - 20%)", "calc(100% - 10px - 20%)", "calc(80% - 10px)", "length"},
+		{"calc(1em + 2rem + 3em)", "calc(1em + 2rem + 3em)", "calc(4em + 2rem)", "length"},
+		{"calc(2 * 10px)", "calc(2*10px)", "20px", "length"},
+		{"calc(10px * 2 / 4)", "calc(10px*2/4)", "5px", "length"},
+		{"calc(10px / 2px)", "calc(10px/2px)", "5", "number"},
+		{"calc(1in / 48px)", "calc(1in/48px)", "2", "number"},
+		{"calc(1 / 2px * 1px)", "calc(1/2px*1px)", ".5", "number"},
+		{"calc(1 / 2px * 1in)", "calc(1/2px*1in)", "48", "number"},
+		{"calc(1 / 3)", "calc(1/3)", ".333333", "number"},
+		{"calc(2 * (10px + 1em))", "calc(2*(10px + 1em))", "calc(2*(10px + 1em))", "length"},
+		{"calc(1em - (10px + 2px))", "calc(1em - (10px + 2px))", "calc(1em - 12px)", "length"},
+		{"calc(1em - (2em - 10px))", "calc(1em - (2em - 10px))", "calc(-1em + 10px)", "length"},
+		{"calc(100% - (50% - 10px))", "calc(100% - (50% - 10px))", "calc(50% + 10px)", "length"},
+		{"calc(10px - (2px + 1em))", "calc(10px - (2px + 1em))", "calc(8px - 1em)", "length"},
+		{"calc((10px))", "calc(10px)", "10px", "length"},
+		{"calc(calc(10px) * 2)", "calc(calc(10px)*2)", "20px", "length"},
+		{"calc(1em / 2)", "calc(1em/2)", ".5em", "length"},
+		{"calc(90deg + 1turn)", "calc(90deg + 1turn)", "450deg", "angle"},
+		{"calc(pi * 1rad)", "calc(3.141593*1rad)", "3.141593rad", "angle"},
+		{"calc(1px / 0)", "calc(1px/0)", "calc(infinity*1px)", "length"},
+		{"calc(-infinity)", "calc(-infinity)", "calc(-infinity)", "number"},
+		{"calc(var(--x) + 10px)", "calc(var(--x) + 10px)", "calc(var(--x) + 10px)", "length"},
+		{"calc(var(--x, 1px) * 2)", "calc(var(--x, 1px)*2)", "calc(2*var(--x, 1px))", "number"},
+		{"min(10px, 2em)", "min(10px,2em)", "min(10px,2em)", "length"},
+		{"min(10px, 1in, 50px)", "min(10px,1in,50px)", "10px", "length"},
+		{"max(10px, 1in, 50px)", "max(10px,1in,50px)", "1in", "length"},
+		{"max(10px + 5px, 12px)", "max(10px + 5px,12px)", "15px", "length"},
+		{"min(100%, 500px)", "min(100%,500px)", "min(100%,500px)", "length"},
+		{"clamp(1rem, 2.5vw, 2rem)", "clamp(1rem,2.5vw,2rem)", "clamp(1rem,2.5vw,2rem)", "length"},
+		{"clamp(10px, 50px, 20px)", "clamp(10px,50px,20px)", "20px", "length"},
+		{"clamp(10px, 5px, 20px)", "clamp(10px,5px,20px)", "10px", "length"},
+		{"clamp(10px, 15px, 20px)", "clamp(10px,15px,20px)", "15px", "length"},
+		{"calc(min(10px, 20px) + 5px)", "calc(min(10px,20px) + 5px)", "15px", "length"},
+		{"calc(50%)", "calc(50%)", "50%", "percentage"},
+		{"calc(3)", "calc(3)", "3", "number"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.calc, func(t *testing.T) {
+			n, err := ParseCalc(lexSelector(tt.calc))
+			test.Error(t, err)
+			test.String(t, n.String(), tt.expected)
+			test.String(t, n.Simplify().String(), tt.simplified)
+			typ, err := n.ValueType()
+			test.Error(t, err)
+			test.String(t, typ.String(), tt.typ)
+
+			// simplified expressions have the same type
+			if s := n.Simplify(); s.Type != CalcValueNode || s.Unit != nil {
+				typ2, err := s.ValueType()
+				test.Error(t, err)
+				test.T(t, typ2, typ)
+			}
+		})
+	}
+}
+
+func TestParseCalcError(t *testing.T) {
+	var tests = []struct {
+		calc string
+		err  string
+	}{
+		{"", "CSS parse error: unexpected ending in math function"},
+		{"10px", "CSS parse error: unexpected token '10px' in math function"},
+		{"abs(1)", "CSS parse error: unexpected token 'abs(' in math function"},
+		{"calc(10px) 5", "CSS parse error: unexpected token '5' in math function"},
+		{"calc()", "CSS parse error: unexpected token ')' in calc()"},
+		{"calc(10px", "CSS parse error: unexpected ending in calc()"},
+		{"calc(10px -2px)", "CSS parse error: unexpected token '-2px' in calc()"},
+		{"calc(10px+ 2px)", "CSS parse error: unexpected token '+' in calc()"},
+		{"calc(10px +2px)", "CSS parse error: unexpected token '+2px' in calc()"},
+		{"calc(10px + )", "CSS parse error: unexpected token ')' in calc()"},
+		{"calc(10px, 2px)", "CSS parse error: unexpected token ',' in calc()"},
+		{"calc(10foo)", "CSS parse error: unexpected token '10foo' in calc()"},
+		{"calc(auto)", "CSS parse error: unexpected token 'auto' in calc()"},
+		{"calc((10px)", "CSS parse error: unexpected ending in calc()"},
+		{"calc(var(--x)", "CSS parse error: unexpected ending in calc()"},
+		{"min(10px, )", "CSS parse error: unexpected token ')' in min()"},
+		{"clamp(1px, 2px)", "CSS parse error: clamp() requires three arguments"},
+		{"calc(10px + 1s)", "CSS type error: cannot add length and time"},
+		{"calc(10px + 5)", "CSS type error: cannot add length and number"},
+		{"calc(2 * (1deg - 1px))", "CSS type error: cannot add angle and length"},
+		{"min(10px, 1s)", "CSS type error: incompatible arguments length and time in min()"},
+		{"calc(10px * 10px)", "CSS type error: invalid type length^2 of calc()"},
+		{"calc(2 / 1em)", "CSS type error: invalid type length^-1 of calc()"},
+		{"calc(1s / 1px)", "CSS type error: invalid type length^-1*time of calc()"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.calc, func(t *testing.T) {
+			_, err := ParseCalc(lexSelector(tt.calc))
+			test.That(t, err != nil, "must fail")
+			test.String(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestCalcType(t *testing.T) {
+	length := CalcType{CalcLength: 1}
+	percent := CalcType{CalcPercent: 1}
+	time := CalcType{CalcTime: 1}
+
+	typ, ok := percent.add(length)
+	test.That(t, ok)
+	test.T(t, typ, length)
+	typ, ok = length.add(percent)
+	test.That(t, ok)
+	test.T(t, typ, length)
+	_, ok = length.add(time)
+	test.That(t, !ok)
+
+	base, ok := length.Base()
+	test.That(t, ok)
+	test.T(t, base, CalcLength)
+	_, ok = CalcType{}.Base()
+	test.That(t, !ok)
+	_, ok = CalcType{CalcLength: 1, CalcTime: -1}.Base()
+	test.That(t, !ok)
+}
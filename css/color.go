@@ -0,0 +1,1062 @@
+package css
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/tdewolff/parse/v2"
+)
+
+// ColorSpace is the color space of a color, see https://www.w3.org/TR/css-color-4/.
+type ColorSpace int
+
+// ColorSpace values.
+const (
+	ColorSRGB        ColorSpace = iota // rgb(), hex colors, and named colors
+	ColorSRGBLinear                    // color(srgb-linear)
+	ColorDisplayP3                     // color(display-p3)
+	ColorA98RGB                        // color(a98-rgb)
+	ColorProPhotoRGB                   // color(prophoto-rgb)
+	ColorRec2020                       // color(rec2020)
+	ColorXYZD50                        // color(xyz-d50)
+	ColorXYZD65                        // color(xyz) and color(xyz-d65)
+	ColorLab                           // lab()
+	ColorLCH                           // lch()
+	ColorOKLab                         // oklab()
+	ColorOKLCH                         // oklch()
+	ColorHSL                           // hsl()
+	ColorHWB                           // hwb()
+)
+
+var colorSpaceNames = []string{"srgb", "srgb-linear", "display-p3", "a98-rgb", "prophoto-rgb", "rec2020", "xyz-d50", "xyz-d65", "lab", "lch", "oklab", "oklch", "hsl", "hwb"}
+
+// String returns the CSS name of a color space.
+func (s ColorSpace) String() string {
+	if 0 <= s && int(s) < len(colorSpaceNames) {
+		return colorSpaceNames[s]
+	}
+	return "Invalid(" + strconv.Itoa(int(s)) + ")"
+}
+
+// isRGB returns true for color spaces with an RGB gamut.
+func (s ColorSpace) isRGB() bool {
+	return s <= ColorRec2020 || s == ColorHSL || s == ColorHWB
+}
+
+// Color is a color in a color space. Channels are in the reference ranges of CSS: RGB channels and XYZ are in [0,1], Lab lightness is in [0,100] with a and b around [-125,125], LCH chroma is around [0,150], OKLab lightness is in [0,1] with a and b around [-0.4,0.4], OKLCH chroma is around [0,0.4], HSL saturation and lightness and HWB whiteness and blackness are in [0,100], and hues are in degrees. Missing components (none) are zero.
+type Color struct {
+	Space    ColorSpace
+	Channels [3]float64
+	Alpha    float64 // in [0,1]
+}
+
+// ParseColor parses a color value, which is a hex color, a named color, transparent, or one of the functions rgb(), rgba(), hsl(), hsla(), hwb(), lab(), lch(), oklab(), oklch(), and color(). Whitespace around the color is ignored. Values that depend on the context such as currentcolor and system colors, and math functions such as calc() return an error.
+func ParseColor(tokens []Token) (Color, error) {
+	p := &tokenParser{tokens: tokens}
+	p.skipWhitespace()
+	c := p.parseColor()
+	p.skipWhitespace()
+	if p.err == nil && p.i < len(p.tokens) {
+		p.fail("color")
+	}
+	if p.err != nil {
+		return Color{}, p.err
+	}
+	return c, nil
+}
+
+func (p *tokenParser) parseColor() Color {
+	t := p.peek(0)
+	switch t.TokenType {
+	case HashToken:
+		if c, ok := parseHexColor(t.Data[1:]); ok {
+			p.i++
+			return c
+		}
+	case IdentToken:
+		name := string(parse.ToLower(parse.Copy(t.Data)))
+		if name == "transparent" {
+			p.i++
+			return Color{ColorSRGB, [3]float64{}, 0.0}
+		} else if rgb, ok := namedColors[name]; ok {
+			p.i++
+			return rgbColor(rgb, 0xFF)
+		}
+	case FunctionToken:
+		return p.parseColorFunction()
+	}
+	p.fail("color")
+	return Color{}
+}
+
+func parseHexColor(b []byte) (Color, bool) {
+	if len(b) != 3 && len(b) != 4 && len(b) != 6 && len(b) != 8 {
+		return Color{}, false
+	}
+	var v [4]uint32
+	n := 1 // digits per channel
+	if 6 <= len(b) {
+		n = 2
+	}
+	for i := 0; i*n < len(b); i++ {
+		for j := 0; j < n; j++ {
+			d, ok := hexDigit(b[i*n+j])
+			if !ok {
+				return Color{}, false
+			}
+			v[i] = v[i]<<4 | d
+		}
+		if n == 1 {
+			v[i] *= 0x11
+		}
+	}
+	if len(b) == 3 || len(b) == 6 {
+		v[3] = 0xFF
+	}
+	return rgbColor(v[0]<<16|v[1]<<8|v[2], v[3]), true
+}
+
+func hexDigit(c byte) (uint32, bool) {
+	if '0' <= c && c <= '9' {
+		return uint32(c - '0'), true
+	} else if 'a' <= c && c <= 'f' {
+		return uint32(c-'a') + 10, true
+	} else if 'A' <= c && c <= 'F' {
+		return uint32(c-'A') + 10, true
+	}
+	return 0, false
+}
+
+func rgbColor(rgb, a uint32) Color {
+	return Color{
+		Space:    ColorSRGB,
+		Channels: [3]float64{float64(rgb>>16) / 255.0, float64(rgb>>8&0xFF) / 255.0, float64(rgb&0xFF) / 255.0},
+		Alpha:    float64(a) / 255.0,
+	}
+}
+
+// colorArg is an argument of a color function, where TokenType is NumberToken, PercentageToken, DimensionToken for angles in degrees, or IdentToken for none.
+type colorArg struct {
+	TokenType
+	v float64
+}
+
+// number returns the value of a number or percentage, where 100% equals ref.
+func (a colorArg) number(ref float64) (float64, bool) {
+	switch a.TokenType {
+	case NumberToken, IdentToken:
+		return a.v, true
+	case PercentageToken:
+		return a.v * ref / 100.0, true
+	}
+	return 0.0, false
+}
+
+// hue returns the value of a number or angle in degrees in the range [0,360).
+func (a colorArg) hue() (float64, bool) {
+	if a.TokenType == PercentageToken {
+		return 0.0, false
+	}
+	return normalizeHue(a.v), true
+}
+
+func normalizeHue(h float64) float64 {
+	if h = math.Mod(h, 360.0); h < 0.0 {
+		h += 360.0
+	}
+	return h
+}
+
+func clamp(v, min, max float64) float64 {
+	return math.Max(min, math.Min(max, v))
+}
+
+func (p *tokenParser) parseColorFunction() Color {
+	fun := parse.ToLower(parse.Copy(p.peek(0).Data))
+	name := string(fun[:len(fun)-1])
+	p.i++
+
+	c := Color{Alpha: 1.0}
+	if name == "color" {
+		p.skipWhitespace()
+		t := p.peek(0)
+		if t.TokenType != IdentToken {
+			p.fail("color()")
+			return Color{}
+		}
+		switch string(parse.ToLower(parse.Copy(t.Data))) {
+		case "srgb":
+			c.Space = ColorSRGB
+		case "srgb-linear":
+			c.Space = ColorSRGBLinear
+		case "display-p3":
+			c.Space = ColorDisplayP3
+		case "a98-rgb":
+			c.Space = ColorA98RGB
+		case "prophoto-rgb":
+			c.Space = ColorProPhotoRGB
+		case "rec2020":
+			c.Space = ColorRec2020
+		case "xyz", "xyz-d65":
+			c.Space = ColorXYZD65
+		case "xyz-d50":
+			c.Space = ColorXYZD50
+		default:
+			p.fail("color()")
+			return Color{}
+		}
+		p.i++
+		if !p.skipWhitespace() {
+			p.fail("color()")
+			return Color{}
+		}
+	}
+
+	args, legacy, ok := p.parseColorArgs(name + "()")
+	if !ok {
+		return Color{}
+	}
+	if 3 < len(args) {
+		if c.Alpha, ok = args[3].number(1.0); !ok {
+			p.err = fmt.Errorf("CSS parse error: invalid alpha in %s()", name)
+			return Color{}
+		}
+		c.Alpha = clamp(c.Alpha, 0.0, 1.0)
+	}
+	if legacy {
+		for _, arg := range args {
+			if arg.TokenType == IdentToken {
+				ok = false // none is not allowed in the legacy syntax
+			}
+		}
+	}
+
+	ch := &c.Channels
+	var ok0, ok1, ok2 bool
+	switch name {
+	case "rgb", "rgba":
+		c.Space = ColorSRGB
+		for i := 0; i < 3; i++ {
+			if legacy && args[i].TokenType != args[0].TokenType {
+				ok = false // mixed numbers and percentages
+			}
+		}
+		ch[0], ok0 = args[0].number(255.0)
+		ch[1], ok1 = args[1].number(255.0)
+		ch[2], ok2 = args[2].number(255.0)
+		for i := range ch {
+			ch[i] = clamp(ch[i]/255.0, 0.0, 1.0)
+		}
+	case "hsl", "hsla":
+		c.Space = ColorHSL
+		if legacy && (args[1].TokenType != PercentageToken || args[2].TokenType != PercentageToken) {
+			ok = false
+		}
+		ch[0], ok0 = args[0].hue()
+		ch[1], ok1 = args[1].number(100.0)
+		ch[2], ok2 = args[2].number(100.0)
+		ch[1], ch[2] = math.Max(0.0, ch[1]), clamp(ch[2], 0.0, 100.0)
+	case "hwb":
+		c.Space = ColorHWB
+		ch[0], ok0 = args[0].hue()
+		ch[1], ok1 = args[1].number(100.0)
+		ch[2], ok2 = args[2].number(100.0)
+		ch[1], ch[2] = clamp(ch[1], 0.0, 100.0), clamp(ch[2], 0.0, 100.0)
+	case "lab", "oklab":
+		c.Space = ColorLab
+		ref, abRef := 100.0, 125.0
+		if name == "oklab" {
+			c.Space, ref, abRef = ColorOKLab, 1.0, 0.4
+		}
+		ch[0], ok0 = args[0].number(ref)
+		ch[1], ok1 = args[1].number(abRef)
+		ch[2], ok2 = args[2].number(abRef)
+		ch[0] = clamp(ch[0], 0.0, ref)
+	case "lch", "oklch":
+		c.Space = ColorLCH
+		ref, cRef := 100.0, 150.0
+		if name == "oklch" {
+			c.Space, ref, cRef = ColorOKLCH, 1.0, 0.4
+		}
+		ch[0], ok0 = args[0].number(ref)
+		ch[1], ok1 = args[1].number(cRef)
+		ch[2], ok2 = args[2].hue()
+		ch[0], ch[1] = clamp(ch[0], 0.0, ref), math.Max(0.0, ch[1])
+	case "color":
+		ch[0], ok0 = args[0].number(1.0)
+		ch[1], ok1 = args[1].number(1.0)
+		ch[2], ok2 = args[2].number(1.0)
+	default:
+		p.err = fmt.Errorf("CSS parse error: unknown color function %s()", name)
+		return Color{}
+	}
+	if legacy && (name == "color" || c.Space != ColorSRGB && c.Space != ColorHSL) || !ok || !ok0 || !ok1 || !ok2 {
+		p.err = fmt.Errorf("CSS parse error: invalid arguments in %s()", name)
+		return Color{}
+	}
+	return c
+}
+
+// parseColorArgs parses the arguments of a color function up to and including the closing parenthesis. It returns three channels and an optional alpha, and whether the arguments use the legacy syntax separated by commas instead of the modern syntax separated by whitespace with a slash before the alpha.
+func (p *tokenParser) parseColorArgs(in string) ([]colorArg, bool, bool) {
+	args := []colorArg{}
+	legacy, slash := false, false
+	for {
+		p.skipWhitespace()
+		t := p.peek(0)
+		if t.TokenType == RightParenthesisToken && 3 <= len(args) && (!slash || len(args) == 4) {
+			p.i++
+			return args, legacy, true
+		} else if 0 < len(args) {
+			if t.TokenType == CommaToken {
+				if len(args) == 1 {
+					legacy = true
+				} else if !legacy {
+					break
+				}
+				p.i++
+				p.skipWhitespace()
+				t = p.peek(0)
+			} else if legacy {
+				break
+			} else if p.isDelim(t, '/') {
+				if len(args) != 3 {
+					break
+				}
+				slash = true
+				p.i++
+				p.skipWhitespace()
+				t = p.peek(0)
+			} else if len(args) == 3 {
+				break
+			}
+		}
+		if len(args) == 4 {
+			break
+		}
+
+		arg := colorArg{TokenType: t.TokenType}
+		switch t.TokenType {
+		case NumberToken:
+			arg.v, _ = strconv.ParseFloat(string(t.Data), 64)
+		case PercentageToken:
+			arg.v, _ = strconv.ParseFloat(string(t.Data[:len(t.Data)-1]), 64)
+		case DimensionToken:
+			n, _ := parse.Dimension(t.Data)
+			arg.v, _ = strconv.ParseFloat(string(t.Data[:n]), 64)
+			switch string(parse.ToLower(parse.Copy(t.Data[n:]))) {
+			case "deg":
+			case "grad":
+				arg.v *= 360.0 / 400.0
+			case "rad":
+				arg.v *= 180.0 / math.Pi
+			case "turn":
+				arg.v *= 360.0
+			default:
+				p.fail(in)
+				return nil, false, false
+			}
+		case IdentToken:
+			if !parse.EqualFold(t.Data, []byte("none")) {
+				p.fail(in)
+				return nil, false, false
+			}
+		default:
+			p.fail(in)
+			return nil, false, false
+		}
+		args = append(args, arg)
+		p.i++
+	}
+	p.fail(in)
+	return nil, false, false
+}
+
+////////////////////////////////////////////////////////////////
+
+// To converts the color to another color space. RGB channels outside the gamut of the target space are not clipped, see ToGamut.
+func (c Color) To(space ColorSpace) Color {
+	if c.Space == space {
+		return c
+	}
+	d := Color{Space: space, Alpha: c.Alpha}
+	switch {
+	case c.Space == ColorLab && space == ColorLCH, c.Space == ColorOKLab && space == ColorOKLCH:
+		d.Channels = toPolar(c.Space, c.Channels)
+	case c.Space == ColorLCH && space == ColorLab, c.Space == ColorOKLCH && space == ColorOKLab:
+		d.Channels = fromPolar(c.Channels)
+	case c.Space.isSRGB() && space.isSRGB():
+		d.Channels = fromSRGB(space, c.srgb())
+	default:
+		d.Channels = fromXYZ(space, c.xyz())
+	}
+	return d
+}
+
+// isSRGB returns true for color spaces that are a representation of sRGB.
+func (s ColorSpace) isSRGB() bool {
+	return s == ColorSRGB || s == ColorHSL || s == ColorHWB
+}
+
+// srgb returns the sRGB channels for colors in the ColorSRGB, ColorHSL, or ColorHWB space.
+func (c Color) srgb() [3]float64 {
+	v := c.Channels
+	switch c.Space {
+	case ColorHSL:
+		r, g, b := HSL2RGB(v[0]/360.0, v[1]/100.0, v[2]/100.0)
+		return [3]float64{r, g, b}
+	case ColorHWB:
+		w, b := v[1]/100.0, v[2]/100.0
+		if 1.0 <= w+b {
+			gray := w / (w + b)
+			return [3]float64{gray, gray, gray}
+		}
+		r, g, bl := HSL2RGB(v[0]/360.0, 1.0, 0.5)
+		return [3]float64{r*(1.0-w-b) + w, g*(1.0-w-b) + w, bl*(1.0-w-b) + w}
+	}
+	return v
+}
+
+func fromSRGB(space ColorSpace, rgb [3]float64) [3]float64 {
+	if space == ColorSRGB {
+		return rgb
+	}
+	max := math.Max(rgb[0], math.Max(rgb[1], rgb[2]))
+	min := math.Min(rgb[0], math.Min(rgb[1], rgb[2]))
+	h, d := 0.0, max-min
+	if d != 0.0 {
+		switch max {
+		case rgb[0]:
+			h = (rgb[1] - rgb[2]) / d
+		case rgb[1]:
+			h = (rgb[2]-rgb[0])/d + 2.0
+		default:
+			h = (rgb[0]-rgb[1])/d + 4.0
+		}
+		h = normalizeHue(h * 60.0)
+	}
+	if space == ColorHWB {
+		return [3]float64{h, min * 100.0, (1.0 - max) * 100.0}
+	}
+	s, l := 0.0, (max+min)/2.0
+	if l != 0.0 && l != 1.0 {
+		s = (max - l) / math.Min(l, 1.0-l)
+	}
+	return [3]float64{h, s * 100.0, l * 100.0}
+}
+
+// xyz returns the color in the XYZ space with a D65 white point.
+func (c Color) xyz() [3]float64 {
+	v := c.Channels
+	switch c.Space {
+	case ColorSRGB, ColorHSL, ColorHWB:
+		return mulMatrix(linSRGBToXYZ, mapChannels(c.srgb(), srgbToLinear))
+	case ColorSRGBLinear:
+		return mulMatrix(linSRGBToXYZ, v)
+	case ColorDisplayP3:
+		return mulMatrix(linP3ToXYZ, mapChannels(v, srgbToLinear))
+	case ColorA98RGB:
+		return mulMatrix(linA98RGBToXYZ, mapChannels(v, a98RGBToLinear))
+	case ColorProPhotoRGB:
+		return mulMatrix(d50ToD65, mulMatrix(linProPhotoToXYZ, mapChannels(v, proPhotoToLinear)))
+	case ColorRec2020:
+		return mulMatrix(linRec2020ToXYZ, mapChannels(v, rec2020ToLinear))
+	case ColorXYZD50:
+		return mulMatrix(d50ToD65, v)
+	case ColorLab, ColorLCH:
+		if c.Space == ColorLCH {
+			v = fromPolar(v)
+		}
+		return mulMatrix(d50ToD65, labToXYZ(v))
+	case ColorOKLab, ColorOKLCH:
+		if c.Space == ColorOKLCH {
+			v = fromPolar(v)
+		}
+		lms := mulMatrix(okLabToLMS, v)
+		return mulMatrix(lmsToXYZ, mapChannels(lms, func(x float64) float64 { return x * x * x }))
+	}
+	return v
+}
+
+func fromXYZ(space ColorSpace, xyz [3]float64) [3]float64 {
+	switch space {
+	case ColorSRGB, ColorHSL, ColorHWB:
+		return fromSRGB(space, mapChannels(mulMatrix(xyzToLinSRGB, xyz), srgbFromLinear))
+	case ColorSRGBLinear:
+		return mulMatrix(xyzToLinSRGB, xyz)
+	case ColorDisplayP3:
+		return mapChannels(mulMatrix(xyzToLinP3, xyz), srgbFromLinear)
+	case ColorA98RGB:
+		return mapChannels(mulMatrix(xyzToLinA98RGB, xyz), a98RGBFromLinear)
+	case ColorProPhotoRGB:
+		return mapChannels(mulMatrix(xyzToLinProPhoto, mulMatrix(d65ToD50, xyz)), proPhotoFromLinear)
+	case ColorRec2020:
+		return mapChannels(mulMatrix(xyzToLinRec2020, xyz), rec2020FromLinear)
+	case ColorXYZD50:
+		return mulMatrix(d65ToD50, xyz)
+	case ColorLab, ColorLCH:
+		lab := xyzToLab(mulMatrix(d65ToD50, xyz))
+		if space == ColorLCH {
+			return toPolar(ColorLab, lab)
+		}
+		return lab
+	case ColorOKLab, ColorOKLCH:
+		lms := mapChannels(mulMatrix(xyzToLMS, xyz), math.Cbrt)
+		lab := mulMatrix(lmsToOKLab, lms)
+		if space == ColorOKLCH {
+			return toPolar(ColorOKLab, lab)
+		}
+		return lab
+	}
+	return xyz
+}
+
+// toPolar converts Lab or OKLab to LCH or OKLCH respectively. The hue of achromatic colors, which would be rounded to zero chroma when serialized, is zero.
+func toPolar(space ColorSpace, lab [3]float64) [3]float64 {
+	c := math.Hypot(lab[1], lab[2])
+	h := 0.0
+	if space == ColorLab && 0.005 <= c || space == ColorOKLab && 0.00005 <= c {
+		h = normalizeHue(math.Atan2(lab[2], lab[1]) * 180.0 / math.Pi)
+	}
+	return [3]float64{lab[0], c, h}
+}
+
+func fromPolar(lch [3]float64) [3]float64 {
+	h := lch[2] * math.Pi / 180.0
+	return [3]float64{lch[0], lch[1] * math.Cos(h), lch[1] * math.Sin(h)}
+}
+
+const (
+	labEpsilon = 216.0 / 24389.0
+	labKappa   = 24389.0 / 27.0
+)
+
+var d50White = [3]float64{0.3457 / 0.3585, 1.0, (1.0 - 0.3457 - 0.3585) / 0.3585}
+
+func xyzToLab(xyz [3]float64) [3]float64 {
+	var f [3]float64
+	for i := range xyz {
+		if x := xyz[i] / d50White[i]; labEpsilon < x {
+			f[i] = math.Cbrt(x)
+		} else {
+			f[i] = (labKappa*x + 16.0) / 116.0
+		}
+	}
+	return [3]float64{116.0*f[1] - 16.0, 500.0 * (f[0] - f[1]), 200.0 * (f[1] - f[2])}
+}
+
+func labToXYZ(lab [3]float64) [3]float64 {
+	f1 := (lab[0] + 16.0) / 116.0
+	f0 := lab[1]/500.0 + f1
+	f2 := f1 - lab[2]/200.0
+	var xyz [3]float64
+	if f0*f0*f0 > labEpsilon {
+		xyz[0] = f0 * f0 * f0
+	} else {
+		xyz[0] = (116.0*f0 - 16.0) / labKappa
+	}
+	if lab[0] > labKappa*labEpsilon {
+		xyz[1] = f1 * f1 * f1
+	} else {
+		xyz[1] = lab[0] / labKappa
+	}
+	if f2*f2*f2 > labEpsilon {
+		xyz[2] = f2 * f2 * f2
+	} else {
+		xyz[2] = (116.0*f2 - 16.0) / labKappa
+	}
+	for i := range xyz {
+		xyz[i] *= d50White[i]
+	}
+	return xyz
+}
+
+func mapChannels(v [3]float64, f func(float64) float64) [3]float64 {
+	return [3]float64{f(v[0]), f(v[1]), f(v[2])}
+}
+
+func mulMatrix(m [3][3]float64, v [3]float64) [3]float64 {
+	return [3]float64{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+// signedPow raises the absolute value of x to the power p while keeping its sign, which extends the transfer functions to negative values.
+func signedPow(x, p float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(x), p), x)
+}
+
+func srgbToLinear(x float64) float64 {
+	if math.Abs(x) <= 0.04045 {
+		return x / 12.92
+	}
+	return math.Pow((math.Abs(x)+0.055)/1.055, 2.4) * sign(x)
+}
+
+func srgbFromLinear(x float64) float64 {
+	if math.Abs(x) <= 0.0031308 {
+		return x * 12.92
+	}
+	return (1.055*math.Pow(math.Abs(x), 1.0/2.4) - 0.055) * sign(x)
+}
+
+func a98RGBToLinear(x float64) float64 {
+	return signedPow(x, 563.0/256.0)
+}
+
+func a98RGBFromLinear(x float64) float64 {
+	return signedPow(x, 256.0/563.0)
+}
+
+func proPhotoToLinear(x float64) float64 {
+	if math.Abs(x) <= 16.0/512.0 {
+		return x / 16.0
+	}
+	return signedPow(x, 1.8)
+}
+
+func proPhotoFromLinear(x float64) float64 {
+	if math.Abs(x) < 1.0/512.0 {
+		return x * 16.0
+	}
+	return signedPow(x, 1.0/1.8)
+}
+
+const (
+	rec2020Alpha = 1.09929682680944
+	rec2020Beta  = 0.018053968510807
+)
+
+func rec2020ToLinear(x float64) float64 {
+	if math.Abs(x) < rec2020Beta*4.5 {
+		return x / 4.5
+	}
+	return math.Pow((math.Abs(x)+rec2020Alpha-1.0)/rec2020Alpha, 1.0/0.45) * sign(x)
+}
+
+func rec2020FromLinear(x float64) float64 {
+	if math.Abs(x) < rec2020Beta {
+		return x * 4.5
+	}
+	return (rec2020Alpha*math.Pow(math.Abs(x), 0.45) - (rec2020Alpha - 1.0)) * sign(x)
+}
+
+func sign(x float64) float64 {
+	if x < 0.0 {
+		return -1.0
+	}
+	return 1.0
+}
+
+// conversion matrices from https://www.w3.org/TR/css-color-4/#color-conversion-code
+var (
+	linSRGBToXYZ = [3][3]float64{
+		{506752.0 / 1228815.0, 87881.0 / 245763.0, 12673.0 / 70218.0},
+		{87098.0 / 409605.0, 175762.0 / 245763.0, 12673.0 / 175545.0},
+		{7918.0 / 409605.0, 87881.0 / 737289.0, 1001167.0 / 1053270.0},
+	}
+	xyzToLinSRGB = [3][3]float64{
+		{12831.0 / 3959.0, -329.0 / 214.0, -1974.0 / 3959.0},
+		{-851781.0 / 878810.0, 1648619.0 / 878810.0, 36519.0 / 878810.0},
+		{705.0 / 12673.0, -2585.0 / 12673.0, 705.0 / 667.0},
+	}
+	linP3ToXYZ = [3][3]float64{
+		{608311.0 / 1250200.0, 189793.0 / 714400.0, 198249.0 / 1000160.0},
+		{35783.0 / 156275.0, 247089.0 / 357200.0, 198249.0 / 2500400.0},
+		{0.0, 32229.0 / 714400.0, 5220557.0 / 5000800.0},
+	}
+	xyzToLinP3 = [3][3]float64{
+		{446124.0 / 178915.0, -333277.0 / 357830.0, -72051.0 / 178915.0},
+		{-14852.0 / 17905.0, 63121.0 / 35810.0, 423.0 / 17905.0},
+		{11844.0 / 330415.0, -50337.0 / 660830.0, 316169.0 / 330415.0},
+	}
+	linA98RGBToXYZ = [3][3]float64{
+		{573536.0 / 994567.0, 263643.0 / 1420810.0, 187206.0 / 994567.0},
+		{591459.0 / 1989134.0, 6239551.0 / 9945670.0, 374412.0 / 4972835.0},
+		{53769.0 / 1989134.0, 351524.0 / 4972835.0, 4929758.0 / 4972835.0},
+	}
+	xyzToLinA98RGB = [3][3]float64{
+		{1829569.0 / 896150.0, -506331.0 / 896150.0, -308931.0 / 896150.0},
+		{-851781.0 / 878810.0, 1648619.0 / 878810.0, 36519.0 / 878810.0},
+		{16779.0 / 1248040.0, -147721.0 / 1248040.0, 1266979.0 / 1248040.0},
+	}
+	linProPhotoToXYZ = [3][3]float64{
+		{0.79776664490064230, 0.13518129740053308, 0.03134773412839220},
+		{0.28807482881940130, 0.71183523424187300, 0.00008993693872564},
+		{0.0, 0.0, 0.82510460251046020},
+	}
+	xyzToLinProPhoto = [3][3]float64{
+		{1.34578688164715830, -0.25557208737979464, -0.05110186497554526},
+		{-0.54463070512490190, 1.50824774284514680, 0.02052744743642139},
+		{0.0, 0.0, 1.21196754563894520},
+	}
+	linRec2020ToXYZ = [3][3]float64{
+		{63426534.0 / 99577255.0, 20160776.0 / 139408157.0, 47086771.0 / 278816314.0},
+		{26158966.0 / 99577255.0, 472592308.0 / 697040785.0, 8267143.0 / 139408157.0},
+		{0.0, 19567812.0 / 697040785.0, 295819943.0 / 278816314.0},
+	}
+	xyzToLinRec2020 = [3][3]float64{
+		{30757411.0 / 17917100.0, -6372589.0 / 17917100.0, -4539589.0 / 17917100.0},
+		{-19765991.0 / 29648200.0, 47925759.0 / 29648200.0, 467509.0 / 29648200.0},
+		{792561.0 / 44930125.0, -1921689.0 / 44930125.0, 42328811.0 / 44930125.0},
+	}
+	d65ToD50 = [3][3]float64{
+		{1.0479297925449969, 0.022946870601609652, -0.05019226628920524},
+		{0.02962780877005599, 0.9904344267538799, -0.017073799063418826},
+		{-0.009243040646204504, 0.015055191490298152, 0.7518742814281371},
+	}
+	d50ToD65 = [3][3]float64{
+		{0.955473421488075, -0.02309845494876471, 0.06325924320057072},
+		{-0.0283697093338637, 1.0099953980813041, 0.021041441191917323},
+		{0.012314014864481998, -0.020507649298898964, 1.330365926242124},
+	}
+	xyzToLMS = [3][3]float64{
+		{0.8190224379967030, 0.3619062600528904, -0.1288737815209879},
+		{0.0329836539323885, 0.9292868615863434, 0.0361446663506424},
+		{0.0481771893596242, 0.2642395317527308, 0.6335478284694309},
+	}
+	lmsToXYZ = [3][3]float64{
+		{1.2268798758459243, -0.5578149944602171, 0.2813910456659647},
+		{-0.0405757452148008, 1.1122868032803170, -0.0717110580655164},
+		{-0.0763729366746601, -0.4214933324022432, 1.5869240198367816},
+	}
+	lmsToOKLab = [3][3]float64{
+		{0.2104542683093140, 0.7936177747023054, -0.0040720430116193},
+		{1.9779985324311684, -2.4285922420485799, 0.4505937096174110},
+		{0.0259040424655478, 0.7827717124575296, -0.8086757549230774},
+	}
+	okLabToLMS = [3][3]float64{
+		{1.0, 0.3963377773761749, 0.2158037573099136},
+		{1.0, -0.1055613458156586, -0.0638541728258133},
+		{1.0, -0.0894841775298119, -1.2914855480194092},
+	}
+)
+
+////////////////////////////////////////////////////////////////
+
+const gamutEpsilon = 0.000075
+
+// InGamut returns true if the color lies within the gamut of an RGB color space, where ColorHSL and ColorHWB have the gamut of sRGB. All colors are within the gamut of other color spaces.
+func (c Color) InGamut(space ColorSpace) bool {
+	if !space.isRGB() {
+		return true
+	} else if space.isSRGB() {
+		space = ColorSRGB
+	}
+	for _, v := range c.To(space).Channels {
+		if v < -gamutEpsilon || 1.0+gamutEpsilon < v {
+			return false
+		}
+	}
+	return true
+}
+
+// ToGamut converts the color to another color space and maps it into the gamut of RGB color spaces by reducing its chroma in OKLCH until clipping is imperceptible, see https://www.w3.org/TR/css-color-4/#css-gamut-mapping.
+func (c Color) ToGamut(space ColorSpace) Color {
+	if c.InGamut(space) {
+		return c.To(space)
+	}
+	rgbSpace := space
+	if space.isSRGB() {
+		rgbSpace = ColorSRGB
+	}
+
+	const jnd = 0.02
+	origin := c.To(ColorOKLCH)
+	if 1.0 <= origin.Channels[0] {
+		return Color{ColorOKLCH, [3]float64{1.0, 0.0, 0.0}, c.Alpha}.To(space)
+	} else if origin.Channels[0] <= 0.0 {
+		return Color{ColorOKLCH, [3]float64{0.0, 0.0, 0.0}, c.Alpha}.To(space)
+	}
+
+	current := origin
+	clipped := clipColor(current.To(rgbSpace))
+	if deltaEOK(clipped, current) < jnd {
+		return clipped.To(space)
+	}
+	min, max := 0.0, origin.Channels[1]
+	minInGamut := true
+	for 0.0001 < max-min {
+		chroma := (min + max) / 2.0
+		current.Channels[1] = chroma
+		if minInGamut && current.InGamut(rgbSpace) {
+			min = chroma
+			continue
+		}
+		clipped = clipColor(current.To(rgbSpace))
+		if e := deltaEOK(clipped, current); e < jnd {
+			if jnd-e < 0.0001 {
+				break
+			}
+			minInGamut = false
+			min = chroma
+		} else {
+			max = chroma
+		}
+	}
+	return clipped.To(space)
+}
+
+func clipColor(c Color) Color {
+	c.Channels = mapChannels(c.Channels, func(v float64) float64 { return clamp(v, 0.0, 1.0) })
+	return c
+}
+
+// deltaEOK returns the Euclidean distance between two colors in OKLab.
+func deltaEOK(a, b Color) float64 {
+	v, w := a.To(ColorOKLab).Channels, b.To(ColorOKLab).Channels
+	return math.Sqrt((v[0]-w[0])*(v[0]-w[0]) + (v[1]-w[1])*(v[1]-w[1]) + (v[2]-w[2])*(v[2]-w[2]))
+}
+
+// RGBA8 returns the 8-bit sRGB channels and alpha of the color, where colors outside the sRGB gamut are gamut mapped.
+func (c Color) RGBA8() (uint8, uint8, uint8, uint8) {
+	rgb := c.ToGamut(ColorSRGB).Channels
+	return to8bit(rgb[0]), to8bit(rgb[1]), to8bit(rgb[2]), to8bit(c.Alpha)
+}
+
+func to8bit(v float64) uint8 {
+	return uint8(math.Round(clamp(v, 0.0, 1.0) * 255.0))
+}
+
+////////////////////////////////////////////////////////////////
+
+// String returns the shortest serialization of the color. Colors in the ColorSRGB, ColorHSL, and ColorHWB spaces are rounded to 8 bits per channel and serialized as a hex or named color, and other colors are serialized in their own color space with rounded channels.
+func (c Color) String() string {
+	return string(c.appendCSS(nil))
+}
+
+func (c Color) appendCSS(b []byte) []byte {
+	if c.Space.isSRGB() {
+		return appendRGBA8(b, c)
+	}
+
+	v := c.Channels
+	prec := 4
+	switch c.Space {
+	case ColorLab, ColorLCH:
+		b = append(b, c.Space.String()...)
+		b = append(b, '(')
+		prec = 2
+	case ColorOKLab, ColorOKLCH:
+		b = append(b, c.Space.String()...)
+		b = append(b, '(')
+	default:
+		b = append(b, "color("...)
+		b = append(b, c.Space.String()...)
+		b = append(b, ' ')
+	}
+	b = appendNumber(b, v[0], prec)
+	b = append(b, ' ')
+	b = appendNumber(b, v[1], prec)
+	b = append(b, ' ')
+	if c.Space == ColorLCH || c.Space == ColorOKLCH {
+		b = appendNumber(b, normalizeHue(v[2]), 2)
+	} else {
+		b = appendNumber(b, v[2], prec)
+	}
+	if alpha := clamp(c.Alpha, 0.0, 1.0); alpha < 1.0 {
+		b = append(b, '/')
+		b = appendNumber(b, alpha, 3)
+	}
+	return append(b, ')')
+}
+
+func appendRGBA8(b []byte, c Color) []byte {
+	const hexDigits = "0123456789abcdef"
+	r, g, bl, a := c.RGBA8()
+	v := []uint8{r, g, bl, a}
+	if a == 0xFF {
+		if name, ok := shortestColorNames[uint32(r)<<16|uint32(g)<<8|uint32(bl)]; ok {
+			short := true
+			for _, x := range v {
+				short = short && x>>4 == x&0x0F
+			}
+			if short && len(name) < 4 || !short && len(name) < 7 {
+				return append(b, name...)
+			}
+		}
+		v = v[:3]
+	}
+
+	short := true
+	for _, x := range v {
+		short = short && x>>4 == x&0x0F
+	}
+	b = append(b, '#')
+	for _, x := range v {
+		if short {
+			b = append(b, hexDigits[x&0x0F])
+		} else {
+			b = append(b, hexDigits[x>>4], hexDigits[x&0x0F])
+		}
+	}
+	return b
+}
+
+////////////////////////////////////////////////////////////////
+
+// namedColors are the named colors of CSS, see https://www.w3.org/TR/css-color-4/#named-colors.
+var namedColors = map[string]uint32{
+	"aliceblue":            0xf0f8ff,
+	"antiquewhite":         0xfaebd7,
+	"aqua":                 0x00ffff,
+	"aquamarine":           0x7fffd4,
+	"azure":                0xf0ffff,
+	"beige":                0xf5f5dc,
+	"bisque":               0xffe4c4,
+	"black":                0x000000,
+	"blanchedalmond":       0xffebcd,
+	"blue":                 0x0000ff,
+	"blueviolet":           0x8a2be2,
+	"brown":                0xa52a2a,
+	"burlywood":            0xdeb887,
+	"cadetblue":            0x5f9ea0,
+	"chartreuse":           0x7fff00,
+	"chocolate":            0xd2691e,
+	"coral":                0xff7f50,
+	"cornflowerblue":       0x6495ed,
+	"cornsilk":             0xfff8dc,
+	"crimson":              0xdc143c,
+	"cyan":                 0x00ffff,
+	"darkblue":             0x00008b,
+	"darkcyan":             0x008b8b,
+	"darkgoldenrod":        0xb8860b,
+	"darkgray":             0xa9a9a9,
+	"darkgreen":            0x006400,
+	"darkgrey":             0xa9a9a9,
+	"darkkhaki":            0xbdb76b,
+	"darkmagenta":          0x8b008b,
+	"darkolivegreen":       0x556b2f,
+	"darkorange":           0xff8c00,
+	"darkorchid":           0x9932cc,
+	"darkred":              0x8b0000,
+	"darksalmon":           0xe9967a,
+	"darkseagreen":         0x8fbc8f,
+	"darkslateblue":        0x483d8b,
+	"darkslategray":        0x2f4f4f,
+	"darkslategrey":        0x2f4f4f,
+	"darkturquoise":        0x00ced1,
+	"darkviolet":           0x9400d3,
+	"deeppink":             0xff1493,
+	"deepskyblue":          0x00bfff,
+	"dimgray":              0x696969,
+	"dimgrey":              0x696969,
+	"dodgerblue":           0x1e90ff,
+	"firebrick":            0xb22222,
+	"floralwhite":          0xfffaf0,
+	"forestgreen":          0x228b22,
+	"fuchsia":              0xff00ff,
+	"gainsboro":            0xdcdcdc,
+	"ghostwhite":           0xf8f8ff,
+	"gold":                 0xffd700,
+	"goldenrod":            0xdaa520,
+	"gray":                 0x808080,
+	"green":                0x008000,
+	"greenyellow":          0xadff2f,
+	"grey":                 0x808080,
+	"honeydew":             0xf0fff0,
+	"hotpink":              0xff69b4,
+	"indianred":            0xcd5c5c,
+	"indigo":               0x4b0082,
+	"ivory":                0xfffff0,
+	"khaki":                0xf0e68c,
+	"lavender":             0xe6e6fa,
+	"lavenderblush":        0xfff0f5,
+	"lawngreen":            0x7cfc00,
+	"lemonchiffon":         0xfffacd,
+	"lightblue":            0xadd8e6,
+	"lightcoral":           0xf08080,
+	"lightcyan":            0xe0ffff,
+	"lightgoldenrodyellow": 0xfafad2,
+	"lightgray":            0xd3d3d3,
+	"lightgreen":           0x90ee90,
+	"lightgrey":            0xd3d3d3,
+	"lightpink":            0xffb6c1,
+	"lightsalmon":          0xffa07a,
+	"lightseagreen":        0x20b2aa,
+	"lightskyblue":         0x87cefa,
+	"lightslategray":       0x778899,
+	"lightslategrey":       0x778899,
+	"lightsteelblue":       0xb0c4de,
+	"lightyellow":          0xffffe0,
+	"lime":                 0x00ff00,
+	"limegreen":            0x32cd32,
+	"linen":                0xfaf0e6,
+	"magenta":              0xff00ff,
+	"maroon":               0x800000,
+	"mediumaquamarine":     0x66cdaa,
+	"mediumblue":           0x0000cd,
+	"mediumorchid":         0xba55d3,
+	"mediumpurple":         0x9370db,
+	"mediumseagreen":       0x3cb371,
+	"mediumslateblue":      0x7b68ee,
+	"mediumspringgreen":    0x00fa9a,
+	"mediumturquoise":      0x48d1cc,
+	"mediumvioletred":      0xc71585,
+	"midnightblue":         0x191970,
+	"mintcream":            0xf5fffa,
+	"mistyrose":            0xffe4e1,
+	"moccasin":             0xffe4b5,
+	"navajowhite":          0xffdead,
+	"navy":                 0x000080,
+	"oldlace":              0xfdf5e6,
+	"olive":                0x808000,
+	"olivedrab":            0x6b8e23,
+	"orange":               0xffa500,
+	"orangered":            0xff4500,
+	"orchid":               0xda70d6,
+	"palegoldenrod":        0xeee8aa,
+	"palegreen":            0x98fb98,
+	"paleturquoise":        0xafeeee,
+	"palevioletred":        0xdb7093,
+	"papayawhip":           0xffefd5,
+	"peachpuff":            0xffdab9,
+	"peru":                 0xcd853f,
+	"pink":                 0xffc0cb,
+	"plum":                 0xdda0dd,
+	"powderblue":           0xb0e0e6,
+	"purple":               0x800080,
+	"rebeccapurple":        0x663399,
+	"red":                  0xff0000,
+	"rosybrown":            0xbc8f8f,
+	"royalblue":            0x4169e1,
+	"saddlebrown":          0x8b4513,
+	"salmon":               0xfa8072,
+	"sandybrown":           0xf4a460,
+	"seagreen":             0x2e8b57,
+	"seashell":             0xfff5ee,
+	"sienna":               0xa0522d,
+	"silver":               0xc0c0c0,
+	"skyblue":              0x87ceeb,
+	"slateblue":            0x6a5acd,
+	"slategray":            0x708090,
+	"slategrey":            0x708090,
+	"snow":                 0xfffafa,
+	"springgreen":          0x00ff7f,
+	"steelblue":            0x4682b4,
+	"tan":                  0xd2b48c,
+	"teal":                 0x008080,
+	"thistle":              0xd8bfd8,
+	"tomato":               0xff6347,
+	"turquoise":            0x40e0d0,
+	"violet":               0xee82ee,
+	"wheat":                0xf5deb3,
+	"white":                0xffffff,
+	"whitesmoke":           0xf5f5f5,
+	"yellow":               0xffff00,
+	"yellowgreen":          0x9acd32,
+}
+
+// shortestColorNames maps colors to their shortest name, where the alphabetically first name wins between names of the same length.
+var shortestColorNames = map[uint32]string{}
+
+func init() {
+	for name, rgb := range namedColors {
+		if other, ok := shortestColorNames[rgb]; !ok || len(name) < len(other) || len(name) == len(other) && name < other {
+			shortestColorNames[rgb] = name
+		}
+	}
+}
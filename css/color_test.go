@@ -0,0 +1,187 @@
+package css
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tdewolff/test"
+)
+
+func TestParseColor(t *testing.T) {
+	var tests = []struct {
+		color    string
+		expected string
+	}{
+		{"#f00", "red"},
+		{"#FF0000", "red"},
+		{"#ff000080", "#ff000080"},
+		{"#f008", "#f008"},
+		{"#112233", "#123"},
+		{"#123456", "#123456"},
+		{"#ffffff", "#fff"},
+		{"#d2b48c", "tan"},
+		{"#808080", "gray"},
+		{"#00ffff", "#0ff"},
+		{"white", "#fff"},
+		{"Navy", "navy"},
+		{"aliceblue", "#f0f8ff"},
+		{"transparent", "#0000"},
+		{"  red  ", "red"},
+		{"rgb(255,0,0)", "red"},
+		{"rgb(255, 0, 0, 0.5)", "#ff000080"},
+		{"rgba(100%, 0%, 0%, 50%)", "#ff000080"},
+		{"rgb(255 0 0)", "red"},
+		{"rgb(255 0 0 / 1)", "red"},
+		{"rgb(255 0 0 / 0)", "#f000"},
+		{"rgb(100% 50% none)", "#ff8000"},
+		{"rgb(300 -10 0)", "red"},
+		{"RGB(0 0 0 / 200%)", "#000"},
+		{"hsl(0, 100%, 50%)", "red"},
+		{"hsla(120, 100%, 25%, .5)", "#00800080"},
+		{"hsl(120deg 100% 25%)", "green"},
+		{"hsl(.5turn 100% 50%)", "#0ff"},
+		{"hsl(240 100 50)", "#00f"},
+		{"hwb(0 0% 0%)", "red"},
+		{"hwb(120 0% 50%)", "green"},
+		{"hwb(0 60% 60%)", "gray"},
+		{"lab(50 20 -30)", "lab(50 20 -30)"},
+		{"lab(50% 16% -24% / 50%)", "lab(50 20 -30/.5)"},
+		{"lab(50.123456 0 none)", "lab(50.12 0 0)"},
+		{"lch(50 30 400)", "lch(50 30 40)"},
+		{"lch(50 20% -90deg)", "lch(50 30 270)"},
+		{"oklab(0.5 0.1 -0.1)", "oklab(.5 .1 -.1)"},
+		{"oklab(50% 25% -25%)", "oklab(.5 .1 -.1)"},
+		{"oklch(0.7 0.1 180 / 0.25)", "oklch(.7 .1 180/.25)"},
+		{"color(display-p3 1 0 0)", "color(display-p3 1 0 0)"},
+		{"color(srgb 1 0 0)", "red"},
+		{"color(srgb-linear 50% 0 0 / .5)", "color(srgb-linear .5 0 0/.5)"},
+		{"color(xyz 0.5 0.5 0.5)", "color(xyz-d65 .5 .5 .5)"},
+		{"color(rec2020 1.5 0 0)", "color(rec2020 1.5 0 0)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.color, func(t *testing.T) {
+			c, err := ParseColor(lexSelector(tt.color))
+			test.Error(t, err)
+			test.String(t, c.String(), tt.expected)
+		})
+	}
+}
+
+func TestParseColorError(t *testing.T) {
+	var tests = []struct {
+		color string
+		err   string
+	}{
+		{"", "CSS parse error: unexpected ending in color"},
+		{"#ff", "CSS parse error: unexpected token '#ff' in color"},
+		{"#ggg", "CSS parse error: unexpected token '#ggg' in color"},
+		{"currentcolor", "CSS parse error: unexpected token 'currentcolor' in color"},
+		{"red blue", "CSS parse error: unexpected token 'blue' in color"},
+		{"rgb(1 2)", "CSS parse error: unexpected token ')' in rgb()"},
+		{"rgb(1 2 3 4)", "CSS parse error: unexpected token '4' in rgb()"},
+		{"rgb(1, 2 3)", "CSS parse error: unexpected token '3' in rgb()"},
+		{"rgb(1 2, 3)", "CSS parse error: unexpected token ',' in rgb()"},
+		{"rgb(1, 2, 3 / 4)", "CSS parse error: unexpected token '/' in rgb()"},
+		{"rgb(1 2 3 /)", "CSS parse error: unexpected token ')' in rgb()"},
+		{"rgb(1 2 3", "CSS parse error: unexpected ending in rgb()"},
+		{"rgb(1 2 calc(3))", "CSS parse error: unexpected token 'calc(' in rgb()"},
+		{"rgb(1, 2%, 3)", "CSS parse error: invalid arguments in rgb()"},
+		{"rgb(1, 2, none)", "CSS parse error: invalid arguments in rgb()"},
+		{"rgb(1 2 3deg)", "CSS parse error: invalid arguments in rgb()"},
+		{"rgb(1 2 3 / 4deg)", "CSS parse error: invalid alpha in rgb()"},
+		{"hsl(0, 100, 50)", "CSS parse error: invalid arguments in hsl()"},
+		{"hsl(10% 100% 50%)", "CSS parse error: invalid arguments in hsl()"},
+		{"hsl(10px 100% 50%)", "CSS parse error: unexpected token '10px' in hsl()"},
+		{"hwb(0, 0%, 0%)", "CSS parse error: invalid arguments in hwb()"},
+		{"lab(50, 20, 30)", "CSS parse error: invalid arguments in lab()"},
+		{"color(srgb, 1, 0, 0)", "CSS parse error: unexpected token ',' in color()"},
+		{"color(cmyk 1 0 0)", "CSS parse error: unexpected token 'cmyk' in color()"},
+		{"color(1 0 0)", "CSS parse error: unexpected token '1' in color()"},
+		{"foo(1 2 3)", "CSS parse error: unknown color function foo()"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.color, func(t *testing.T) {
+			_, err := ParseColor(lexSelector(tt.color))
+			test.That(t, err != nil, "must fail")
+			test.String(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestColorTo(t *testing.T) {
+	red := Color{ColorSRGB, [3]float64{1.0, 0.0, 0.0}, 1.0}
+	white := Color{ColorSRGB, [3]float64{1.0, 1.0, 1.0}, 1.0}
+	var tests = []struct {
+		color    Color
+		space    ColorSpace
+		expected [3]float64
+	}{
+		{red, ColorSRGBLinear, [3]float64{1.0, 0.0, 0.0}},
+		{red, ColorXYZD65, [3]float64{0.41239, 0.21264, 0.01933}},
+		{red, ColorXYZD50, [3]float64{0.43607, 0.22249, 0.01392}},
+		{red, ColorLab, [3]float64{54.29054, 80.80492, 69.89098}},
+		{red, ColorLCH, [3]float64{54.29054, 106.83719, 40.85766}},
+		{red, ColorOKLab, [3]float64{0.62796, 0.22486, 0.12585}},
+		{red, ColorOKLCH, [3]float64{0.62796, 0.25768, 29.23389}},
+		{red, ColorDisplayP3, [3]float64{0.91749, 0.20029, 0.13856}},
+		{red, ColorHSL, [3]float64{0.0, 100.0, 50.0}},
+		{red, ColorHWB, [3]float64{0.0, 0.0, 0.0}},
+		{white, ColorLab, [3]float64{100.0, 0.0, 0.0}},
+		{white, ColorOKLCH, [3]float64{1.0, 0.0, 0.0}},
+		{white, ColorRec2020, [3]float64{1.0, 1.0, 1.0}},
+		{white, ColorA98RGB, [3]float64{1.0, 1.0, 1.0}},
+		{white, ColorProPhotoRGB, [3]float64{1.0, 1.0, 1.0}},
+		{Color{ColorHSL, [3]float64{210.0, 50.0, 40.0}, 1.0}, ColorHWB, [3]float64{210.0, 20.0, 40.0}},
+		{Color{ColorOKLCH, [3]float64{0.62796, 0.25768, 29.23389}, 1.0}, ColorSRGB, [3]float64{1.0, 0.0, 0.0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.color.String()+" to "+tt.space.String(), func(t *testing.T) {
+			c := tt.color.To(tt.space)
+			test.T(t, c.Space, tt.space)
+			for i, v := range c.Channels {
+				test.That(t, math.Abs(v-tt.expected[i]) < 1e-4, "channel", i, v, "!=", tt.expected[i])
+			}
+		})
+	}
+
+	// all spaces round trip
+	c := Color{ColorSRGB, [3]float64{0.2, 0.4, 0.6}, 0.5}
+	for space := ColorSRGB; space <= ColorHWB; space++ {
+		d := c.To(space).To(ColorSRGB)
+		test.T(t, d.Alpha, 0.5)
+		for i, v := range d.Channels {
+			test.That(t, math.Abs(v-c.Channels[i]) < 1e-9, "round trip through", space, "channel", i, v)
+		}
+	}
+}
+
+func TestColorGamut(t *testing.T) {
+	p3 := Color{ColorDisplayP3, [3]float64{1.0, 0.0, 0.0}, 1.0}
+	test.That(t, p3.InGamut(ColorDisplayP3))
+	test.That(t, !p3.InGamut(ColorSRGB))
+	test.That(t, !p3.InGamut(ColorHSL))
+	test.That(t, Color{ColorSRGB, [3]float64{1.0, 0.0, 0.0}, 1.0}.InGamut(ColorRec2020))
+	test.That(t, p3.InGamut(ColorLab))
+
+	c := p3.ToGamut(ColorSRGB)
+	test.That(t, c.InGamut(ColorSRGB))
+	test.That(t, deltaEOK(c, p3) < 0.1)
+	r, g, b, a := p3.RGBA8()
+	test.T(t, a, uint8(255))
+	test.That(t, 0xF0 < r && g < 0x30 && b < 0x30, r, g, b)
+
+	test.String(t, Color{ColorOKLCH, [3]float64{1.2, 0.3, 0.0}, 1.0}.ToGamut(ColorSRGB).String(), "#fff")
+	test.String(t, Color{ColorLab, [3]float64{-5.0, 0.0, 0.0}, 1.0}.ToGamut(ColorHSL).String(), "#000")
+	test.String(t, Color{ColorLab, [3]float64{50.0, 200.0, 0.0}, 1.0}.ToGamut(ColorLab).String(), "lab(50 200 0)")
+}
+
+func TestNamedColors(t *testing.T) {
+	test.T(t, len(namedColors), 148)
+	for name := range namedColors {
+		c, err := ParseColor(lexSelector(name))
+		test.Error(t, err)
+		d, err := ParseColor(lexSelector(c.String()))
+		test.Error(t, err)
+		test.T(t, d, c, name)
+	}
+}
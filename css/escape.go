@@ -0,0 +1,211 @@
+package css
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+var replacementBytes = []byte("\uFFFD")
+
+// Unescape returns the value of an ident, function, at-keyword, hash, custom property name, string, or URL token. It removes the @ and # prefixes, the opening parenthesis of functions, the quotes of strings, and the url( and ) around URLs, and it decodes backslash escapes, see https://www.w3.org/TR/css-syntax-3/#consume-escaped-code-point. Other tokens are returned unchanged. The returned slice shares memory with data when there is nothing to decode.
+func Unescape(tt TokenType, data []byte) []byte {
+	switch tt {
+	case IdentToken, CustomPropertyNameToken:
+		return unescape(data, false)
+	case FunctionToken:
+		return unescape(data[:len(data)-1], false)
+	case AtKeywordToken, HashToken:
+		return unescape(data[1:], false)
+	case StringToken:
+		return unescapeString(data)
+	case URLToken:
+		data = data[bytes.IndexByte(data, '(')+1:]
+		if 0 < len(data) && data[len(data)-1] == ')' { // closing parenthesis may be missing at EOF
+			data = data[:len(data)-1]
+		}
+		data = trimWhitespace(data)
+		if 0 < len(data) && (data[0] == '"' || data[0] == '\'') {
+			return unescapeString(data)
+		}
+		return unescape(data, false)
+	}
+	return data
+}
+
+func unescapeString(data []byte) []byte {
+	if 0 < len(data) && (data[0] == '"' || data[0] == '\'') {
+		quote := data[0]
+		data = data[1:]
+		if 0 < len(data) && data[len(data)-1] == quote && !endsInBackslash(data[:len(data)-1]) { // closing quote may be missing at EOF
+			data = data[:len(data)-1]
+		}
+	}
+	return unescape(data, true)
+}
+
+// endsInBackslash returns true if b ends in a backslash that starts an escape, and is thus not escaped itself.
+func endsInBackslash(b []byte) bool {
+	n := 0
+	for i := len(b) - 1; 0 <= i && b[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+func trimWhitespace(b []byte) []byte {
+	for 0 < len(b) && isWhitespace(b[0]) {
+		b = b[1:]
+	}
+	for 0 < len(b) && isWhitespace(b[len(b)-1]) && !endsInBackslash(b[:len(b)-1]) {
+		b = b[:len(b)-1]
+	}
+	return b
+}
+
+func isWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func hexValue(c byte) rune {
+	if c <= '9' {
+		return rune(c - '0')
+	} else if c <= 'F' {
+		return rune(c-'A') + 10
+	}
+	return rune(c-'a') + 10
+}
+
+// unescape decodes backslash escapes and replaces NULL characters. Inside strings an escaped newline is removed and a backslash at the end is ignored.
+func unescape(b []byte, inString bool) []byte {
+	if bytes.IndexByte(b, '\\') == -1 && bytes.IndexByte(b, 0) == -1 {
+		return b
+	}
+
+	dst := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] == 0 {
+			dst = append(dst, replacementBytes...)
+			continue
+		} else if b[i] != '\\' {
+			dst = append(dst, b[i])
+			continue
+		}
+
+		i++
+		if len(b) <= i {
+			if !inString {
+				dst = append(dst, replacementBytes...)
+			}
+		} else if isHexDigit(b[i]) {
+			var r rune
+			j := i
+			for ; j < len(b) && j < i+6 && isHexDigit(b[j]); j++ {
+				r = r<<4 | hexValue(b[j])
+			}
+			if r == 0 || 0xD800 <= r && r <= 0xDFFF || utf8.MaxRune < r {
+				r = utf8.RuneError
+			}
+			dst = append(dst, string(r)...)
+			if j+1 < len(b) && b[j] == '\r' && b[j+1] == '\n' {
+				j++
+			} else if j < len(b) && !isWhitespace(b[j]) {
+				j--
+			}
+			i = j
+		} else if b[i] == '\n' || b[i] == '\f' || b[i] == '\r' {
+			if b[i] == '\r' && i+1 < len(b) && b[i+1] == '\n' {
+				i++
+			}
+		} else {
+			_, n := utf8.DecodeRune(b[i:])
+			if b[i] == 0 {
+				dst = append(dst, replacementBytes...)
+			} else {
+				dst = append(dst, b[i:i+n]...)
+			}
+			i += n - 1
+		}
+	}
+	return dst
+}
+
+////////////////////////////////////////////////////////////////
+
+// Escape returns the shortest serialization of s as either an identifier or a quoted string, preferring identifiers. The empty string is always serialized as a quoted string.
+func Escape(s []byte) []byte {
+	str := EscapeString(s)
+	if len(s) == 0 {
+		return str
+	} else if ident := EscapeIdent(s); len(ident) <= len(str) {
+		return ident
+	}
+	return str
+}
+
+// EscapeIdent returns the shortest serialization of s as an identifier, escaping characters only when needed, see https://drafts.csswg.org/cssom/#serialize-an-identifier. NULL characters and invalid UTF-8 are replaced by U+FFFD. The result is not a valid identifier when s is empty.
+func EscapeIdent(s []byte) []byte {
+	dst := make([]byte, 0, len(s))
+	for i := 0; i < len(s); {
+		c := s[i]
+		r, n := utf8.DecodeRune(s[i:])
+		if c == 0 || r == utf8.RuneError && n == 1 {
+			dst = append(dst, replacementBytes...)
+		} else if c <= 0x1F || c == 0x7F || '0' <= c && c <= '9' && (i == 0 || i == 1 && s[0] == '-') {
+			dst = appendHexEscape(dst, c, s[i+1:])
+		} else if c == '-' && len(s) == 1 {
+			dst = append(dst, '\\', '-')
+		} else if 0x80 <= c {
+			dst = append(dst, s[i:i+n]...)
+		} else if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_' || c == '-' {
+			dst = append(dst, c)
+		} else {
+			dst = append(dst, '\\', c)
+		}
+		i += n
+	}
+	return dst
+}
+
+// EscapeString returns the shortest serialization of s as a quoted string, using the quote that needs the fewest escapes and preferring double quotes. Only quotes, backslashes, and newlines are escaped. NULL characters and invalid UTF-8 are replaced by U+FFFD.
+func EscapeString(s []byte) []byte {
+	quote := byte('"')
+	if bytes.Count(s, []byte{'\''}) < bytes.Count(s, []byte{'"'}) {
+		quote = '\''
+	}
+
+	dst := make([]byte, 0, len(s)+2)
+	dst = append(dst, quote)
+	for i := 0; i < len(s); {
+		c := s[i]
+		r, n := utf8.DecodeRune(s[i:])
+		if c == 0 || r == utf8.RuneError && n == 1 {
+			dst = append(dst, replacementBytes...)
+		} else if c == '\n' || c == '\r' || c == '\f' {
+			dst = appendHexEscape(dst, c, s[i+1:])
+		} else if c == quote || c == '\\' {
+			dst = append(dst, '\\', c)
+		} else {
+			dst = append(dst, s[i:i+n]...)
+		}
+		i += n
+	}
+	return append(dst, quote)
+}
+
+// appendHexEscape appends an escape of an ASCII character as hexadecimal code point, followed by a space when the next character would otherwise be part of the escape.
+func appendHexEscape(dst []byte, c byte, next []byte) []byte {
+	const hex = "0123456789abcdef"
+	dst = append(dst, '\\')
+	if 0x10 <= c {
+		dst = append(dst, hex[c>>4])
+	}
+	dst = append(dst, hex[c&0x0F])
+	if 0 < len(next) && (isHexDigit(next[0]) || isWhitespace(next[0])) {
+		dst = append(dst, ' ')
+	}
+	return dst
+}
@@ -0,0 +1,116 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestUnescape(t *testing.T) {
+	var tests = []struct {
+		css      string
+		tt       TokenType
+		expected string
+	}{
+		{"color", IdentToken, "color"},
+		{`\63 olor`, IdentToken, "color"},
+		{`\000063olor`, IdentToken, "color"},
+		{`\31 23`, IdentToken, "123"},
+		{`a\.b`, IdentToken, "a.b"},
+		{`a\ b`, IdentToken, "a b"},
+		{`\E9t\E9`, IdentToken, "été"},
+		{"\\1F600 x", IdentToken, "😀x"},
+		{"a\\20\nb", IdentToken, "a b"},
+		{`\0 a`, IdentToken, "�a"},
+		{`\D800 a`, IdentToken, "�a"},
+		{`\110000 a`, IdentToken, "�a"},
+		{`--my\-var`, CustomPropertyNameToken, "--my-var"},
+		{`r\gb(`, FunctionToken, "rgb"},
+		{`@m\65 dia`, AtKeywordToken, "media"},
+		{`#\31 23`, HashToken, "123"},
+		{`"string"`, StringToken, "string"},
+		{`'it\'s'`, StringToken, "it's"},
+		{`"a\"b"`, StringToken, `a"b`},
+		{`"a\\"`, StringToken, `a\`},
+		{"\"a\\\nb\"", StringToken, "ab"},
+		{`"\26 B"`, StringToken, "&B"},
+		{`"unterminated`, StringToken, "unterminated"},
+		{`"a\"`, StringToken, `a"`},
+		{"\"a\\", StringToken, "a"},
+		{`url(a.png)`, URLToken, "a.png"},
+		{`URL( a.png )`, URLToken, "a.png"},
+		{`url( "a b.png" )`, URLToken, "a b.png"},
+		{`url('a\'b.png')`, URLToken, "a'b.png"},
+		{`url(a\)b.png)`, URLToken, "a)b.png"},
+		{`url(a\ )`, URLToken, "a "},
+		{`url(a.png`, URLToken, "a.png"},
+		{`5px`, DimensionToken, "5px"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.css, func(t *testing.T) {
+			l := NewLexer(parse.NewInputString(tt.css))
+			tt2, data := l.Next()
+			test.T(t, tt2, tt.tt)
+			test.String(t, string(Unescape(tt2, data)), tt.expected)
+		})
+	}
+
+	// not produced by the lexer
+	test.String(t, string(unescape([]byte("a\\20\r\nb"), false)), "a b")
+	test.String(t, string(unescape([]byte("a\x00b"), false)), "a\uFFFDb")
+	test.String(t, string(unescape([]byte("a\\"), false)), "a\uFFFD")
+	test.String(t, string(unescape([]byte("a\\"), true)), "a")
+}
+
+func TestEscape(t *testing.T) {
+	var tests = []struct {
+		s      string
+		ident  string
+		str    string
+		escape string
+	}{
+		{"", "", `""`, `""`},
+		{"color", "color", `"color"`, "color"},
+		{"-", `\-`, `"-"`, `\-`},
+		{"--x", "--x", `"--x"`, "--x"},
+		{"-1", `-\31`, `"-1"`, `-\31`},
+		{"1a", `\31 a`, `"1a"`, `"1a"`},
+		{"1x", `\31x`, `"1x"`, `\31x`},
+		{"a1", "a1", `"a1"`, "a1"},
+		{"a b", `a\ b`, `"a b"`, `a\ b`},
+		{"Times New Roman", `Times\ New\ Roman`, `"Times New Roman"`, `Times\ New\ Roman`},
+		{"a.b#c", `a\.b\#c`, `"a.b#c"`, `a\.b\#c`},
+		{"été", "été", `"été"`, "été"},
+		{"a\tb", `a\9 b`, "\"a\tb\"", `a\9 b`},
+		{"a\nb", `a\a b`, `"a\a b"`, `a\a b`},
+		{"a\nz", `a\az`, `"a\az"`, `a\az`},
+		{"a\x00b", "a�b", "\"a�b\"", "a�b"},
+		{"a\xffb", "a�b", "\"a�b\"", "a�b"},
+		{`it's`, `it\'s`, `"it's"`, `it\'s`},
+		{`say "hi"`, `say\ \"hi\"`, `'say "hi"'`, `'say "hi"'`},
+		{`a\b`, `a\\b`, `"a\\b"`, `a\\b`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			test.String(t, string(EscapeIdent([]byte(tt.s))), tt.ident)
+			test.String(t, string(EscapeString([]byte(tt.s))), tt.str)
+			test.String(t, string(Escape([]byte(tt.s))), tt.escape)
+
+			// round trip
+			if tt.s != "" && tt.s != "--x" && tt.s != "a\x00b" && tt.s != "a\xffb" {
+				l := NewLexer(parse.NewInputString(tt.ident))
+				tt2, data := l.Next()
+				test.T(t, tt2, IdentToken)
+				test.String(t, string(Unescape(tt2, data)), tt.s)
+				tt2, _ = l.Next()
+				test.T(t, tt2, ErrorToken)
+
+				l = NewLexer(parse.NewInputString(tt.str))
+				tt2, data = l.Next()
+				test.T(t, tt2, StringToken)
+				test.String(t, string(Unescape(tt2, data)), tt.s)
+			}
+		})
+	}
+}
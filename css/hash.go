@@ -10,12 +10,943 @@ type Hash uint32
 
 // Unique hash definitions to be used instead of strings
 const (
-	Document  Hash = 0x8    // document
-	Font_Face Hash = 0x809  // font-face
-	Keyframes Hash = 0x1109 // keyframes
-	Media     Hash = 0x2105 // media
-	Page      Hash = 0x2604 // page
-	Supports  Hash = 0x1908 // supports
+	Abs                           Hash = 0x181903 // abs
+	Absolute                      Hash = 0x181908 // absolute
+	Accent_Color                  Hash = 0xd270c  // accent-color
+	Acos                          Hash = 0xece04  // acos
+	Active                        Hash = 0x6      // active
+	Additive_Symbols              Hash = 0x3810   // additive-symbols
+	After                         Hash = 0x4ac05  // after
+	Align_Content                 Hash = 0x4370d  // align-content
+	Align_Items                   Hash = 0xc3f0b  // align-items
+	Align_Self                    Hash = 0x10d00a // align-self
+	Alignment_Baseline            Hash = 0x177e12 // alignment-baseline
+	All                           Hash = 0x1f703  // all
+	Alternate                     Hash = 0x10809  // alternate
+	Alternate_Reverse             Hash = 0x10811  // alternate-reverse
+	And                           Hash = 0x79403  // and
+	Animation                     Hash = 0x4d09   // animation
+	Animation_Composition         Hash = 0xda015  // animation-composition
+	Animation_Delay               Hash = 0x147d0f // animation-delay
+	Animation_Direction           Hash = 0x2b313  // animation-direction
+	Animation_Duration            Hash = 0x4d12   // animation-duration
+	Animation_Fill_Mode           Hash = 0xf213   // animation-fill-mode
+	Animation_Iteration_Count     Hash = 0x13519  // animation-iteration-count
+	Animation_Name                Hash = 0x1680e  // animation-name
+	Animation_Play_State          Hash = 0x1c914  // animation-play-state
+	Animation_Timeline            Hash = 0x20d12  // animation-timeline
+	Animation_Timing_Function     Hash = 0x23119  // animation-timing-function
+	Any_Hover                     Hash = 0x29709  // any-hover
+	Any_Link                      Hash = 0x2d008  // any-link
+	Any_Pointer                   Hash = 0x2e00b  // any-pointer
+	Anywhere                      Hash = 0x2f608  // anywhere
+	Appearance                    Hash = 0xd480a  // appearance
+	Ascent_Override               Hash = 0x16530f // ascent-override
+	Asin                          Hash = 0x32c04  // asin
+	Aspect_Ratio                  Hash = 0x1b70c  // aspect-ratio
+	At                            Hash = 0x5102   // at
+	Atan                          Hash = 0x197f04 // atan
+	Atan2                         Hash = 0x197f05 // atan2
+	Attr                          Hash = 0x124c04 // attr
+	Auto                          Hash = 0x33604  // auto
+	Autofill                      Hash = 0x33608  // autofill
+	Backdrop                      Hash = 0x10dd08 // backdrop
+	Backdrop_Filter               Hash = 0x10dd0f // backdrop-filter
+	Backface_Visibility           Hash = 0x1aef13 // backface-visibility
+	Background                    Hash = 0x3860a  // background
+	Background_Attachment         Hash = 0xfa415  // background-attachment
+	Background_Blend_Mode         Hash = 0x3da15  // background-blend-mode
+	Background_Clip               Hash = 0x99e0f  // background-clip
+	Background_Color              Hash = 0x162010 // background-color
+	Background_Image              Hash = 0x1b0410 // background-image
+	Background_Origin             Hash = 0xe1f11  // background-origin
+	Background_Position           Hash = 0x38613  // background-position
+	Background_Position_X         Hash = 0x38615  // background-position-x
+	Background_Position_Y         Hash = 0x40215  // background-position-y
+	Background_Repeat             Hash = 0x41711  // background-repeat
+	Background_Size               Hash = 0x4480f  // background-size
+	Backwards                     Hash = 0x45709  // backwards
+	Base_Palette                  Hash = 0x4800c  // base-palette
+	Baseline                      Hash = 0x19d08  // baseline
+	Baseline_Shift                Hash = 0x17880e // baseline-shift
+	Before                        Hash = 0x120e06 // before
+	Blank                         Hash = 0x4bd05  // blank
+	BlockHash                     Hash = 0x2a505  // block
+	Block_Size                    Hash = 0x53d0a  // block-size
+	Blur                          Hash = 0x4c204  // blur
+	Bold                          Hash = 0x4f104  // bold
+	Bolder                        Hash = 0x4f106  // bolder
+	Border                        Hash = 0x36a06  // border
+	Border_Block                  Hash = 0x36a0c  // border-block
+	Border_Block_Color            Hash = 0x36a12  // border-block-color
+	Border_Block_End              Hash = 0x54710  // border-block-end
+	Border_Block_End_Color        Hash = 0x54716  // border-block-end-color
+	Border_Block_End_Style        Hash = 0x5ce16  // border-block-end-style
+	Border_Block_End_Width        Hash = 0x60c16  // border-block-end-width
+	Border_Block_Start            Hash = 0x62912  // border-block-start
+	Border_Block_Start_Color      Hash = 0x62918  // border-block-start-color
+	Border_Block_Start_Style      Hash = 0x67118  // border-block-start-style
+	Border_Block_Start_Width      Hash = 0x68918  // border-block-start-width
+	Border_Block_Style            Hash = 0x6ae12  // border-block-style
+	Border_Block_Width            Hash = 0x6c012  // border-block-width
+	Border_Bottom                 Hash = 0x6f00d  // border-bottom
+	Border_Bottom_Color           Hash = 0x6f013  // border-bottom-color
+	Border_Bottom_Left_Radius     Hash = 0x71b19  // border-bottom-left-radius
+	Border_Bottom_Right_Radius    Hash = 0x7461a  // border-bottom-right-radius
+	Border_Bottom_Style           Hash = 0x76c13  // border-bottom-style
+	Border_Bottom_Width           Hash = 0x77f13  // border-bottom-width
+	Border_Box                    Hash = 0x79c0a  // border-box
+	Border_Collapse               Hash = 0x7c80f  // border-collapse
+	Border_Color                  Hash = 0x7f10c  // border-color
+	Border_End_End_Radius         Hash = 0x83115  // border-end-end-radius
+	Border_End_Start_Radius       Hash = 0x85a17  // border-end-start-radius
+	Border_Image                  Hash = 0x8b30c  // border-image
+	Border_Image_Outset           Hash = 0x8b313  // border-image-outset
+	Border_Image_Repeat           Hash = 0x8e213  // border-image-repeat
+	Border_Image_Slice            Hash = 0x8f712  // border-image-slice
+	Border_Image_Source           Hash = 0x90913  // border-image-source
+	Border_Image_Width            Hash = 0x91c12  // border-image-width
+	Border_Inline                 Hash = 0x9450d  // border-inline
+	Border_Inline_Color           Hash = 0x94513  // border-inline-color
+	Border_Inline_End             Hash = 0x95811  // border-inline-end
+	Border_Inline_End_Color       Hash = 0x95817  // border-inline-end-color
+	Border_Inline_End_Style       Hash = 0x96f17  // border-inline-end-style
+	Border_Inline_End_Width       Hash = 0x98617  // border-inline-end-width
+	Border_Inline_Start           Hash = 0x9d613  // border-inline-start
+	Border_Inline_Start_Color     Hash = 0x9d619  // border-inline-start-color
+	Border_Inline_Start_Style     Hash = 0x9ef19  // border-inline-start-style
+	Border_Inline_Start_Width     Hash = 0xa0819  // border-inline-start-width
+	Border_Inline_Style           Hash = 0xa3313  // border-inline-style
+	Border_Inline_Width           Hash = 0xa4613  // border-inline-width
+	Border_Left                   Hash = 0xa770b  // border-left
+	Border_Left_Color             Hash = 0xa7711  // border-left-color
+	Border_Left_Style             Hash = 0xa8811  // border-left-style
+	Border_Left_Width             Hash = 0xa9911  // border-left-width
+	Border_Radius                 Hash = 0xabf0d  // border-radius
+	Border_Right                  Hash = 0xaf80c  // border-right
+	Border_Right_Color            Hash = 0xaf812  // border-right-color
+	Border_Right_Style            Hash = 0xb0a12  // border-right-style
+	Border_Right_Width            Hash = 0xb1c12  // border-right-width
+	Border_Spacing                Hash = 0xb2e0e  // border-spacing
+	Border_Start_End_Radius       Hash = 0xb4617  // border-start-end-radius
+	Border_Start_Start_Radius     Hash = 0xb7e19  // border-start-start-radius
+	Border_Style                  Hash = 0xbbc0c  // border-style
+	Border_Top                    Hash = 0xbc80a  // border-top
+	Border_Top_Color              Hash = 0xbc810  // border-top-color
+	Border_Top_Left_Radius        Hash = 0xbd816  // border-top-left-radius
+	Border_Top_Right_Radius       Hash = 0xc1d17  // border-top-right-radius
+	Border_Top_Style              Hash = 0xc5f10  // border-top-style
+	Border_Top_Width              Hash = 0xc6f10  // border-top-width
+	Border_Width                  Hash = 0xc7f0c  // border-width
+	Both                          Hash = 0xc8b04  // both
+	Bottom                        Hash = 0x46d06  // bottom
+	Box_Decoration_Break          Hash = 0x33f14  // box-decoration-break
+	Box_Shadow                    Hash = 0x7a30a  // box-shadow
+	Box_Sizing                    Hash = 0xf8a0a  // box-sizing
+	Break_After                   Hash = 0x4a60b  // break-after
+	Break_All                     Hash = 0x34e09  // break-all
+	Break_Before                  Hash = 0x12080c // break-before
+	Break_Inside                  Hash = 0x17dc0c // break-inside
+	Break_Spaces                  Hash = 0x2200c  // break-spaces
+	Break_Word                    Hash = 0x16a50a // break-word
+	Brightness                    Hash = 0xc8f0a  // brightness
+	Browser                       Hash = 0xca807  // browser
+	Buffering                     Hash = 0xcaf09  // buffering
+	Calc                          Hash = 0x14e404 // calc
+	Cap                           Hash = 0x3a303  // cap
+	Capitalize                    Hash = 0x1ad50a // capitalize
+	Caption_Side                  Hash = 0x13300c // caption-side
+	Caret_Color                   Hash = 0x14e70b // caret-color
+	Center                        Hash = 0xd5006  // center
+	Ch                            Hash = 0xa502   // ch
+	Charset                       Hash = 0x13fb07 // charset
+	Checked                       Hash = 0x183607 // checked
+	Circle                        Hash = 0x191906 // circle
+	Clamp                         Hash = 0x19d305 // clamp
+	Clear                         Hash = 0x191c05 // clear
+	Clip                          Hash = 0x47704  // clip
+	Clip_Path                     Hash = 0x9a909  // clip-path
+	Clip_Rule                     Hash = 0x47709  // clip-rule
+	Closed                        Hash = 0xccb06  // closed
+	Closest_Corner                Hash = 0xcd10e  // closest-corner
+	Closest_Side                  Hash = 0xcdf0c  // closest-side
+	Cm                            Hash = 0xe6902  // cm
+	Coarse                        Hash = 0xd1f06  // coarse
+	Collapse                      Hash = 0x7cf08  // collapse
+	ColorHash                     Hash = 0x20205  // color
+	Color_Gamut                   Hash = 0xd2e0b  // color-gamut
+	Color_Index                   Hash = 0x162b0b // color-index
+	Color_Interpolation           Hash = 0x55813  // color-interpolation
+	Color_Interpolation_Filters   Hash = 0x5581b  // color-interpolation-filters
+	Color_Mix                     Hash = 0x63c09  // color-mix
+	Color_Profile                 Hash = 0x6fe0d  // color-profile
+	Color_Scheme                  Hash = 0x194c0c // color-scheme
+	Column                        Hash = 0x2506   // column
+	Column_Count                  Hash = 0xfbb0c  // column-count
+	Column_Fill                   Hash = 0x250b   // column-fill
+	Column_Gap                    Hash = 0xd400a  // column-gap
+	Column_Reverse                Hash = 0xd560e  // column-reverse
+	Column_Rule                   Hash = 0xd640b  // column-rule
+	Column_Rule_Color             Hash = 0xd6411  // column-rule-color
+	Column_Rule_Style             Hash = 0xd7511  // column-rule-style
+	Column_Rule_Width             Hash = 0xd8611  // column-rule-width
+	Column_Span                   Hash = 0xd970b  // column-span
+	Column_Width                  Hash = 0xdb90c  // column-width
+	Columns                       Hash = 0x50a07  // columns
+	Conic_Gradient                Hash = 0x16f80e // conic-gradient
+	Contain                       Hash = 0xdc507  // contain
+	Contain_Intrinsic_Block_Size  Hash = 0xdc51c  // contain-intrinsic-block-size
+	Contain_Intrinsic_Height      Hash = 0xde118  // contain-intrinsic-height
+	Contain_Intrinsic_Inline_Size Hash = 0xe741d  // contain-intrinsic-inline-size
+	Contain_Intrinsic_Size        Hash = 0xe9116  // contain-intrinsic-size
+	Contain_Intrinsic_Width       Hash = 0xea717  // contain-intrinsic-width
+	Container                     Hash = 0xebe09  // container
+	Container_Name                Hash = 0xebe0e  // container-name
+	Container_Type                Hash = 0xef80e  // container-type
+	Content                       Hash = 0x43d07  // content
+	Content_Box                   Hash = 0x43d0b  // content-box
+	Content_Visibility            Hash = 0x108512 // content-visibility
+	Contents                      Hash = 0x101a08 // contents
+	Contrast                      Hash = 0x196008 // contrast
+	Cos                           Hash = 0xecf03  // cos
+	Counter                       Hash = 0x14907  // counter
+	Counter_Increment             Hash = 0x14911  // counter-increment
+	Counter_Reset                 Hash = 0xfc20d  // counter-reset
+	Counter_Set                   Hash = 0xf2d0b  // counter-set
+	Counter_Style                 Hash = 0xf510d  // counter-style
+	Counters                      Hash = 0xf5e08  // counters
+	Cover                         Hash = 0xf7405  // cover
+	Cqb                           Hash = 0xfa203  // cqb
+	Cqh                           Hash = 0xfe203  // cqh
+	Cqi                           Hash = 0xfe503  // cqi
+	Cqmax                         Hash = 0xffe05  // cqmax
+	Cqmin                         Hash = 0x103105 // cqmin
+	Cqw                           Hash = 0x105103 // cqw
+	Cross_Fade                    Hash = 0x10670a // cross-fade
+	Crosshair                     Hash = 0x109709 // crosshair
+	Cubic_Bezier                  Hash = 0x10a00c // cubic-bezier
+	Cue                           Hash = 0x10ac03 // cue
+	Cue_Region                    Hash = 0x10ac0a // cue-region
+	Current                       Hash = 0x10b607 // current
+	Currentcolor                  Hash = 0x10b60c // currentcolor
+	Cursive                       Hash = 0x10c207 // cursive
+	Cursor                        Hash = 0x10ec06 // cursor
+	Cx                            Hash = 0x10f202 // cx
+	Cy                            Hash = 0x19b402 // cy
+	D                             Hash = 0x3901   // d
+	Dark                          Hash = 0x6aa04  // dark
+	Dashed                        Hash = 0xd3a06  // dashed
+	Decimal                       Hash = 0x10307  // decimal
+	Default                       Hash = 0x3ed07  // default
+	Defined                       Hash = 0x64e07  // defined
+	Deg                           Hash = 0xce903  // deg
+	Dense                         Hash = 0x62405  // dense
+	Descent_Override              Hash = 0x106f10 // descent-override
+	Details_Content               Hash = 0x107d0f // details-content
+	Device_Aspect_Ratio           Hash = 0x1b013  // device-aspect-ratio
+	Device_Height                 Hash = 0x110e0d // device-height
+	Device_Width                  Hash = 0x12be0c // device-width
+	Dir                           Hash = 0x2bd03  // dir
+	Direction                     Hash = 0x2bd09  // direction
+	Disabled                      Hash = 0x1bc108 // disabled
+	Disc                          Hash = 0x16004  // disc
+	Display                       Hash = 0x12b407 // display
+	Display_Mode                  Hash = 0x12b40c // display-mode
+	Document                      Hash = 0x18108  // document
+	Dominant_Baseline             Hash = 0x19411  // dominant-baseline
+	Dotted                        Hash = 0x65406  // dotted
+	Double                        Hash = 0x29106  // double
+	Dpcm                          Hash = 0xe6704  // dpcm
+	Dpi                           Hash = 0x65903  // dpi
+	Dppx                          Hash = 0x6ec04  // dppx
+	Drop_Shadow                   Hash = 0xc70b   // drop-shadow
+	Dvb                           Hash = 0x3d803  // dvb
+	Dvh                           Hash = 0x4ee03  // dvh
+	Dvi                           Hash = 0x73d03  // dvi
+	Dvmax                         Hash = 0x53705  // dvmax
+	Dvmin                         Hash = 0x5fc05  // dvmin
+	Dvw                           Hash = 0x84d03  // dvw
+	Dynamic_Range                 Hash = 0x9380d  // dynamic-range
+	Ease                          Hash = 0x31804  // ease
+	Ease_In                       Hash = 0x139707 // ease-in
+	Ease_In_Out                   Hash = 0x13970b // ease-in-out
+	Ease_Out                      Hash = 0x31808  // ease-out
+	Element                       Hash = 0x1707   // element
+	Ellipse                       Hash = 0xef107  // ellipse
+	Ellipsis                      Hash = 0x6a08   // ellipsis
+	Em                            Hash = 0x1902   // em
+	Emoji                         Hash = 0x149905 // emoji
+	Empty                         Hash = 0x4cd05  // empty
+	Empty_Cells                   Hash = 0x4cd0b  // empty-cells
+	Enabled                       Hash = 0x15ba07 // enabled
+	End                           Hash = 0x3d603  // end
+	Env                           Hash = 0x18fa03 // env
+	Ex                            Hash = 0x9f02   // ex
+	Exp                           Hash = 0x163403 // exp
+	Fallback                      Hash = 0x10d908 // fallback
+	Fangsong                      Hash = 0x1b9d08 // fangsong
+	Fantasy                       Hash = 0x1bac07 // fantasy
+	Farthest_Corner               Hash = 0x10f40f // farthest-corner
+	Farthest_Side                 Hash = 0x11030d // farthest-side
+	File_Selector_Button          Hash = 0x70714  // file-selector-button
+	Fill                          Hash = 0x2c04   // fill
+	Fill_Box                      Hash = 0x33a08  // fill-box
+	Fill_Opacity                  Hash = 0x2c0c   // fill-opacity
+	Fill_Rule                     Hash = 0x113409 // fill-rule
+	Filter                        Hash = 0x56c06  // filter
+	Fine                          Hash = 0x65004  // fine
+	First                         Hash = 0x113d05 // first
+	First_Child                   Hash = 0x113d0b // first-child
+	First_Letter                  Hash = 0x11480c // first-letter
+	First_Line                    Hash = 0x11910a // first-line
+	First_Of_Type                 Hash = 0x11ad0d // first-of-type
+	Fit_Content                   Hash = 0x10160b // fit-content
+	Fixed                         Hash = 0x19b905 // fixed
+	Flex                          Hash = 0x11ba04 // flex
+	Flex_Basis                    Hash = 0x18af0a // flex-basis
+	Flex_Direction                Hash = 0x11ba0e // flex-direction
+	Flex_End                      Hash = 0x11c808 // flex-end
+	Flex_Flow                     Hash = 0x11d009 // flex-flow
+	Flex_Grow                     Hash = 0x11f709 // flex-grow
+	Flex_Shrink                   Hash = 0x121a0b // flex-shrink
+	Flex_Start                    Hash = 0x12250a // flex-start
+	Flex_Wrap                     Hash = 0x123809 // flex-wrap
+	Float                         Hash = 0x124905 // float
+	Flood_Color                   Hash = 0x12720b // flood-color
+	Flood_Opacity                 Hash = 0x127d0d // flood-opacity
+	Flow_Root                     Hash = 0x11d509 // flow-root
+	Focus                         Hash = 0x128a05 // focus
+	Focus_Visible                 Hash = 0x128a0d // focus-visible
+	Focus_Within                  Hash = 0x12970c // focus-within
+	Font                          Hash = 0x12af04 // font
+	Font_Display                  Hash = 0x12af0c // font-display
+	Font_Face                     Hash = 0x12ca09 // font-face
+	Font_Family                   Hash = 0x12d30b // font-family
+	Font_Feature_Settings         Hash = 0x12de15 // font-feature-settings
+	Font_Feature_Values           Hash = 0x130713 // font-feature-values
+	Font_Kerning                  Hash = 0x133c0c // font-kerning
+	Font_Language_Override        Hash = 0x135f16 // font-language-override
+	Font_Optical_Sizing           Hash = 0x137513 // font-optical-sizing
+	Font_Palette                  Hash = 0x13ab0c // font-palette
+	Font_Palette_Values           Hash = 0x13ab13 // font-palette-values
+	Font_Size                     Hash = 0x13ca09 // font-size
+	Font_Size_Adjust              Hash = 0x13ca10 // font-size-adjust
+	Font_Stretch                  Hash = 0x13f10c // font-stretch
+	Font_Style                    Hash = 0x141d0a // font-style
+	Font_Synthesis                Hash = 0x14270e // font-synthesis
+	Font_Variant                  Hash = 0x14380c // font-variant
+	Font_Variant_Alternates       Hash = 0x143817 // font-variant-alternates
+	Font_Variant_Caps             Hash = 0x145311 // font-variant-caps
+	Font_Variant_East_Asian       Hash = 0x146817 // font-variant-east-asian
+	Font_Variant_Emoji            Hash = 0x148c12 // font-variant-emoji
+	Font_Variant_Ligatures        Hash = 0x14b816 // font-variant-ligatures
+	Font_Variant_Numeric          Hash = 0x14d114 // font-variant-numeric
+	Font_Variant_Position         Hash = 0x14f215 // font-variant-position
+	Font_Variation_Settings       Hash = 0x150717 // font-variation-settings
+	Font_Weight                   Hash = 0x15240b // font-weight
+	Forced_Color_Adjust           Hash = 0x153813 // forced-color-adjust
+	Forced_Colors                 Hash = 0x15570d // forced-colors
+	Format                        Hash = 0x157f06 // format
+	Forwards                      Hash = 0x158c08 // forwards
+	Fr                            Hash = 0x2ac02  // fr
+	From                          Hash = 0x159804 // from
+	Full_Width                    Hash = 0x15a80a // full-width
+	Fullscreen                    Hash = 0x15b20a // fullscreen
+	Future                        Hash = 0x15c106 // future
+	Gap                           Hash = 0x1a603  // gap
+	Grab                          Hash = 0x11d04  // grab
+	Grabbing                      Hash = 0x11d08  // grabbing
+	Grad                          Hash = 0x25d04  // grad
+	Grammar_Error                 Hash = 0x1240d  // grammar-error
+	Grayscale                     Hash = 0xb3b09  // grayscale
+	Grid                          Hash = 0xb304   // grid
+	Grid_Area                     Hash = 0xb309   // grid-area
+	Grid_Auto_Columns             Hash = 0x1ac311 // grid-auto-columns
+	Grid_Auto_Flow                Hash = 0xcb70e  // grid-auto-flow
+	Grid_Auto_Rows                Hash = 0xceb0e  // grid-auto-rows
+	Grid_Column                   Hash = 0xf930b  // grid-column
+	Grid_Column_End               Hash = 0xf930f  // grid-column-end
+	Grid_Column_Start             Hash = 0x115b11 // grid-column-start
+	Grid_Row                      Hash = 0x118508 // grid-row
+	Grid_Row_End                  Hash = 0x11850c // grid-row-end
+	Grid_Row_Start                Hash = 0x13470e // grid-row-start
+	Grid_Template                 Hash = 0x4fc0d  // grid-template
+	Grid_Template_Areas           Hash = 0x138713 // grid-template-areas
+	Grid_Template_Columns         Hash = 0x4fc15  // grid-template-columns
+	Grid_Template_Rows            Hash = 0x59812  // grid-template-rows
+	Groove                        Hash = 0x160606 // groove
+	Hanging_Punctuation           Hash = 0x9b113  // hanging-punctuation
+	Has                           Hash = 0x49203  // has
+	Height                        Hash = 0xdf306  // height
+	Help                          Hash = 0x49e04  // help
+	Hidden                        Hash = 0x62106  // hidden
+	High                          Hash = 0x6a004  // high
+	Highlight                     Hash = 0x6a009  // highlight
+	Horizontal_Tb                 Hash = 0xe130d  // horizontal-tb
+	Host                          Hash = 0x6d104  // host
+	Host_Context                  Hash = 0x6d10c  // host-context
+	Hover                         Hash = 0x29b05  // hover
+	Hsl                           Hash = 0x79103  // hsl
+	Hsla                          Hash = 0x79104  // hsla
+	Hue_Rotate                    Hash = 0x92d0a  // hue-rotate
+	Hwb                           Hash = 0x99c03  // hwb
+	Hyphenate_Character           Hash = 0xa2013  // hyphenate-character
+	Hyphens                       Hash = 0xa5807  // hyphens
+	Hypot                         Hash = 0xaa905  // hypot
+	Hz                            Hash = 0x2d802  // hz
+	Ic                            Hash = 0x1b302  // ic
+	Image                         Hash = 0x8ba05  // image
+	Image_Orientation             Hash = 0x1b0f11 // image-orientation
+	Image_Rendering               Hash = 0x11770f // image-rendering
+	Image_Set                     Hash = 0x16b409 // image-set
+	Import                        Hash = 0xfe706  // import
+	Important                     Hash = 0xfe709  // important
+	In                            Hash = 0xac02   // in
+	In_Range                      Hash = 0x32e08  // in-range
+	Indeterminate                 Hash = 0xe2e0d  // indeterminate
+	Infinite                      Hash = 0xf1408  // infinite
+	Inherit                       Hash = 0x125c07 // inherit
+	Inherits                      Hash = 0x125c08 // inherits
+	Initial                       Hash = 0x12a107 // initial
+	Initial_Letter                Hash = 0x12a10e // initial-letter
+	Initial_Value                 Hash = 0x16cf0d // initial-value
+	Inline                        Hash = 0xac06   // inline
+	Inline_Block                  Hash = 0x16120c // inline-block
+	Inline_Flex                   Hash = 0x18a80b // inline-flex
+	Inline_Grid                   Hash = 0xac0b   // inline-grid
+	Inline_Size                   Hash = 0xe860b  // inline-size
+	Inline_Table                  Hash = 0x13230c // inline-table
+	Inset                         Hash = 0x3c905  // inset
+	Inset_Block                   Hash = 0x1a840b // inset-block
+	Inset_Block_End               Hash = 0x1b320f // inset-block-end
+	Inset_Block_Start             Hash = 0x1a8411 // inset-block-start
+	Inset_Inline                  Hash = 0x3c90c  // inset-inline
+	Inset_Inline_End              Hash = 0x3c910  // inset-inline-end
+	Inset_Inline_Start            Hash = 0x149d12 // inset-inline-start
+	Interlace                     Hash = 0x15d709 // interlace
+	Invalid                       Hash = 0x73707  // invalid
+	Invert                        Hash = 0x15e006 // invert
+	Inverted_Colors               Hash = 0x15e00f // inverted-colors
+	Is                            Hash = 0x7002   // is
+	Isolation                     Hash = 0x7009   // isolation
+	Italic                        Hash = 0x191406 // italic
+	Justify                       Hash = 0xedc07  // justify
+	Justify_Content               Hash = 0xedc0f  // justify-content
+	Justify_Items                 Hash = 0x13d60d // justify-items
+	Justify_Self                  Hash = 0x15470c // justify-self
+	Keep_All                      Hash = 0x1f208  // keep-all
+	Keyframes                     Hash = 0x2a909  // keyframes
+	Khz                           Hash = 0x2d703  // khz
+	Lab                           Hash = 0x181803 // lab
+	Landscape                     Hash = 0x79309  // landscape
+	Lang                          Hash = 0x136404 // lang
+	Large                         Hash = 0x42d05  // large
+	Larger                        Hash = 0x42d06  // larger
+	Last                          Hash = 0x2004   // last
+	Last_Child                    Hash = 0x18b0a  // last-child
+	Last_Of_Type                  Hash = 0xff20c  // last-of-type
+	Layer                         Hash = 0x1a0f05 // layer
+	Lch                           Hash = 0x183503 // lch
+	Left                          Hash = 0xe404   // left
+	Less                          Hash = 0x5e204  // less
+	Letter_Spacing                Hash = 0x114e0e // letter-spacing
+	Lh                            Hash = 0x15d502 // lh
+	Light                         Hash = 0x1f905  // light
+	Light_Dark                    Hash = 0x6a40a  // light-dark
+	Lighter                       Hash = 0x35607  // lighter
+	Lighting_Color                Hash = 0x1f90e  // lighting-color
+	Line_Break                    Hash = 0x21b0a  // line-break
+	Line_Gap_Override             Hash = 0x1a111  // line-gap-override
+	Line_Height                   Hash = 0x11970b // line-height
+	Line_Through                  Hash = 0x186f0c // line-through
+	Linear                        Hash = 0x25606  // linear
+	Linear_Gradient               Hash = 0x2560f  // linear-gradient
+	Link                          Hash = 0x2d404  // link
+	List_Item                     Hash = 0x4c609  // list-item
+	List_Style                    Hash = 0x7d0a   // list-style
+	List_Style_Image              Hash = 0x116c10 // list-style-image
+	List_Style_Position           Hash = 0x7d13   // list-style-position
+	List_Style_Type               Hash = 0x5bf0f  // list-style-type
+	Local                         Hash = 0x15fa05 // local
+	Local_Link                    Hash = 0x15fa0a // local-link
+	Log                           Hash = 0x160403 // log
+	Lowercase                     Hash = 0xcc209  // lowercase
+	Ltr                           Hash = 0x3f203  // ltr
+	Lvb                           Hash = 0x161e03 // lvb
+	Lvh                           Hash = 0x163803 // lvh
+	Lvi                           Hash = 0x163b03 // lvi
+	Lvmax                         Hash = 0x166e05 // lvmax
+	Lvmin                         Hash = 0x168405 // lvmin
+	Lvw                           Hash = 0x169e03 // lvw
+	Margin                        Hash = 0xdd06   // margin
+	Margin_Block                  Hash = 0x3ad0c  // margin-block
+	Margin_Block_End              Hash = 0xe5810  // margin-block-end
+	Margin_Block_Start            Hash = 0x3ad12  // margin-block-start
+	Margin_Bottom                 Hash = 0x4660d  // margin-bottom
+	Margin_Box                    Hash = 0xf830a  // margin-box
+	Margin_Inline                 Hash = 0x4de0d  // margin-inline
+	Margin_Inline_End             Hash = 0x4de11  // margin-inline-end
+	Margin_Inline_Start           Hash = 0x57913  // margin-inline-start
+	Margin_Left                   Hash = 0xdd0b   // margin-left
+	Margin_Right                  Hash = 0x5170c  // margin-right
+	Margin_Top                    Hash = 0x5b00a  // margin-top
+	Marker                        Hash = 0xe6a06  // marker
+	Marker_End                    Hash = 0xe6a0a  // marker-end
+	Marker_Mid                    Hash = 0x159b0a // marker-mid
+	Marker_Start                  Hash = 0x17a30c // marker-start
+	Mask                          Hash = 0x36504  // mask
+	Mask_Border                   Hash = 0x3650b  // mask-border
+	Mask_Clip                     Hash = 0x47209  // mask-clip
+	Mask_Composite                Hash = 0x8840e  // mask-composite
+	Mask_Image                    Hash = 0x16af0a // mask-image
+	Mask_Mode                     Hash = 0x16bd09 // mask-mode
+	Mask_Origin                   Hash = 0x16c60b // mask-origin
+	Mask_Position                 Hash = 0x16dc0d // mask-position
+	Mask_Repeat                   Hash = 0x16e90b // mask-repeat
+	Mask_Size                     Hash = 0x170609 // mask-size
+	Mask_Type                     Hash = 0x170f09 // mask-type
+	Masonry                       Hash = 0x171807 // masonry
+	Math                          Hash = 0x158204 // math
+	Math_Depth                    Hash = 0x15820a // math-depth
+	Math_Style                    Hash = 0x171f0a // math-style
+	Matrix                        Hash = 0x172906 // matrix
+	Matrix3d                      Hash = 0x172908 // matrix3d
+	Max                           Hash = 0x53903  // max
+	Max_Aspect_Ratio              Hash = 0x100010 // max-aspect-ratio
+	Max_Block_Size                Hash = 0x5390e  // max-block-size
+	Max_Content                   Hash = 0x16700b // max-content
+	Max_Height                    Hash = 0x17710a // max-height
+	Max_Inline_Size               Hash = 0x1b430f // max-inline-size
+	Max_Resolution                Hash = 0x17310e // max-resolution
+	Max_Width                     Hash = 0x173f09 // max-width
+	Media                         Hash = 0xeca05  // media
+	Medium                        Hash = 0x179e06 // medium
+	Middle                        Hash = 0x15a206 // middle
+	Min                           Hash = 0x19603  // min
+	Min_Aspect_Ratio              Hash = 0x103310 // min-aspect-ratio
+	Min_Block_Size                Hash = 0x5fe0e  // min-block-size
+	Min_Content                   Hash = 0x16860b // min-content
+	Min_Height                    Hash = 0x1b540a // min-height
+	Min_Inline_Size               Hash = 0x17480f // min-inline-size
+	Min_Resolution                Hash = 0x17570e // min-resolution
+	Min_Width                     Hash = 0x176509 // min-width
+	Minmax                        Hash = 0x176e06 // minmax
+	Mix_Blend_Mode                Hash = 0x6420e  // mix-blend-mode
+	Mm                            Hash = 0x12702  // mm
+	Mod                           Hash = 0x10103  // mod
+	Modal                         Hash = 0x177b05 // modal
+	Monochrome                    Hash = 0x17960a // monochrome
+	Monospace                     Hash = 0x1b7609 // monospace
+	More                          Hash = 0x17af04 // more
+	Move                          Hash = 0x17ca04 // move
+	Ms                            Hash = 0xc4802  // ms
+	Muted                         Hash = 0xd3605  // muted
+	Namespace                     Hash = 0x17209  // namespace
+	Negative                      Hash = 0x9c508  // negative
+	No_Preference                 Hash = 0x5e0d   // no-preference
+	No_Repeat                     Hash = 0x24909  // no-repeat
+	None                          Hash = 0x9c304  // none
+	Normal                        Hash = 0x7806   // normal
+	Not                           Hash = 0x28703  // not
+	Not_Allowed                   Hash = 0x2870b  // not-allowed
+	Nowrap                        Hash = 0x8f06   // nowrap
+	Nth_Child                     Hash = 0x15809  // nth-child
+	Nth_Col                       Hash = 0xfb707  // nth-col
+	Nth_Last_Child                Hash = 0x1870e  // nth-last-child
+	Nth_Last_Col                  Hash = 0x1c0c   // nth-last-col
+	Nth_Last_Of_Type              Hash = 0xfee10  // nth-last-of-type
+	Nth_Of_Type                   Hash = 0x16790b // nth-of-type
+	Object_Fit                    Hash = 0x100f0a // object-fit
+	Object_Position               Hash = 0x10420f // object-position
+	Oblique                       Hash = 0x1c207  // oblique
+	Offset                        Hash = 0xe0906  // offset
+	Offset_Anchor                 Hash = 0xe090d  // offset-anchor
+	Offset_Distance               Hash = 0x1a4b0f // offset-distance
+	Offset_Path                   Hash = 0x11290b // offset-path
+	Offset_Position               Hash = 0x17e80f // offset-position
+	Offset_Rotate                 Hash = 0x17f70d // offset-rotate
+	Oklab                         Hash = 0x181605 // oklab
+	Oklch                         Hash = 0x183305 // oklch
+	Only                          Hash = 0xbe04   // only
+	Only_Child                    Hash = 0xbe0a   // only-child
+	Only_Of_Type                  Hash = 0x2c40c  // only-of-type
+	Opacity                       Hash = 0x3107   // opacity
+	Open                          Hash = 0x18f804 // open
+	Optional                      Hash = 0x5b808  // optional
+	Or                            Hash = 0x802    // or
+	Order                         Hash = 0x36b05  // order
+	Orientation                   Hash = 0x27d0b  // orientation
+	Orphans                       Hash = 0x7fb07  // orphans
+	Out_Of_Range                  Hash = 0x139f0c // out-of-range
+	Outline                       Hash = 0x31d07  // outline
+	Outline_Color                 Hash = 0x31d0d  // outline-color
+	Outline_Offset                Hash = 0xe010e  // outline-offset
+	Outline_Style                 Hash = 0x183d0d // outline-style
+	Outline_Width                 Hash = 0x184a0d // outline-width
+	Outset                        Hash = 0x8c006  // outset
+	Overflow                      Hash = 0x29c08  // overflow
+	Overflow_Anchor               Hash = 0xfd30f  // overflow-anchor
+	Overflow_Block                Hash = 0x29c0e  // overflow-block
+	Overflow_Clip_Margin          Hash = 0xf7514  // overflow-clip-margin
+	Overflow_Inline               Hash = 0x16090f // overflow-inline
+	Overflow_Wrap                 Hash = 0x17cb0d // overflow-wrap
+	Overflow_X                    Hash = 0x18570a // overflow-x
+	Overflow_Y                    Hash = 0x18610a // overflow-y
+	Overline                      Hash = 0x186b08 // overline
+	Override_Colors               Hash = 0x165a0f // override-colors
+	Overscroll_Behavior           Hash = 0x187b13 // overscroll-behavior
+	Overscroll_Behavior_Block     Hash = 0x187b19 // overscroll-behavior-block
+	Overscroll_Behavior_Inline    Hash = 0x18941a // overscroll-behavior-inline
+	Overscroll_Behavior_X         Hash = 0x18c615 // overscroll-behavior-x
+	Overscroll_Behavior_Y         Hash = 0x18db15 // overscroll-behavior-y
+	P3                            Hash = 0x163602 // p3
+	Pad                           Hash = 0x5ec03  // pad
+	Padding                       Hash = 0x5ec07  // padding
+	Padding_Block                 Hash = 0x5ec0d  // padding-block
+	Padding_Block_End             Hash = 0x5ec11  // padding-block-end
+	Padding_Block_Start           Hash = 0x80813  // padding-block-start
+	Padding_Bottom                Hash = 0x8770e  // padding-bottom
+	Padding_Box                   Hash = 0x19d70b // padding-box
+	Padding_Inline                Hash = 0xa650e  // padding-inline
+	Padding_Inline_End            Hash = 0xa6512  // padding-inline-end
+	Padding_Inline_Start          Hash = 0xad214  // padding-inline-start
+	Padding_Left                  Hash = 0xb630c  // padding-left
+	Padding_Right                 Hash = 0xb9d0d  // padding-right
+	Padding_Top                   Hash = 0xbf40b  // padding-top
+	Page                          Hash = 0x4a104  // page
+	Page_Break_After              Hash = 0x4a110  // page-break-after
+	Page_Break_Before             Hash = 0x120311 // page-break-before
+	Page_Break_Inside             Hash = 0x17d711 // page-break-inside
+	Paint_Order                   Hash = 0x8d60b  // paint-order
+	Part                          Hash = 0x9404   // part
+	Past                          Hash = 0xf4504  // past
+	Paused                        Hash = 0x193e06 // paused
+	Pc                            Hash = 0xe6802  // pc
+	Perspective                   Hash = 0xf040b  // perspective
+	Perspective_Origin            Hash = 0xf0412  // perspective-origin
+	Picture_In_Picture            Hash = 0x65a12  // picture-in-picture
+	Place_Content                 Hash = 0xbfe0d  // place-content
+	Place_Items                   Hash = 0x156e0b // place-items
+	Place_Self                    Hash = 0x1a670a // place-self
+	Placeholder                   Hash = 0x8a20b  // placeholder
+	Placeholder_Shown             Hash = 0x8a211  // placeholder-shown
+	Playing                       Hash = 0x1bf507 // playing
+	Pointer                       Hash = 0x2e407  // pointer
+	Pointer_Events                Hash = 0x2e40e  // pointer-events
+	Popover_Open                  Hash = 0x18f00c // popover-open
+	Portrait                      Hash = 0x190e08 // portrait
+	Position                      Hash = 0x8808   // position
+	Position_Try                  Hash = 0xdad0c  // position-try
+	Pow                           Hash = 0x192103 // pow
+	Pre                           Hash = 0x6103   // pre
+	Pre_Line                      Hash = 0x192f08 // pre-line
+	Pre_Wrap                      Hash = 0x193708 // pre-wrap
+	Prefers_Color_Scheme          Hash = 0x194414 // prefers-color-scheme
+	Prefers_Contrast              Hash = 0x195810 // prefers-contrast
+	Prefers_Reduced_Data          Hash = 0x196e14 // prefers-reduced-data
+	Prefers_Reduced_Motion        Hash = 0x198416 // prefers-reduced-motion
+	Prefers_Reduced_Transparency  Hash = 0x199a1c // prefers-reduced-transparency
+	Prefix                        Hash = 0x19b606 // prefix
+	Print                         Hash = 0x19be05 // print
+	Print_Color_Adjust            Hash = 0x19be12 // print-color-adjust
+	Progress                      Hash = 0x19e208 // progress
+	Progressive                   Hash = 0x19e20b // progressive
+	Property                      Hash = 0xc1508  // property
+	Pt                            Hash = 0x4cf02  // pt
+	Px                            Hash = 0x6ee02  // px
+	Q                             Hash = 0x1c601  // q
+	Quotes                        Hash = 0x19ed06 // quotes
+	R                             Hash = 0x901    // r
+	Rad                           Hash = 0x25e03  // rad
+	Radial_Gradient               Hash = 0x17bb0f // radial-gradient
+	Range                         Hash = 0x33105  // range
+	Rcap                          Hash = 0x1ad404 // rcap
+	Rch                           Hash = 0x49c03  // rch
+	Read_Only                     Hash = 0xb909   // read-only
+	Read_Write                    Hash = 0x2fc0a  // read-write
+	Rec2020                       Hash = 0x66a07  // rec2020
+	Reduce                        Hash = 0x197606 // reduce
+	Relative                      Hash = 0x15c508 // relative
+	Rem                           Hash = 0x15403  // rem
+	Repeat                        Hash = 0x24c06  // repeat
+	Repeat_X                      Hash = 0x42208  // repeat-x
+	Repeat_Y                      Hash = 0x8ef08  // repeat-y
+	Repeating_Conic_Gradient      Hash = 0x16ee18 // repeating-conic-gradient
+	Repeating_Linear_Gradient     Hash = 0x24c19  // repeating-linear-gradient
+	Repeating_Radial_Gradient     Hash = 0x17b119 // repeating-radial-gradient
+	Required                      Hash = 0x121208 // required
+	Resize                        Hash = 0x14cb06 // resize
+	Resolution                    Hash = 0x17350a // resolution
+	Reverse                       Hash = 0x11207  // reverse
+	Revert                        Hash = 0x1a0806 // revert
+	Revert_Layer                  Hash = 0x1a080c // revert-layer
+	Rex                           Hash = 0x32903  // rex
+	Rgb                           Hash = 0x1aed03 // rgb
+	Rgba                          Hash = 0x1aed04 // rgba
+	Ric                           Hash = 0x14e203 // ric
+	Ridge                         Hash = 0x13005  // ridge
+	Right                         Hash = 0x51e05  // right
+	Rlh                           Hash = 0x15d403 // rlh
+	Root                          Hash = 0x11da04 // root
+	Rotate                        Hash = 0x20606  // rotate
+	Rotate3d                      Hash = 0x93108  // rotate3d
+	Rotatex                       Hash = 0x17fe07 // rotatex
+	Rotatey                       Hash = 0x20607  // rotatey
+	Rotatez                       Hash = 0x35c07  // rotatez
+	Round                         Hash = 0x17d05  // round
+	Row                           Hash = 0x37b03  // row
+	Row_Gap                       Hash = 0x11fd07 // row-gap
+	Row_Reverse                   Hash = 0x37b0b  // row-reverse
+	Rtl                           Hash = 0x116a03 // rtl
+	Ruby                          Hash = 0x43204  // ruby
+	Ruby_Align                    Hash = 0x4320a  // ruby-align
+	Ruby_Position                 Hash = 0x4b00d  // ruby-position
+	Run_In                        Hash = 0xa806   // run-in
+	Running                       Hash = 0x4f607  // running
+	Rx                            Hash = 0x8e002  // rx
+	Ry                            Hash = 0xdb702  // ry
+	S                             Hash = 0x1401   // s
+	Safe                          Hash = 0x1bd804 // safe
+	Sans_Serif                    Hash = 0x1b940a // sans-serif
+	Saturate                      Hash = 0xed108  // saturate
+	Scale                         Hash = 0x4705   // scale
+	Scale3d                       Hash = 0xb3f07  // scale3d
+	Scalex                        Hash = 0x16206  // scalex
+	Scaley                        Hash = 0x4706   // scaley
+	Scalez                        Hash = 0x22b06  // scalez
+	Scan                          Hash = 0x2b104  // scan
+	Scope                         Hash = 0x2f105  // scope
+	Screen                        Hash = 0x15b606 // screen
+	Scroll_Behavior               Hash = 0x187f0f // scroll-behavior
+	Scroll_Margin                 Hash = 0xd60d   // scroll-margin
+	Scroll_Margin_Block           Hash = 0x3a613  // scroll-margin-block
+	Scroll_Margin_Block_End       Hash = 0xe5117  // scroll-margin-block-end
+	Scroll_Margin_Block_Start     Hash = 0x3a619  // scroll-margin-block-start
+	Scroll_Margin_Bottom          Hash = 0x45f14  // scroll-margin-bottom
+	Scroll_Margin_Inline          Hash = 0x4d714  // scroll-margin-inline
+	Scroll_Margin_Inline_End      Hash = 0x4d718  // scroll-margin-inline-end
+	Scroll_Margin_Inline_Start    Hash = 0x5721a  // scroll-margin-inline-start
+	Scroll_Margin_Left            Hash = 0xd612   // scroll-margin-left
+	Scroll_Margin_Right           Hash = 0x51013  // scroll-margin-right
+	Scroll_Margin_Top             Hash = 0x5a911  // scroll-margin-top
+	Scroll_Padding                Hash = 0x5e50e  // scroll-padding
+	Scroll_Padding_Block          Hash = 0x5e514  // scroll-padding-block
+	Scroll_Padding_Block_End      Hash = 0x5e518  // scroll-padding-block-end
+	Scroll_Padding_Block_Start    Hash = 0x8011a  // scroll-padding-block-start
+	Scroll_Padding_Bottom         Hash = 0x87015  // scroll-padding-bottom
+	Scroll_Padding_Inline         Hash = 0xa5e15  // scroll-padding-inline
+	Scroll_Padding_Inline_End     Hash = 0xa5e19  // scroll-padding-inline-end
+	Scroll_Padding_Inline_Start   Hash = 0xacb1b  // scroll-padding-inline-start
+	Scroll_Padding_Left           Hash = 0xb5c13  // scroll-padding-left
+	Scroll_Padding_Right          Hash = 0xb9614  // scroll-padding-right
+	Scroll_Padding_Top            Hash = 0xbed12  // scroll-padding-top
+	Scroll_Snap_Align             Hash = 0xc3311  // scroll-snap-align
+	Scroll_Snap_Stop              Hash = 0xc4910  // scroll-snap-stop
+	Scroll_Snap_Type              Hash = 0xc9810  // scroll-snap-type
+	Scroll_Timeline               Hash = 0xcf80f  // scroll-timeline
+	Scroll_Timeline_Axis          Hash = 0xcf814  // scroll-timeline-axis
+	Scroll_Timeline_Name          Hash = 0xd0b14  // scroll-timeline-name
+	Scrollbar_Color               Hash = 0xf650f  // scrollbar-color
+	Scrollbar_Gutter              Hash = 0x102110 // scrollbar-gutter
+	Scrollbar_Width               Hash = 0x12630f // scrollbar-width
+	Seeking                       Hash = 0x11707  // seeking
+	Selection                     Hash = 0x76309  // selection
+	Self_End                      Hash = 0x154f08 // self-end
+	Self_Start                    Hash = 0x1a6d0a // self-start
+	Separate                      Hash = 0x7d508  // separate
+	Sepia                         Hash = 0xd2305  // sepia
+	Serif                         Hash = 0x1b9905 // serif
+	Shape_Image_Threshold         Hash = 0x12f215 // shape-image-threshold
+	Shape_Margin                  Hash = 0x13190c // shape-margin
+	Shape_Outside                 Hash = 0x13bd0d // shape-outside
+	Shape_Rendering               Hash = 0x13e20f // shape-rendering
+	Sign                          Hash = 0x143404 // sign
+	Sin                           Hash = 0x32d03  // sin
+	Size_Adjust                   Hash = 0x5270b  // size-adjust
+	Skew                          Hash = 0x144e04 // skew
+	Skewx                         Hash = 0x144e05 // skewx
+	Skewy                         Hash = 0x146305 // skewy
+	Slotted                       Hash = 0x151d07 // slotted
+	Slow                          Hash = 0x156304 // slow
+	Small                         Hash = 0x39d05  // small
+	Small_Caps                    Hash = 0x39d0a  // small-caps
+	Smaller                       Hash = 0x157807 // smaller
+	Solid                         Hash = 0x159305 // solid
+	Space                         Hash = 0x17605  // space
+	Space_Around                  Hash = 0x1760c  // space-around
+	Space_Between                 Hash = 0x1b7a0d // space-between
+	Space_Evenly                  Hash = 0x15ee0c // space-evenly
+	Span                          Hash = 0xd9e04  // span
+	Speak_As                      Hash = 0x164d08 // speak-as
+	Speech                        Hash = 0x166806 // speech
+	Spelling_Error                Hash = 0x18b80e // spelling-error
+	Sqrt                          Hash = 0x19f204 // sqrt
+	Square                        Hash = 0x1a0406 // square
+	Src                           Hash = 0x1ad303 // src
+	Srgb                          Hash = 0x1aec04 // srgb
+	Stalled                       Hash = 0x6e607  // stalled
+	Standalone                    Hash = 0xf470a  // standalone
+	Standard                      Hash = 0x196608 // standard
+	Start                         Hash = 0x3ba05  // start
+	Starting_Style                Hash = 0x122a0e // starting-style
+	StateHash                     Hash = 0x1d805  // state
+	Static                        Hash = 0x19ce06 // static
+	Step_End                      Hash = 0x53008  // step-end
+	Step_Start                    Hash = 0x19f60a // step-start
+	Steps                         Hash = 0x1a0005 // steps
+	Sticky                        Hash = 0x1a1406 // sticky
+	Stop_Color                    Hash = 0xc550a  // stop-color
+	Stop_Opacity                  Hash = 0x1a1a0c // stop-opacity
+	Stretch                       Hash = 0x13f607 // stretch
+	Stroke                        Hash = 0x1a2606 // stroke
+	Stroke_Box                    Hash = 0x1a260a // stroke-box
+	Stroke_Dasharray              Hash = 0x1a3010 // stroke-dasharray
+	Stroke_Dashoffset             Hash = 0x1a4011 // stroke-dashoffset
+	Stroke_Linecap                Hash = 0x1a5a0e // stroke-linecap
+	Stroke_Linejoin               Hash = 0x1a770f // stroke-linejoin
+	Stroke_Miterlimit             Hash = 0x1a9511 // stroke-miterlimit
+	Stroke_Opacity                Hash = 0x1aa60e // stroke-opacity
+	Stroke_Width                  Hash = 0x1ab40c // stroke-width
+	Subgrid                       Hash = 0x1ac007 // subgrid
+	Suffix                        Hash = 0x1adf06 // suffix
+	Supports                      Hash = 0x1ae508 // supports
+	Svb                           Hash = 0x1b0203 // svb
+	Svh                           Hash = 0x1b2003 // svh
+	Svi                           Hash = 0x1b2303 // svi
+	Svmax                         Hash = 0x1b4105 // svmax
+	Svmin                         Hash = 0x1b5205 // svmin
+	Svw                           Hash = 0x1b5e03 // svw
+	Symbols                       Hash = 0x4107   // symbols
+	Syntax                        Hash = 0x1bb106 // syntax
+	System                        Hash = 0x1b6c06 // system
+	System_Ui                     Hash = 0x1b6c09 // system-ui
+	Tab_Size                      Hash = 0x1008   // tab-size
+	Table                         Hash = 0x26405  // table
+	Table_Caption                 Hash = 0x132a0d // table-caption
+	Table_Cell                    Hash = 0xeea0a  // table-cell
+	Table_Column                  Hash = 0x2640c  // table-column
+	Table_Column_Group            Hash = 0x26412  // table-column-group
+	Table_Footer_Group            Hash = 0x7af12  // table-footer-group
+	Table_Header_Group            Hash = 0x8c512  // table-header-group
+	Table_Layout                  Hash = 0xdf80c  // table-layout
+	Table_Row                     Hash = 0xf3709  // table-row
+	Table_Row_Group               Hash = 0xf370f  // table-row-group
+	Tan                           Hash = 0xf4803  // tan
+	Target                        Hash = 0x9706   // target
+	Target_Text                   Hash = 0x970b   // target-text
+	Target_Within                 Hash = 0x3be0d  // target-within
+	Text                          Hash = 0x9e04   // text
+	Text_Align                    Hash = 0x6d90a  // text-align
+	Text_Align_Last               Hash = 0x6d90f  // text-align-last
+	Text_Anchor                   Hash = 0x9e0b   // text-anchor
+	Text_Combine_Upright          Hash = 0x180214 // text-combine-upright
+	Text_Decoration               Hash = 0x1db0f  // text-decoration
+	Text_Decoration_Color         Hash = 0x140815 // text-decoration-color
+	Text_Decoration_Line          Hash = 0x181f14 // text-decoration-line
+	Text_Decoration_Skip_Ink      Hash = 0x1db18  // text-decoration-skip-ink
+	Text_Decoration_Style         Hash = 0x30415  // text-decoration-style
+	Text_Decoration_Thickness     Hash = 0xe3919  // text-decoration-thickness
+	Text_Emphasis                 Hash = 0x48a0d  // text-emphasis
+	Text_Emphasis_Color           Hash = 0x48a13  // text-emphasis-color
+	Text_Emphasis_Position        Hash = 0x7db16  // text-emphasis-position
+	Text_Emphasis_Style           Hash = 0xf1a13  // text-emphasis-style
+	Text_Indent                   Hash = 0x8900b  // text-indent
+	Text_Justify                  Hash = 0xed70c  // text-justify
+	Text_Orientation              Hash = 0x27810  // text-orientation
+	Text_Overflow                 Hash = 0xfce0d  // text-overflow
+	Text_Rendering                Hash = 0x58b0e  // text-rendering
+	Text_Shadow                   Hash = 0xe70b   // text-shadow
+	Text_Size_Adjust              Hash = 0x52210  // text-size-adjust
+	Text_Transform                Hash = 0xaad0e  // text-transform
+	Text_Underline_Offset         Hash = 0x111a15 // text-underline-offset
+	Text_Underline_Position       Hash = 0x81a17  // text-underline-position
+	Text_Wrap                     Hash = 0x89a09  // text-wrap
+	Thick                         Hash = 0xe4905  // thick
+	Thin                          Hash = 0x3c704  // thin
+	To                            Hash = 0x702    // to
+	Top                           Hash = 0x5b703  // top
+	Touch_Action                  Hash = 0x11a10c // touch-action
+	Transform                     Hash = 0x3f309  // transform
+	Transform_Box                 Hash = 0xab20d  // transform-box
+	Transform_Origin              Hash = 0x124e10 // transform-origin
+	Transform_Style               Hash = 0x3f30f  // transform-style
+	Transition                    Hash = 0xae50a  // transition
+	Transition_Behavior           Hash = 0xae513  // transition-behavior
+	Transition_Delay              Hash = 0xb6e10  // transition-delay
+	Transition_Duration           Hash = 0xba913  // transition-duration
+	Transition_Property           Hash = 0xc0a13  // transition-property
+	Transition_Timing_Function    Hash = 0x11dd1a // transition-timing-function
+	Translate                     Hash = 0x135409 // translate
+	Translate3d                   Hash = 0x13540b // translate3d
+	Translatex                    Hash = 0x14010a // translatex
+	Translatey                    Hash = 0x14ae0a // translatey
+	Translatez                    Hash = 0x152e0a // translatez
+	Transparent                   Hash = 0x16900b // transparent
+	Turn                          Hash = 0x169a04 // turn
+	Ui_Monospace                  Hash = 0x1b730c // ui-monospace
+	Ui_Rounded                    Hash = 0x1b870a // ui-rounded
+	Ui_Sans_Serif                 Hash = 0x1b910d // ui-sans-serif
+	Ui_Serif                      Hash = 0x1ba508 // ui-serif
+	Underline                     Hash = 0x81f09  // underline
+	Unicode_Bidi                  Hash = 0x1bb70c // unicode-bidi
+	Unicode_Range                 Hash = 0x1bc90d // unicode-range
+	Unsafe                        Hash = 0x1bd606 // unsafe
+	Unset                         Hash = 0x1bdc05 // unset
+	Update                        Hash = 0x27406  // update
+	Uppercase                     Hash = 0x7bf09  // uppercase
+	Url                           Hash = 0x4c403  // url
+	User_Invalid                  Hash = 0x7320c  // user-invalid
+	User_Select                   Hash = 0x75e0b  // user-select
+	User_Valid                    Hash = 0x8440a  // user-valid
+	Valid                         Hash = 0x73905  // valid
+	Var                           Hash = 0x143d03 // var
+	Vb                            Hash = 0x3d902  // vb
+	Vector_Effect                 Hash = 0x40d    // vector-effect
+	Vertical_Align                Hash = 0x10c70e // vertical-align
+	Vertical_Lr                   Hash = 0x9cb0b  // vertical-lr
+	Vertical_Rl                   Hash = 0x15cb0b // vertical-rl
+	Vh                            Hash = 0x4ef02  // vh
+	Vi                            Hash = 0x1b202  // vi
+	View_Box                      Hash = 0x73e08  // view-box
+	View_Timeline                 Hash = 0x163c0d // view-timeline
+	View_Timeline_Axis            Hash = 0x163c12 // view-timeline-axis
+	View_Timeline_Inset           Hash = 0x1b2413 // view-timeline-inset
+	View_Timeline_Name            Hash = 0x18fc12 // view-timeline-name
+	View_Transition               Hash = 0x1be10f // view-transition
+	View_Transition_Group         Hash = 0x1be115 // view-transition-group
+	View_Transition_Image_Pair    Hash = 0x1bfc1a // view-transition-image-pair
+	View_Transition_Name          Hash = 0x1c1614 // view-transition-name
+	View_Transition_New           Hash = 0x1c2a13 // view-transition-new
+	View_Transition_Old           Hash = 0x1c3d13 // view-transition-old
+	Visibility                    Hash = 0x108d0a // visibility
+	Visible                       Hash = 0x129007 // visible
+	Visited                       Hash = 0x1c5007 // visited
+	Vmax                          Hash = 0x53804  // vmax
+	Vmin                          Hash = 0x5fd04  // vmin
+	Volume_Locked                 Hash = 0x1c570d // volume-locked
+	Vw                            Hash = 0x84e02  // vw
+	Wait                          Hash = 0x7ac04  // wait
+	Where                         Hash = 0x2f905  // where
+	White_Space                   Hash = 0x10530b // white-space
+	White_Space_Collapse          Hash = 0x105314 // white-space-collapse
+	Widows                        Hash = 0xd106   // widows
+	Width                         Hash = 0x61d05  // width
+	Will_Change                   Hash = 0x84f0b  // will-change
+	Word_Break                    Hash = 0x16a00a // word-break
+	Word_Spacing                  Hash = 0x19230c // word-spacing
+	Word_Wrap                     Hash = 0x156609 // word-wrap
+	Wrap                          Hash = 0x9104   // wrap
+	Wrap_Reverse                  Hash = 0x123d0c // wrap-reverse
+	Writing_Mode                  Hash = 0x1b600c // writing-mode
+	X                             Hash = 0xa001   // x
+	X_Large                       Hash = 0x42b07  // x-large
+	X_Small                       Hash = 0x39b07  // x-small
+	Xx_Large                      Hash = 0x42a08  // xx-large
+	Xx_Small                      Hash = 0x39a08  // xx-small
+	Xxx_Large                     Hash = 0x42909  // xxx-large
+	Y                             Hash = 0x3701   // y
+	Z_Index                       Hash = 0x2d907  // z-index
+	Zoom                          Hash = 0x36204  // zoom
 )
 
 // String returns the hash' name.
@@ -61,15 +992,1059 @@ NEXT:
 	return 0
 }
 
-const _Hash_hash0 = 0x9acb0442
-const _Hash_maxLen = 9
-const _Hash_text = "documentfont-facekeyframesupportsmediapage"
+const _Hash_hash0 = 0x881ce627
+const _Hash_maxLen = 29
+const _Hash_text = "activector-effectab-sizelementh-last-column-fill-opacityadditive" +
+	"-symbolscaleyanimation-durationo-preferencellipsisolationormalis" +
+	"t-style-positionowrapartarget-text-anchorun-inline-grid-aread-on" +
+	"ly-childrop-shadowidowscroll-margin-leftext-shadowanimation-fill" +
+	"-modecimalternate-reverseekingrabbingrammar-erroridgeanimation-i" +
+	"teration-counter-incrementh-childiscalexanimation-namespace-arou" +
+	"ndocumenth-last-childominant-baseline-gap-overridevice-aspect-ra" +
+	"tiobliqueanimation-play-statext-decoration-skip-inkeep-allightin" +
+	"g-colorotateyanimation-timeline-break-spacescalezanimation-timin" +
+	"g-functiono-repeating-linear-gradientable-column-groupdatext-ori" +
+	"entationot-allowedoubleany-hoverflow-blockeyframescanimation-dir" +
+	"ectionly-of-typeany-linkhz-indexany-pointer-eventscopeanywheread" +
+	"-writext-decoration-stylease-outline-colorexasin-rangeautofill-b" +
+	"ox-decoration-break-allighterotatezoomask-border-block-colorow-r" +
+	"eversebackground-position-xx-small-capscroll-margin-block-starta" +
+	"rget-withinset-inline-endvbackground-blend-modefaultransform-sty" +
+	"lebackground-position-ybackground-repeat-xxx-largeruby-align-con" +
+	"tent-boxbackground-sizebackwardscroll-margin-bottomask-clip-rule" +
+	"base-palettext-emphasis-colorchelpage-break-afteruby-positionbla" +
+	"nkblurlist-itempty-cellscroll-margin-inline-endvhbolderunningrid" +
+	"-template-columnscroll-margin-rightext-size-adjustep-endvmax-blo" +
+	"ck-sizeborder-block-end-color-interpolation-filterscroll-margin-" +
+	"inline-startext-renderingrid-template-rowscroll-margin-toptional" +
+	"ist-style-typeborder-block-end-stylesscroll-padding-block-endvmi" +
+	"n-block-sizeborder-block-end-widthiddenseborder-block-start-colo" +
+	"r-mix-blend-modefinedottedpicture-in-picturec2020border-block-st" +
+	"art-styleborder-block-start-widthighlight-darkborder-block-style" +
+	"border-block-widthost-context-align-lastalledppxborder-bottom-co" +
+	"lor-profile-selector-buttonborder-bottom-left-radiuser-invalidvi" +
+	"ew-boxborder-bottom-right-radiuser-selectionborder-bottom-styleb" +
+	"order-bottom-widthslandscapeborder-box-shadowaitable-footer-grou" +
+	"ppercaseborder-collapseparatext-emphasis-positionborder-colorpha" +
+	"nscroll-padding-block-startext-underline-positionborder-end-end-" +
+	"radiuser-validvwill-changeborder-end-start-radiuscroll-padding-b" +
+	"ottomask-compositext-indentext-wraplaceholder-shownborder-image-" +
+	"outsetable-header-groupaint-orderxborder-image-repeat-yborder-im" +
+	"age-sliceborder-image-sourceborder-image-widthue-rotate3dynamic-" +
+	"rangeborder-inline-colorborder-inline-end-colorborder-inline-end" +
+	"-styleborder-inline-end-widthwbackground-clip-pathanging-punctua" +
+	"tiononegativertical-lrborder-inline-start-colorborder-inline-sta" +
+	"rt-styleborder-inline-start-widthyphenate-characterborder-inline" +
+	"-styleborder-inline-widthyphenscroll-padding-inline-endborder-le" +
+	"ft-colorborder-left-styleborder-left-widthypotext-transform-boxb" +
+	"order-radiuscroll-padding-inline-startransition-behaviorborder-r" +
+	"ight-colorborder-right-styleborder-right-widthborder-spacingrays" +
+	"cale3dborder-start-end-radiuscroll-padding-leftransition-delaybo" +
+	"rder-start-start-radiuscroll-padding-rightransition-durationbord" +
+	"er-styleborder-top-colorborder-top-left-radiuscroll-padding-topl" +
+	"ace-contentransition-propertyborder-top-right-radiuscroll-snap-a" +
+	"lign-itemscroll-snap-stop-colorborder-top-styleborder-top-widthb" +
+	"order-widthbothbrightnesscroll-snap-typebrowserbufferingrid-auto" +
+	"-flowercaseclosedclosest-cornerclosest-sidegrid-auto-rowscroll-t" +
+	"imeline-axiscroll-timeline-namecoarsepiaccent-color-gamutedashed" +
+	"column-gappearancentercolumn-reversecolumn-rule-colorcolumn-rule" +
+	"-stylecolumn-rule-widthcolumn-spanimation-composition-trycolumn-" +
+	"widthcontain-intrinsic-block-sizecontain-intrinsic-heightable-la" +
+	"youtline-offset-anchorizontal-tbackground-origindeterminatext-de" +
+	"coration-thicknesscroll-margin-block-endpcmarker-endcontain-intr" +
+	"insic-inline-sizecontain-intrinsic-sizecontain-intrinsic-widthco" +
+	"ntainer-namediacosaturatext-justify-contentable-cellipsecontaine" +
+	"r-typerspective-originfinitext-emphasis-stylecounter-setable-row" +
+	"-groupastandalonecounter-stylecounterscrollbar-colorcoverflow-cl" +
+	"ip-margin-box-sizingrid-column-endcqbackground-attachmenth-colum" +
+	"n-counter-resetext-overflow-anchorcqhcqimportanth-last-of-typecq" +
+	"max-aspect-ratiobject-fit-contentscrollbar-guttercqmin-aspect-ra" +
+	"tiobject-positioncqwhite-space-collapsecross-fadescent-overridet" +
+	"ails-content-visibilitycrosshaircubic-beziercue-regioncurrentcol" +
+	"orcursivertical-align-selfallbackdrop-filtercursorcxfarthest-cor" +
+	"nerfarthest-sidevice-heightext-underline-offset-pathfill-rulefir" +
+	"st-childfirst-letter-spacingrid-column-startlist-style-image-ren" +
+	"deringrid-row-endfirst-line-heightouch-actionfirst-of-typeflex-d" +
+	"irectionflex-endflex-flow-rootransition-timing-functionflex-grow" +
+	"-gapage-break-beforequiredflex-shrinkflex-starting-styleflex-wra" +
+	"p-reversefloattransform-originheritscrollbar-widthflood-colorflo" +
+	"od-opacityfocus-visiblefocus-withinitial-letterfont-display-mode" +
+	"vice-widthfont-facefont-familyfont-feature-settingshape-image-th" +
+	"resholdfont-feature-valueshape-marginline-table-caption-sidefont" +
+	"-kerningrid-row-startranslate3dfont-language-overridefont-optica" +
+	"l-sizingrid-template-arease-in-out-of-rangefont-palette-valuesha" +
+	"pe-outsidefont-size-adjustify-itemshape-renderingfont-stretchars" +
+	"etranslatext-decoration-colorfont-stylefont-synthesisignfont-var" +
+	"iant-alternateskewxfont-variant-capskewyfont-variant-east-asiani" +
+	"mation-delayfont-variant-emojinset-inline-startranslateyfont-var" +
+	"iant-ligaturesizefont-variant-numericalcaret-colorfont-variant-p" +
+	"ositionfont-variation-settingslottedfont-weightranslatezforced-c" +
+	"olor-adjustify-self-endforced-colorsloword-wraplace-itemsmallerf" +
+	"ormath-depthforwardsolidfromarker-middlefull-widthfullscreenable" +
+	"dfuturelativertical-rlhinterlaceinverted-colorspace-evenlylocal-" +
+	"linklogrooverflow-inline-blocklvbackground-color-indexp3lvhlview" +
+	"-timeline-axispeak-ascent-override-colorspeechlvmax-contenth-of-" +
+	"typelvmin-contentransparenturnlvword-break-wordmask-image-setmas" +
+	"k-modemask-originitial-valuemask-positionmask-repeating-conic-gr" +
+	"adientmask-sizemask-typemasonrymath-stylematrix3dmax-resolutionm" +
+	"ax-widthmin-inline-sizemin-resolutionmin-widthminmax-heightmodal" +
+	"ignment-baseline-shiftmonochromediumarker-startmorepeating-radia" +
+	"l-gradientmoverflow-wrapage-break-insideoffset-positionoffset-ro" +
+	"tatext-combine-uprightoklabsolutext-decoration-lineoklcheckedout" +
+	"line-styleoutline-widthoverflow-xoverflow-yoverline-throughovers" +
+	"croll-behavior-blockoverscroll-behavior-inline-flex-basispelling" +
+	"-erroroverscroll-behavior-xoverscroll-behavior-ypopover-openview" +
+	"-timeline-nameportraitalicirclearpoword-spacingpre-linepre-wrapa" +
+	"usedprefers-color-schemeprefers-contrastandardprefers-reduced-da" +
+	"tan2prefers-reduced-motionprefers-reduced-transparencyprefixedpr" +
+	"int-color-adjustaticlampadding-boxprogressivequotesqrtstep-start" +
+	"stepsquarevert-layerstickystop-opacitystroke-boxstroke-dasharray" +
+	"stroke-dashoffset-distancestroke-linecaplace-self-startstroke-li" +
+	"nejoinset-block-startstroke-miterlimitstroke-opacitystroke-width" +
+	"subgrid-auto-columnsrcapitalizesuffixsupportsrgbackface-visibili" +
+	"tysvbackground-image-orientationsvhsview-timeline-inset-block-en" +
+	"dsvmax-inline-sizesvmin-heightsvwriting-modesystem-ui-monospace-" +
+	"betweenui-roundedui-sans-serifangsongui-serifantasyntaxunicode-b" +
+	"idisabledunicode-rangeunsafeunsetview-transition-grouplayingview" +
+	"-transition-image-pairview-transition-nameview-transition-newvie" +
+	"w-transition-oldvisitedvolume-locked"
 
-var _Hash_table = [1 << 3]Hash{
-	0x1: 0x2604, // page
-	0x2: 0x2105, // media
-	0x3: 0x809,  // font-face
-	0x5: 0x1109, // keyframes
-	0x6: 0x1908, // supports
-	0x7: 0x8,    // document
+var _Hash_table = [1 << 11]Hash{
+	0x1:   0x47704,  // clip
+	0x8:   0xcc209,  // lowercase
+	0xa:   0x15a206, // middle
+	0xc:   0x191906, // circle
+	0xe:   0x8770e,  // padding-bottom
+	0xf:   0xffe05,  // cqmax
+	0x10:  0x157f06, // format
+	0x11:  0x16860b, // min-content
+	0x15:  0xe5810,  // margin-block-end
+	0x17:  0xe5117,  // scroll-margin-block-end
+	0x19:  0x4a60b,  // break-after
+	0x1c:  0x73d03,  // dvi
+	0x1e:  0xb6e10,  // transition-delay
+	0x20:  0x1b4105, // svmax
+	0x22:  0x15ee0c, // space-evenly
+	0x24:  0x11290b, // offset-path
+	0x28:  0x1ad303, // src
+	0x2a:  0x191406, // italic
+	0x2b:  0x45709,  // backwards
+	0x2d:  0x148c12, // font-variant-emoji
+	0x2f:  0x2f105,  // scope
+	0x32:  0x13bd0d, // shape-outside
+	0x33:  0x170609, // mask-size
+	0x34:  0x4cd05,  // empty
+	0x35:  0x163c0d, // view-timeline
+	0x37:  0x139707, // ease-in
+	0x3a:  0x183503, // lch
+	0x3b:  0x18db15, // overscroll-behavior-y
+	0x3c:  0x8ba05,  // image
+	0x3d:  0x12250a, // flex-start
+	0x3e:  0x10160b, // fit-content
+	0x40:  0x53d0a,  // block-size
+	0x41:  0x196608, // standard
+	0x42:  0xcf80f,  // scroll-timeline
+	0x43:  0x15820a, // math-depth
+	0x46:  0x18108,  // document
+	0x47:  0x21b0a,  // line-break
+	0x4b:  0x11da04, // root
+	0x4d:  0x17480f, // min-inline-size
+	0x4e:  0x4bd05,  // blank
+	0x4f:  0x45f14,  // scroll-margin-bottom
+	0x51:  0xf8a0a,  // box-sizing
+	0x52:  0x12630f, // scrollbar-width
+	0x54:  0xa5e15,  // scroll-padding-inline
+	0x55:  0x1c914,  // animation-play-state
+	0x56:  0x14ae0a, // translatey
+	0x59:  0xbbc0c,  // border-style
+	0x5b:  0x18610a, // overflow-y
+	0x5e:  0x84d03,  // dvw
+	0x60:  0x11ba04, // flex
+	0x63:  0x5270b,  // size-adjust
+	0x69:  0x14907,  // counter
+	0x6d:  0x37b03,  // row
+	0x6e:  0xac06,   // inline
+	0x71:  0xf7405,  // cover
+	0x74:  0x9a909,  // clip-path
+	0x75:  0x16c60b, // mask-origin
+	0x77:  0x73e08,  // view-box
+	0x7a:  0x5ec11,  // padding-block-end
+	0x7f:  0x1b013,  // device-aspect-ratio
+	0x82:  0xf4803,  // tan
+	0x85:  0xb9614,  // scroll-padding-right
+	0x86:  0x181f14, // text-decoration-line
+	0x89:  0x61d05,  // width
+	0x8a:  0x52210,  // text-size-adjust
+	0x8b:  0x15b606, // screen
+	0x8f:  0x144e05, // skewx
+	0x91:  0x1ac311, // grid-auto-columns
+	0x97:  0xbc80a,  // border-top
+	0x9e:  0x17570e, // min-resolution
+	0xa1:  0x1c0c,   // nth-last-col
+	0xa5:  0xdd0b,   // margin-left
+	0xa6:  0x1b6c06, // system
+	0xa7:  0x22b06,  // scalez
+	0xa8:  0x1a603,  // gap
+	0xa9:  0x2004,   // last
+	0xaa:  0xd5006,  // center
+	0xb4:  0x153813, // forced-color-adjust
+	0xb5:  0x169e03, // lvw
+	0xb9:  0x1b870a, // ui-rounded
+	0xbb:  0x7009,   // isolation
+	0xbe:  0x40d,    // vector-effect
+	0xc2:  0x12ca09, // font-face
+	0xc4:  0x4107,   // symbols
+	0xc7:  0x1a2606, // stroke
+	0xc9:  0xfa415,  // background-attachment
+	0xca:  0xc6f10,  // border-top-width
+	0xcd:  0x132a0d, // table-caption
+	0xce:  0x10670a, // cross-fade
+	0xd0:  0xeea0a,  // table-cell
+	0xd1:  0xf1a13,  // text-emphasis-style
+	0xd2:  0xebe09,  // container
+	0xdb:  0x10dd0f, // backdrop-filter
+	0xdf:  0x65004,  // fine
+	0xe0:  0x1a670a, // place-self
+	0xe1:  0x160606, // groove
+	0xe2:  0xb630c,  // padding-left
+	0xe7:  0x13519,  // animation-iteration-count
+	0xea:  0x141d0a, // font-style
+	0xec:  0x11fd07, // row-gap
+	0xee:  0xf5e08,  // counters
+	0xf0:  0x15c106, // future
+	0xf1:  0x19f204, // sqrt
+	0xf4:  0xe1f11,  // background-origin
+	0xf5:  0x62918,  // border-block-start-color
+	0xf8:  0x57913,  // margin-inline-start
+	0xf9:  0x6,      // active
+	0xfa:  0x1aec04, // srgb
+	0xfb:  0x7f10c,  // border-color
+	0xfc:  0xd60d,   // scroll-margin
+	0xfd:  0xfce0d,  // text-overflow
+	0xfe:  0x18b80e, // spelling-error
+	0x102: 0x1db18,  // text-decoration-skip-ink
+	0x107: 0x62106,  // hidden
+	0x10f: 0x113409, // fill-rule
+	0x110: 0x3ed07,  // default
+	0x111: 0x16bd09, // mask-mode
+	0x113: 0x4cf02,  // pt
+	0x114: 0x4370d,  // align-content
+	0x118: 0x70714,  // file-selector-button
+	0x119: 0x1bc108, // disabled
+	0x11a: 0x146817, // font-variant-east-asian
+	0x11b: 0x7c80f,  // border-collapse
+	0x11d: 0x3ad0c,  // margin-block
+	0x121: 0x19411,  // dominant-baseline
+	0x123: 0xd612,   // scroll-margin-left
+	0x128: 0x181908, // absolute
+	0x132: 0x92d0a,  // hue-rotate
+	0x137: 0x42909,  // xxx-large
+	0x139: 0x5ec07,  // padding
+	0x13b: 0x195810, // prefers-contrast
+	0x13c: 0x1a4b0f, // offset-distance
+	0x13e: 0x171807, // masonry
+	0x141: 0x6f00d,  // border-bottom
+	0x143: 0x1b430f, // max-inline-size
+	0x144: 0x13f607, // stretch
+	0x145: 0x144e04, // skew
+	0x146: 0x1c207,  // oblique
+	0x147: 0x136404, // lang
+	0x148: 0x6a40a,  // light-dark
+	0x149: 0x9d613,  // border-inline-start
+	0x14b: 0x135409, // translate
+	0x14d: 0xc70b,   // drop-shadow
+	0x14f: 0x81a17,  // text-underline-position
+	0x154: 0x15809,  // nth-child
+	0x157: 0x7a30a,  // box-shadow
+	0x158: 0x14d114, // font-variant-numeric
+	0x159: 0x14270e, // font-synthesis
+	0x15a: 0x7320c,  // user-invalid
+	0x15e: 0xedc0f,  // justify-content
+	0x160: 0x32c04,  // asin
+	0x162: 0x2c0c,   // fill-opacity
+	0x165: 0xef80e,  // container-type
+	0x167: 0x4705,   // scale
+	0x168: 0x12b40c, // display-mode
+	0x169: 0x12de15, // font-feature-settings
+	0x16a: 0x121a0b, // flex-shrink
+	0x16d: 0x3d803,  // dvb
+	0x171: 0x186f0c, // line-through
+	0x173: 0x5390e,  // max-block-size
+	0x174: 0x146305, // skewy
+	0x176: 0x17fe07, // rotatex
+	0x17a: 0x35607,  // lighter
+	0x180: 0x176509, // min-width
+	0x181: 0x8b30c,  // border-image
+	0x182: 0x18fc12, // view-timeline-name
+	0x183: 0x93108,  // rotate3d
+	0x186: 0x10307,  // decimal
+	0x187: 0x50a07,  // columns
+	0x18a: 0x25e03,  // rad
+	0x18d: 0x1ab40c, // stroke-width
+	0x190: 0x17f70d, // offset-rotate
+	0x196: 0x59812,  // grid-template-rows
+	0x198: 0x123d0c, // wrap-reverse
+	0x19a: 0x2560f,  // linear-gradient
+	0x19e: 0xecf03,  // cos
+	0x19f: 0x4fc15,  // grid-template-columns
+	0x1a2: 0x143404, // sign
+	0x1a3: 0x76309,  // selection
+	0x1a5: 0x3a303,  // cap
+	0x1a6: 0x183d0d, // outline-style
+	0x1a7: 0x14e404, // calc
+	0x1a8: 0x177e12, // alignment-baseline
+	0x1a9: 0x39d05,  // small
+	0x1ab: 0x1b5205, // svmin
+	0x1ac: 0x51013,  // scroll-margin-right
+	0x1af: 0x4ee03,  // dvh
+	0x1b0: 0xc7f0c,  // border-width
+	0x1b1: 0x187b19, // overscroll-behavior-block
+	0x1b3: 0x11d009, // flex-flow
+	0x1b4: 0x5ec0d,  // padding-block
+	0x1b6: 0x156609, // word-wrap
+	0x1b9: 0x4b00d,  // ruby-position
+	0x1bb: 0x1a111,  // line-gap-override
+	0x1bd: 0x5e514,  // scroll-padding-block
+	0x1be: 0xe130d,  // horizontal-tb
+	0x1c1: 0x9450d,  // border-inline
+	0x1c2: 0x159b0a, // marker-mid
+	0x1c6: 0xfe706,  // import
+	0x1c8: 0x179e06, // medium
+	0x1ca: 0x1be10f, // view-transition
+	0x1d1: 0x11770f, // image-rendering
+	0x1d5: 0x197606, // reduce
+	0x1d7: 0x15e006, // invert
+	0x1d8: 0xb309,   // grid-area
+	0x1da: 0x1c570d, // volume-locked
+	0x1db: 0x18fa03, // env
+	0x1dc: 0xbed12,  // scroll-padding-top
+	0x1dd: 0x1ad404, // rcap
+	0x1df: 0x1240d,  // grammar-error
+	0x1e0: 0x100010, // max-aspect-ratio
+	0x1e1: 0x193708, // pre-wrap
+	0x1e2: 0x17960a, // monochrome
+	0x1e3: 0x113d05, // first
+	0x1e5: 0x17350a, // resolution
+	0x1e7: 0xebe0e,  // container-name
+	0x1e8: 0x17af04, // more
+	0x1ec: 0x1b9d08, // fangsong
+	0x1ef: 0xd2305,  // sepia
+	0x1f1: 0x17ca04, // move
+	0x1f6: 0x20205,  // color
+	0x1f8: 0x110e0d, // device-height
+	0x1f9: 0x48a0d,  // text-emphasis
+	0x1fa: 0x162b0b, // color-index
+	0x1fb: 0x159804, // from
+	0x1fc: 0x79309,  // landscape
+	0x1fe: 0x16206,  // scalex
+	0x200: 0x4c403,  // url
+	0x203: 0x3a613,  // scroll-margin-block
+	0x204: 0x13ab0c, // font-palette
+	0x205: 0x1d805,  // state
+	0x206: 0x62405,  // dense
+	0x207: 0x160403, // log
+	0x209: 0xdb90c,  // column-width
+	0x20c: 0x1bb106, // syntax
+	0x20d: 0x24c19,  // repeating-linear-gradient
+	0x20f: 0xc8f0a,  // brightness
+	0x211: 0xc3311,  // scroll-snap-align
+	0x212: 0x32903,  // rex
+	0x213: 0x15a80a, // full-width
+	0x215: 0x10d908, // fallback
+	0x217: 0x111a15, // text-underline-offset
+	0x21a: 0xbe0a,   // only-child
+	0x21e: 0x118508, // grid-row
+	0x21f: 0xd270c,  // accent-color
+	0x221: 0x121208, // required
+	0x222: 0xe6802,  // pc
+	0x223: 0x31d07,  // outline
+	0x224: 0x33604,  // auto
+	0x225: 0xa806,   // run-in
+	0x227: 0x12af04, // font
+	0x228: 0x2c40c,  // only-of-type
+	0x229: 0x147d0f, // animation-delay
+	0x22b: 0xa5e19,  // scroll-padding-inline-end
+	0x22e: 0x2f905,  // where
+	0x233: 0x4d714,  // scroll-margin-inline
+	0x235: 0x1ba508, // ui-serif
+	0x239: 0x4d09,   // animation
+	0x23a: 0x4706,   // scaley
+	0x23c: 0x47209,  // mask-clip
+	0x23e: 0x11d509, // flow-root
+	0x23f: 0xa7711,  // border-left-color
+	0x240: 0x27810,  // text-orientation
+	0x241: 0x16b409, // image-set
+	0x242: 0x1bd804, // safe
+	0x243: 0x9cb0b,  // vertical-lr
+	0x245: 0x4c609,  // list-item
+	0x247: 0x1aa60e, // stroke-opacity
+	0x248: 0x98617,  // border-inline-end-width
+	0x24a: 0x25606,  // linear
+	0x24d: 0x10ac0a, // cue-region
+	0x252: 0x159305, // solid
+	0x253: 0x19b905, // fixed
+	0x255: 0x197f05, // atan2
+	0x257: 0x6d10c,  // host-context
+	0x25b: 0xd7511,  // column-rule-style
+	0x25c: 0x15ba07, // enabled
+	0x25e: 0x2c04,   // fill
+	0x25f: 0xbe04,   // only
+	0x261: 0x169a04, // turn
+	0x262: 0x42d05,  // large
+	0x263: 0x15d403, // rlh
+	0x266: 0x5b703,  // top
+	0x268: 0x139f0c, // out-of-range
+	0x26e: 0xcdf0c,  // closest-side
+	0x270: 0x113d0b, // first-child
+	0x271: 0x6d104,  // host
+	0x272: 0x7d508,  // separate
+	0x273: 0x32d03,  // sin
+	0x278: 0x10b607, // current
+	0x279: 0x101a08, // contents
+	0x27c: 0x166806, // speech
+	0x27e: 0x901,    // r
+	0x27f: 0x8c512,  // table-header-group
+	0x280: 0x5e204,  // less
+	0x281: 0x157807, // smaller
+	0x282: 0x5e518,  // scroll-padding-block-end
+	0x285: 0x192f08, // pre-line
+	0x286: 0x165a0f, // override-colors
+	0x287: 0x15403,  // rem
+	0x28e: 0x12080c, // break-before
+	0x290: 0x4660d,  // margin-bottom
+	0x291: 0xbd816,  // border-top-left-radius
+	0x295: 0x17880e, // baseline-shift
+	0x299: 0x80813,  // padding-block-start
+	0x29c: 0xae513,  // transition-behavior
+	0x29d: 0xe010e,  // outline-offset
+	0x29e: 0x16120c, // inline-block
+	0x29f: 0x23119,  // animation-timing-function
+	0x2a0: 0x9c508,  // negative
+	0x2a1: 0xde118,  // contain-intrinsic-height
+	0x2a6: 0x2bd03,  // dir
+	0x2a8: 0x28703,  // not
+	0x2a9: 0x16cf0d, // initial-value
+	0x2af: 0x65406,  // dotted
+	0x2b0: 0x4f104,  // bold
+	0x2b1: 0xfd30f,  // overflow-anchor
+	0x2b3: 0x1ad50a, // capitalize
+	0x2b5: 0x163b03, // lvi
+	0x2b8: 0x71b19,  // border-bottom-left-radius
+	0x2b9: 0xaad0e,  // text-transform
+	0x2bb: 0xfc20d,  // counter-reset
+	0x2bc: 0x11030d, // farthest-side
+	0x2bd: 0x79c0a,  // border-box
+	0x2bf: 0xfe709,  // important
+	0x2c1: 0xdd06,   // margin
+	0x2c2: 0x1b7a0d, // space-between
+	0x2c6: 0x1c1614, // view-transition-name
+	0x2c9: 0xb9d0d,  // padding-right
+	0x2ce: 0x19e20b, // progressive
+	0x2d0: 0x2bd09,  // direction
+	0x2d2: 0x6fe0d,  // color-profile
+	0x2d4: 0x65a12,  // picture-in-picture
+	0x2d5: 0x7bf09,  // uppercase
+	0x2d6: 0xc1508,  // property
+	0x2d9: 0xacb1b,  // scroll-padding-inline-start
+	0x2da: 0x9404,   // part
+	0x2db: 0x8e213,  // border-image-repeat
+	0x2df: 0x14cb06, // resize
+	0x2e0: 0x177b05, // modal
+	0x2e1: 0xc550a,  // stop-color
+	0x2e2: 0x2d907,  // z-index
+	0x2e3: 0x6d90f,  // text-align-last
+	0x2e5: 0x127d0d, // flood-opacity
+	0x2e6: 0x158c08, // forwards
+	0x2e9: 0x176e06, // minmax
+	0x2ea: 0xfe503,  // cqi
+	0x2ec: 0xc4910,  // scroll-snap-stop
+	0x2ef: 0x10c207, // cursive
+	0x2f0: 0x16af0a, // mask-image
+	0x2f2: 0x9e04,   // text
+	0x2f5: 0x1bd606, // unsafe
+	0x2f7: 0xad214,  // padding-inline-start
+	0x2fa: 0x19603,  // min
+	0x2ff: 0x27406,  // update
+	0x300: 0xcd10e,  // closest-corner
+	0x303: 0x6420e,  // mix-blend-mode
+	0x305: 0x49203,  // has
+	0x307: 0x4d718,  // scroll-margin-inline-end
+	0x30a: 0x16dc0d, // mask-position
+	0x30b: 0x3c905,  // inset
+	0x30d: 0x5ce16,  // border-block-end-style
+	0x312: 0x2200c,  // break-spaces
+	0x314: 0x2a909,  // keyframes
+	0x315: 0x15e00f, // inverted-colors
+	0x317: 0x13e20f, // shape-rendering
+	0x318: 0x2a505,  // block
+	0x31a: 0x164d08, // speak-as
+	0x31b: 0xe9116,  // contain-intrinsic-size
+	0x31c: 0x84e02,  // vw
+	0x31d: 0x31d0d,  // outline-color
+	0x31e: 0x802,    // or
+	0x31f: 0x1a1a0c, // stop-opacity
+	0x321: 0x154f08, // self-end
+	0x323: 0x14e203, // ric
+	0x324: 0x151d07, // slotted
+	0x325: 0xeca05,  // media
+	0x328: 0x10420f, // object-position
+	0x32b: 0x6a004,  // high
+	0x32c: 0x4cd0b,  // empty-cells
+	0x32e: 0x18a80b, // inline-flex
+	0x330: 0x43d07,  // content
+	0x331: 0x4de0d,  // margin-inline
+	0x333: 0x1b600c, // writing-mode
+	0x334: 0xb909,   // read-only
+	0x339: 0x19ed06, // quotes
+	0x33b: 0xb4617,  // border-start-end-radius
+	0x33f: 0x1b202,  // vi
+	0x342: 0x4c204,  // blur
+	0x343: 0xda015,  // animation-composition
+	0x348: 0x25d04,  // grad
+	0x34d: 0x2f608,  // anywhere
+	0x34e: 0x9706,   // target
+	0x34f: 0x1a3010, // stroke-dasharray
+	0x352: 0xcb70e,  // grid-auto-flow
+	0x354: 0x4fc0d,  // grid-template
+	0x356: 0x11480c, // first-letter
+	0x359: 0x250b,   // column-fill
+	0x35d: 0x18941a, // overscroll-behavior-inline
+	0x35e: 0x19d305, // clamp
+	0x365: 0xc3f0b,  // align-items
+	0x367: 0x66a07,  // rec2020
+	0x368: 0x107d0f, // details-content
+	0x369: 0x18b0a,  // last-child
+	0x36b: 0xc1d17,  // border-top-right-radius
+	0x36c: 0xdad0c,  // position-try
+	0x36d: 0x14010a, // translatex
+	0x36e: 0x2640c,  // table-column
+	0x36f: 0x1ac007, // subgrid
+	0x370: 0x13ca10, // font-size-adjust
+	0x372: 0x19be05, // print
+	0x374: 0xe4905,  // thick
+	0x376: 0x35c07,  // rotatez
+	0x378: 0x191c05, // clear
+	0x379: 0x3901,   // d
+	0x37e: 0x1c601,  // q
+	0x37f: 0xf7514,  // overflow-clip-margin
+	0x382: 0xdb702,  // ry
+	0x383: 0x108512, // content-visibility
+	0x384: 0x1a840b, // inset-block
+	0x389: 0x81f09,  // underline
+	0x38a: 0x3be0d,  // target-within
+	0x38b: 0x42a08,  // xx-large
+	0x38d: 0x36a0c,  // border-block
+	0x390: 0xb5c13,  // scroll-padding-left
+	0x391: 0xa0819,  // border-inline-start-width
+	0x392: 0x26412,  // table-column-group
+	0x393: 0x3f309,  // transform
+	0x398: 0x19e208, // progress
+	0x39b: 0x12970c, // focus-within
+	0x39d: 0x199a1c, // prefers-reduced-transparency
+	0x3a3: 0x12f215, // shape-image-threshold
+	0x3a5: 0x12a107, // initial
+	0x3aa: 0x9b113,  // hanging-punctuation
+	0x3ad: 0x53008,  // step-end
+	0x3ae: 0xea717,  // contain-intrinsic-width
+	0x3af: 0x42b07,  // x-large
+	0x3b0: 0xf0412,  // perspective-origin
+	0x3b1: 0x1db0f,  // text-decoration
+	0x3b2: 0x702,    // to
+	0x3b3: 0xb1c12,  // border-right-width
+	0x3b6: 0x124e10, // transform-origin
+	0x3ba: 0x9f02,   // ex
+	0x3bb: 0x120311, // page-break-before
+	0x3bc: 0x31808,  // ease-out
+	0x3be: 0xd0b14,  // scroll-timeline-name
+	0x3c1: 0x53705,  // dvmax
+	0x3c5: 0x54710,  // border-block-end
+	0x3c6: 0xe3919,  // text-decoration-thickness
+	0x3c7: 0xd9e04,  // span
+	0x3c9: 0x128a05, // focus
+	0x3cc: 0x6c012,  // border-block-width
+	0x3cd: 0xca807,  // browser
+	0x3cf: 0x12b407, // display
+	0x3d9: 0x9c304,  // none
+	0x3da: 0x1ae508, // supports
+	0x3db: 0x3ad12,  // margin-block-start
+	0x3dd: 0x2fc0a,  // read-write
+	0x3e0: 0x17310e, // max-resolution
+	0x3e3: 0x51e05,  // right
+	0x3e4: 0x10811,  // alternate-reverse
+	0x3e7: 0x114e0e, // letter-spacing
+	0x3e9: 0xf470a,  // standalone
+	0x3eb: 0x15fa0a, // local-link
+	0x3ec: 0x11ba0e, // flex-direction
+	0x3ed: 0x17710a, // max-height
+	0x3f0: 0x180214, // text-combine-upright
+	0x3f3: 0x2ac02,  // fr
+	0x3f6: 0xff20c,  // last-of-type
+	0x3f7: 0x6ee02,  // px
+	0x3f9: 0xabf0d,  // border-radius
+	0x3fb: 0x1a260a, // stroke-box
+	0x3fd: 0x3c910,  // inset-inline-end
+	0x402: 0x3c90c,  // inset-inline
+	0x404: 0xf2d0b,  // counter-set
+	0x407: 0x8440a,  // user-valid
+	0x409: 0xce903,  // deg
+	0x40a: 0x130713, // font-feature-values
+	0x40c: 0xc8b04,  // both
+	0x40e: 0x128a0d, // focus-visible
+	0x40f: 0x122a0e, // starting-style
+	0x412: 0x38613,  // background-position
+	0x414: 0x11f709, // flex-grow
+	0x415: 0x68918,  // border-block-start-width
+	0x417: 0x2e00b,  // any-pointer
+	0x419: 0x109709, // crosshair
+	0x41d: 0x33608,  // autofill
+	0x41e: 0x1b5e03, // svw
+	0x421: 0x171f0a, // math-style
+	0x425: 0x16e90b, // mask-repeat
+	0x427: 0x1aed04, // rgba
+	0x42c: 0x33a08,  // fill-box
+	0x430: 0x8d60b,  // paint-order
+	0x434: 0xe741d,  // contain-intrinsic-inline-size
+	0x436: 0x187b13, // overscroll-behavior
+	0x437: 0xac02,   // in
+	0x438: 0xf3709,  // table-row
+	0x43a: 0x143817, // font-variant-alternates
+	0x43b: 0x19b606, // prefix
+	0x43c: 0x33105,  // range
+	0x43f: 0x145311, // font-variant-caps
+	0x441: 0x1f905,  // light
+	0x442: 0x15cb0b, // vertical-rl
+	0x443: 0x133c0c, // font-kerning
+	0x446: 0x135f16, // font-language-override
+	0x448: 0x1aef13, // backface-visibility
+	0x44e: 0x8ef08,  // repeat-y
+	0x44f: 0x3da15,  // background-blend-mode
+	0x450: 0x17e80f, // offset-position
+	0x452: 0x11ad0d, // first-of-type
+	0x459: 0xedc07,  // justify
+	0x45a: 0x2d404,  // link
+	0x460: 0x1a6d0a, // self-start
+	0x461: 0x5fe0e,  // min-block-size
+	0x463: 0x6d90a,  // text-align
+	0x464: 0x11c808, // flex-end
+	0x467: 0x20607,  // rotatey
+	0x468: 0x38615,  // background-position-x
+	0x46a: 0x32e08,  // in-range
+	0x46b: 0x1bf507, // playing
+	0x46c: 0xa502,   // ch
+	0x46d: 0x17d05,  // round
+	0x46e: 0x7db16,  // text-emphasis-position
+	0x472: 0x1bc90d, // unicode-range
+	0x473: 0x1b0f11, // image-orientation
+	0x475: 0x10ec06, // cursor
+	0x478: 0x39a08,  // xx-small
+	0x47c: 0xe0906,  // offset
+	0x47d: 0xfe203,  // cqh
+	0x481: 0x13190c, // shape-margin
+	0x482: 0x8a20b,  // placeholder
+	0x483: 0x11207,  // reverse
+	0x484: 0x1a0005, // steps
+	0x487: 0x43204,  // ruby
+	0x488: 0x9380d,  // dynamic-range
+	0x48b: 0x27d0b,  // orientation
+	0x48c: 0xd2e0b,  // color-gamut
+	0x48f: 0x10b60c, // currentcolor
+	0x496: 0x1a9511, // stroke-miterlimit
+	0x497: 0x5b00a,  // margin-top
+	0x499: 0xf213,   // animation-fill-mode
+	0x49e: 0x970b,   // target-text
+	0x49f: 0x1b6c09, // system-ui
+	0x4a2: 0x1b2003, // svh
+	0x4a3: 0x16700b, // max-content
+	0x4a5: 0x85a17,  // border-end-start-radius
+	0x4a9: 0x1707,   // element
+	0x4aa: 0xab20d,  // transform-box
+	0x4ac: 0xa5807,  // hyphens
+	0x4ad: 0x7af12,  // table-footer-group
+	0x4ae: 0x2e40e,  // pointer-events
+	0x4af: 0x5581b,  // color-interpolation-filters
+	0x4b0: 0x16ee18, // repeating-conic-gradient
+	0x4b5: 0x5fd04,  // vmin
+	0x4bc: 0x14e70b, // caret-color
+	0x4bd: 0xa650e,  // padding-inline
+	0x4be: 0x63c09,  // color-mix
+	0x4bf: 0xfa203,  // cqb
+	0x4c0: 0x124c04, // attr
+	0x4c2: 0x5721a,  // scroll-margin-inline-start
+	0x4c3: 0x1a5a0e, // stroke-linecap
+	0x4c6: 0x79403,  // and
+	0x4c7: 0xa9911,  // border-left-width
+	0x4c8: 0x16530f, // ascent-override
+	0x4ca: 0x4d12,   // animation-duration
+	0x4cb: 0x53804,  // vmax
+	0x4cc: 0x6a08,   // ellipsis
+	0x4cd: 0xd480a,  // appearance
+	0x4ce: 0x9d619,  // border-inline-start-color
+	0x4d4: 0x17cb0d, // overflow-wrap
+	0x4d5: 0xece04,  // acos
+	0x4d6: 0xf650f,  // scrollbar-color
+	0x4da: 0x14380c, // font-variant
+	0x4dc: 0x62912,  // border-block-start
+	0x4df: 0xe70b,   // text-shadow
+	0x4e0: 0x4800c,  // base-palette
+	0x4e1: 0xe860b,  // inline-size
+	0x4e3: 0x36504,  // mask
+	0x4e6: 0x65903,  // dpi
+	0x4e7: 0x12720b, // flood-color
+	0x4e8: 0x8a211,  // placeholder-shown
+	0x4ea: 0x10103,  // mod
+	0x4eb: 0x94513,  // border-inline-color
+	0x4ec: 0x7cf08,  // collapse
+	0x4ee: 0x9e0b,   // text-anchor
+	0x4f1: 0x4ef02,  // vh
+	0x4f4: 0x183305, // oklch
+	0x4f5: 0xf370f,  // table-row-group
+	0x4f7: 0x36a12,  // border-block-color
+	0x4f8: 0x16f80e, // conic-gradient
+	0x4f9: 0x166e05, // lvmax
+	0x4fd: 0x129007, // visible
+	0x4ff: 0xb7e19,  // border-start-start-radius
+	0x504: 0xfb707,  // nth-col
+	0x505: 0x196008, // contrast
+	0x506: 0xaf812,  // border-right-color
+	0x508: 0x163c12, // view-timeline-axis
+	0x509: 0xed70c,  // text-justify
+	0x50c: 0xc9810,  // scroll-snap-type
+	0x50d: 0x53903,  // max
+	0x50e: 0x11850c, // grid-row-end
+	0x510: 0x1a0406, // square
+	0x512: 0x13970b, // ease-in-out
+	0x515: 0x8e002,  // rx
+	0x517: 0xf4504,  // past
+	0x518: 0x17605,  // space
+	0x519: 0x30415,  // text-decoration-style
+	0x51a: 0x49e04,  // help
+	0x51b: 0x11a10c, // touch-action
+	0x51d: 0x172908, // matrix3d
+	0x522: 0x1f703,  // all
+	0x525: 0x103310, // min-aspect-ratio
+	0x526: 0xd1f06,  // coarse
+	0x527: 0x1b320f, // inset-block-end
+	0x52a: 0xbf40b,  // padding-top
+	0x52c: 0xcf814,  // scroll-timeline-axis
+	0x52f: 0x4ac05,  // after
+	0x531: 0x54716,  // border-block-end-color
+	0x533: 0x3d902,  // vb
+	0x534: 0x8808,   // position
+	0x536: 0x15b20a, // fullscreen
+	0x537: 0x29b05,  // hover
+	0x539: 0x181903, // abs
+	0x53a: 0xa2013,  // hyphenate-character
+	0x53d: 0x17dc0c, // break-inside
+	0x53f: 0x5b808,  // optional
+	0x540: 0x91c12,  // border-image-width
+	0x541: 0x1a770f, // stroke-linejoin
+	0x544: 0x99e0f,  // background-clip
+	0x545: 0x197f04, // atan
+	0x547: 0x156304, // slow
+	0x548: 0x181605, // oklab
+	0x54d: 0x1870e,  // nth-last-child
+	0x54f: 0xdc51c,  // contain-intrinsic-block-size
+	0x553: 0x11970b, // line-height
+	0x554: 0x19f60a, // step-start
+	0x55c: 0x143d03, // var
+	0x560: 0x13d60d, // justify-items
+	0x565: 0x31804,  // ease
+	0x567: 0x17b119, // repeating-radial-gradient
+	0x568: 0xae50a,  // transition
+	0x569: 0x1a8411, // inset-block-start
+	0x56a: 0xb0a12,  // border-right-style
+	0x56d: 0x41711,  // background-repeat
+	0x56e: 0x73707,  // invalid
+	0x56f: 0xe6704,  // dpcm
+	0x570: 0x64e07,  // defined
+	0x576: 0x194c0c, // color-scheme
+	0x577: 0x116a03, // rtl
+	0x578: 0xd970b,  // column-span
+	0x57d: 0x115b11, // grid-column-start
+	0x580: 0x24c06,  // repeat
+	0x581: 0x56c06,  // filter
+	0x582: 0x193e06, // paused
+	0x583: 0x95817,  // border-inline-end-color
+	0x585: 0x96f17,  // border-inline-end-style
+	0x58b: 0xd8611,  // column-rule-width
+	0x58d: 0x105314, // white-space-collapse
+	0x58f: 0x8c006,  // outset
+	0x592: 0x1adf06, // suffix
+	0x593: 0x18f00c, // popover-open
+	0x599: 0x1b9905, // serif
+	0x59c: 0x10f202, // cx
+	0x59d: 0x10dd08, // backdrop
+	0x5a1: 0x1a0806, // revert
+	0x5a2: 0x6ec04,  // dppx
+	0x5a3: 0x5a911,  // scroll-margin-top
+	0x5a4: 0x10530b, // white-space
+	0x5a5: 0x102110, // scrollbar-gutter
+	0x5a8: 0x36204,  // zoom
+	0x5a9: 0x158204, // math
+	0x5ac: 0x19d70b, // padding-box
+	0x5ad: 0xf830a,  // margin-box
+	0x5af: 0x186b08, // overline
+	0x5b2: 0x163602, // p3
+	0x5b5: 0x161e03, // lvb
+	0x5b9: 0x10c70e, // vertical-align
+	0x5ba: 0x17209,  // namespace
+	0x5bb: 0x75e0b,  // user-select
+	0x5bf: 0x6aa04,  // dark
+	0x5c0: 0x29709,  // any-hover
+	0x5c1: 0x55813,  // color-interpolation
+	0x5c2: 0xba913,  // transition-duration
+	0x5c4: 0xd6411,  // column-rule-color
+	0x5c5: 0xac0b,   // inline-grid
+	0x5c8: 0x12a10e, // initial-letter
+	0x5cb: 0x156e0b, // place-items
+	0x5cf: 0x138713, // grid-template-areas
+	0x5d1: 0x29c0e,  // overflow-block
+	0x5d2: 0x150717, // font-variation-settings
+	0x5d9: 0x15570d, // forced-colors
+	0x5de: 0x7ac04,  // wait
+	0x5e0: 0x9ef19,  // border-inline-start-style
+	0x5e4: 0x140815, // text-decoration-color
+	0x5e6: 0xbfe0d,  // place-content
+	0x5e8: 0x149905, // emoji
+	0x5ea: 0xd3a06,  // dashed
+	0x5eb: 0x7d0a,   // list-style
+	0x5ed: 0x15240b, // font-weight
+	0x5ee: 0x16004,  // disc
+	0x5f1: 0x83115,  // border-end-end-radius
+	0x5f3: 0x13230c, // inline-table
+	0x5f4: 0x2e407,  // pointer
+	0x5f6: 0x8b313,  // border-image-outset
+	0x5f9: 0x2870b,  // not-allowed
+	0x5fa: 0x3701,   // y
+	0x5fb: 0x40215,  // background-position-y
+	0x5fe: 0x10f40f, // farthest-corner
+	0x5ff: 0xf930b,  // grid-column
+	0x600: 0xa4613,  // border-inline-width
+	0x605: 0xe6a06,  // marker
+	0x606: 0xed108,  // saturate
+	0x608: 0x1a0f05, // layer
+	0x60b: 0x1b302,  // ic
+	0x60c: 0x15d709, // interlace
+	0x610: 0x105103, // cqw
+	0x612: 0x173f09, // max-width
+	0x614: 0x67118,  // border-block-start-style
+	0x616: 0xd640b,  // column-rule
+	0x619: 0x1f90e,  // lighting-color
+	0x61d: 0xe6a0a,  // marker-end
+	0x61e: 0xb3b09,  // grayscale
+	0x620: 0x77f13,  // border-bottom-width
+	0x623: 0x3810,   // additive-symbols
+	0x624: 0x20606,  // rotate
+	0x626: 0x137513, // font-optical-sizing
+	0x629: 0x1b70c,  // aspect-ratio
+	0x62c: 0xd400a,  // column-gap
+	0x62d: 0xb2e0e,  // border-spacing
+	0x631: 0x12af0c, // font-display
+	0x632: 0x5102,   // at
+	0x634: 0x3d603,  // end
+	0x635: 0x1b2303, // svi
+	0x636: 0x1c3d13, // view-transition-old
+	0x637: 0x1b0410, // background-image
+	0x639: 0x3f30f,  // transform-style
+	0x63a: 0x10ac03, // cue
+	0x63b: 0x2b313,  // animation-direction
+	0x63c: 0x1401,   // s
+	0x63e: 0x16790b, // nth-of-type
+	0x640: 0x3c704,  // thin
+	0x643: 0x48a13,  // text-emphasis-color
+	0x645: 0x6ae12,  // border-block-style
+	0x646: 0x106f10, // descent-override
+	0x647: 0x33f14,  // box-decoration-break
+	0x64a: 0x87015,  // scroll-padding-bottom
+	0x64b: 0x11d08,  // grabbing
+	0x64c: 0xd106,   // widows
+	0x64e: 0x1c5007, // visited
+	0x652: 0xa6512,  // padding-inline-end
+	0x657: 0x1008,   // tab-size
+	0x658: 0x1a1406, // sticky
+	0x65e: 0xdf306,  // height
+	0x65f: 0x123809, // flex-wrap
+	0x660: 0x183607, // checked
+	0x661: 0x103105, // cqmin
+	0x662: 0xe2e0d,  // indeterminate
+	0x669: 0x4de11,  // margin-inline-end
+	0x66d: 0x6e607,  // stalled
+	0x66e: 0xc0a13,  // transition-property
+	0x674: 0x60c16,  // border-block-end-width
+	0x675: 0x2506,   // column
+	0x677: 0xc4802,  // ms
+	0x67a: 0x172906, // matrix
+	0x67b: 0x15d502, // lh
+	0x67d: 0x10d00a, // align-self
+	0x67e: 0x5170c,  // margin-right
+	0x681: 0x90913,  // border-image-source
+	0x684: 0x11dd1a, // transition-timing-function
+	0x687: 0xfbb0c,  // column-count
+	0x688: 0x15c508, // relative
+	0x689: 0x6f013,  // border-bottom-color
+	0x68a: 0x5e50e,  // scroll-padding
+	0x68d: 0x7d13,   // list-style-position
+	0x68e: 0x16090f, // overflow-inline
+	0x68f: 0xaa905,  // hypot
+	0x690: 0xf930f,  // grid-column-end
+	0x693: 0xf1408,  // infinite
+	0x694: 0x3107,   // opacity
+	0x697: 0x116c10, // list-style-image
+	0x69d: 0x13300c, // caption-side
+	0x69f: 0x1760c,  // space-around
+	0x6a0: 0x120e06, // before
+	0x6a6: 0x7fb07,  // orphans
+	0x6a8: 0x18c615, // overscroll-behavior-x
+	0x6a9: 0xef107,  // ellipse
+	0x6aa: 0x170f09, // mask-type
+	0x6ad: 0x16a50a, // break-word
+	0x6af: 0x1be115, // view-transition-group
+	0x6b2: 0x13ca09, // font-size
+	0x6b4: 0x36b05,  // order
+	0x6b6: 0x181803, // lab
+	0x6b9: 0x95811,  // border-inline-end
+	0x6ba: 0x14f215, // font-variant-position
+	0x6bc: 0x1c2a13, // view-transition-new
+	0x6be: 0x194414, // prefers-color-scheme
+	0x6bf: 0x5ec03,  // pad
+	0x6c1: 0x17d711, // page-break-inside
+	0x6c2: 0x190e08, // portrait
+	0x6c6: 0x125c07, // inherit
+	0x6c8: 0x18570a, // overflow-x
+	0x6cd: 0x3ba05,  // start
+	0x6cf: 0x19230c, // word-spacing
+	0x6d1: 0x1a080c, // revert-layer
+	0x6d4: 0x18f804, // open
+	0x6d6: 0x168405, // lvmin
+	0x6d8: 0x46d06,  // bottom
+	0x6d9: 0x26405,  // table
+	0x6ea: 0x100f0a, // object-fit
+	0x6eb: 0xf040b,  // perspective
+	0x6ee: 0xd560e,  // column-reverse
+	0x6ef: 0x125c08, // inherits
+	0x6f0: 0x3650b,  // mask-border
+	0x6f4: 0x39d0a,  // small-caps
+	0x6f5: 0x11707,  // seeking
+	0x6f6: 0x4f607,  // running
+	0x6f7: 0x1bac07, // fantasy
+	0x6f8: 0x43d0b,  // content-box
+	0x6fb: 0xccb06,  // closed
+	0x6fd: 0x42208,  // repeat-x
+	0x6fe: 0x163403, // exp
+	0x701: 0x8840e,  // mask-composite
+	0x703: 0x108d0a, // visibility
+	0x707: 0x4f106,  // bolder
+	0x70b: 0x3860a,  // background
+	0x710: 0x7806,   // normal
+	0x711: 0x39b07,  // x-small
+	0x716: 0x17bb0f, // radial-gradient
+	0x719: 0x1902,   // em
+	0x71e: 0x1b0203, // svb
+	0x721: 0x1a4011, // stroke-dashoffset
+	0x722: 0x13fb07, // charset
+	0x724: 0x11910a, // first-line
+	0x725: 0x12d30b, // font-family
+	0x726: 0x2d008,  // any-link
+	0x727: 0x149d12, // inset-inline-start
+	0x728: 0x13540b, // translate3d
+	0x729: 0xe090d,  // offset-anchor
+	0x72c: 0x196e14, // prefers-reduced-data
+	0x72d: 0x19ce06, // static
+	0x72e: 0x13f10c, // font-stretch
+	0x72f: 0x19b402, // cy
+	0x730: 0x42d06,  // larger
+	0x731: 0x14911,  // counter-increment
+	0x734: 0x99c03,  // hwb
+	0x735: 0x6a009,  // highlight
+	0x736: 0x184a0d, // outline-width
+	0x737: 0x163803, // lvh
+	0x73b: 0x7002,   // is
+	0x73e: 0x15470c, // justify-self
+	0x743: 0x1b730c, // ui-monospace
+	0x745: 0x1680e,  // animation-name
+	0x748: 0x19d08,  // baseline
+	0x74a: 0xa770b,  // border-left
+	0x74b: 0x13470e, // grid-row-start
+	0x74c: 0x12be0c, // device-width
+	0x74e: 0x1b7609, // monospace
+	0x756: 0x152e0a, // translatez
+	0x757: 0x29c08,  // overflow
+	0x759: 0xceb0e,  // grid-auto-rows
+	0x75a: 0x29106,  // double
+	0x75b: 0x192103, // pow
+	0x75f: 0x198416, // prefers-reduced-motion
+	0x761: 0xf510d,  // counter-style
+	0x762: 0x5bf0f,  // list-style-type
+	0x763: 0x124905, // float
+	0x765: 0x2d802,  // hz
+	0x767: 0x8011a,  // scroll-padding-block-start
+	0x76b: 0x1bb70c, // unicode-bidi
+	0x76c: 0x4a104,  // page
+	0x76e: 0x13005,  // ridge
+	0x76f: 0x9104,   // wrap
+	0x771: 0x36a06,  // border
+	0x772: 0x79103,  // hsl
+	0x775: 0x24909,  // no-repeat
+	0x776: 0x19be12, // print-color-adjust
+	0x77a: 0x47709,  // clip-rule
+	0x780: 0x2b104,  // scan
+	0x781: 0x1bfc1a, // view-transition-image-pair
+	0x783: 0x8f712,  // border-image-slice
+	0x784: 0x17a30c, // marker-start
+	0x787: 0xbc810,  // border-top-color
+	0x788: 0xb3f07,  // scale3d
+	0x78d: 0xa001,   // x
+	0x78f: 0xc5f10,  // border-top-style
+	0x791: 0x7461a,  // border-bottom-right-radius
+	0x792: 0x3a619,  // scroll-margin-block-start
+	0x793: 0x10809,  // alternate
+	0x795: 0x4a110,  // page-break-after
+	0x799: 0x16a00a, // word-break
+	0x79c: 0xe404,   // left
+	0x79d: 0x58b0e,  // text-rendering
+	0x79f: 0x18af0a, // flex-basis
+	0x7a0: 0x6103,   // pre
+	0x7a1: 0x13ab13, // font-palette-values
+	0x7a3: 0x73905,  // valid
+	0x7a5: 0x162010, // background-color
+	0x7a8: 0x10a00c, // cubic-bezier
+	0x7a9: 0x1b940a, // sans-serif
+	0x7aa: 0x1b2413, // view-timeline-inset
+	0x7ab: 0x187f0f, // scroll-behavior
+	0x7ac: 0x37b0b,  // row-reverse
+	0x7af: 0x1b540a, // min-height
+	0x7b0: 0x14b816, // font-variant-ligatures
+	0x7b3: 0xe6902,  // cm
+	0x7b5: 0x11d04,  // grab
+	0x7b7: 0xfee10,  // nth-last-of-type
+	0x7b8: 0x8f06,   // nowrap
+	0x7b9: 0xdc507,  // contain
+	0x7bd: 0xa8811,  // border-left-style
+	0x7bf: 0x4320a,  // ruby-align
+	0x7c2: 0xa3313,  // border-inline-style
+	0x7c3: 0xcaf09,  // buffering
+	0x7c4: 0x49c03,  // rch
+	0x7c8: 0x1bdc05, // unset
+	0x7cb: 0xb304,   // grid
+	0x7cf: 0x5fc05,  // dvmin
+	0x7d0: 0x20d12,  // animation-timeline
+	0x7d2: 0xdf80c,  // table-layout
+	0x7d4: 0x34e09,  // break-all
+	0x7d5: 0xaf80c,  // border-right
+	0x7d6: 0x84f0b,  // will-change
+	0x7d9: 0x79104,  // hsla
+	0x7db: 0x4480f,  // background-size
+	0x7dd: 0x8900b,  // text-indent
+	0x7df: 0x89a09,  // text-wrap
+	0x7e1: 0x1f208,  // keep-all
+	0x7e6: 0x15fa05, // local
+	0x7e9: 0x12702,  // mm
+	0x7ee: 0x1aed03, // rgb
+	0x7f3: 0x16900b, // transparent
+	0x7f6: 0x5e0d,   // no-preference
+	0x7f7: 0x3f203,  // ltr
+	0x7fa: 0x2d703,  // khz
+	0x7fb: 0x76c13,  // border-bottom-style
+	0x7fe: 0xd3605,  // muted
+	0x7ff: 0x1b910d, // ui-sans-serif
 }
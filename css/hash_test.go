@@ -0,0 +1,26 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/tdewolff/test"
+)
+
+func TestHashTable(t *testing.T) {
+	test.T(t, ToHash([]byte("font-face")), Font_Face)
+	test.T(t, ToHash([]byte("border-top-left-radius")), Border_Top_Left_Radius)
+	test.T(t, ToHash([]byte("nth-last-of-type")), Nth_Last_Of_Type)
+	test.T(t, ToHash([]byte("px")), Px)
+	test.T(t, ToHash([]byte("color")), ColorHash)
+	test.T(t, ToHash([]byte("Color")), Hash(0))
+	test.T(t, ToHash([]byte("colors")), Hash(0))
+	test.T(t, ToHash([]byte("")), Hash(0))
+	test.String(t, Starting_Style.String(), "starting-style")
+	test.String(t, Hash(0xffffff).String(), "")
+
+	for _, h := range _Hash_table {
+		if h != 0 {
+			test.T(t, ToHash([]byte(h.String())), h, h.String())
+		}
+	}
+}
@@ -0,0 +1,816 @@
+package css
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/tdewolff/parse/v2"
+)
+
+// MediaQueryList is a comma-separated list of media queries, which matches when any of its queries matches or when it is empty, see https://www.w3.org/TR/mediaqueries-4/.
+type MediaQueryList []MediaQuery
+
+// MediaQuery is a single media query such as `not screen and (min-width: 400px)`.
+type MediaQuery struct {
+	Not       bool
+	Only      bool
+	Type      []byte          // lowercase media type, nil if there is none
+	Condition *MediaCondition // nil if there is none
+	Invalid   bool            // query could not be parsed and never matches, as if it were `not all`
+}
+
+// MediaConditionType determines the type of a media condition.
+type MediaConditionType int
+
+// MediaConditionType values.
+const (
+	MediaFeatureCondition MediaConditionType = iota // (feature)
+	MediaNotCondition                               // not (a)
+	MediaAndCondition                               // (a) and (b)
+	MediaOrCondition                                // (a) or (b)
+	MediaGeneralEnclosed                            // unknown syntax between parentheses or in a function, which never matches
+)
+
+// MediaCondition is a media feature, a negation, conjunction, or disjunction of media conditions, or a general enclosed expression.
+type MediaCondition struct {
+	Type       MediaConditionType
+	Feature    *MediaFeature     // for MediaFeatureCondition
+	Conditions []*MediaCondition // operands of MediaNotCondition, MediaAndCondition, and MediaOrCondition
+	Tokens     []Token           // for MediaGeneralEnclosed, including the parentheses
+}
+
+// MediaComparison is a comparison operator in a range media feature.
+type MediaComparison int
+
+// MediaComparison values.
+const (
+	MediaEqual        MediaComparison = iota // =
+	MediaLess                                // <
+	MediaLessEqual                           // <=
+	MediaGreater                             // >
+	MediaGreaterEqual                        // >=
+)
+
+// String returns the CSS representation of a comparison.
+func (c MediaComparison) String() string {
+	switch c {
+	case MediaEqual:
+		return "="
+	case MediaLess:
+		return "<"
+	case MediaLessEqual:
+		return "<="
+	case MediaGreater:
+		return ">"
+	case MediaGreaterEqual:
+		return ">="
+	}
+	return "Invalid(" + strconv.Itoa(int(c)) + ")"
+}
+
+// flip returns the comparison with its operands swapped, so that `a < b` becomes `b > a`.
+func (c MediaComparison) flip() MediaComparison {
+	switch c {
+	case MediaLess:
+		return MediaGreater
+	case MediaLessEqual:
+		return MediaGreaterEqual
+	case MediaGreater:
+		return MediaLess
+	case MediaGreaterEqual:
+		return MediaLessEqual
+	}
+	return c
+}
+
+// MediaFeature is a media feature in boolean context `(name)`, plain syntax `(name: value)`, or range syntax such as `(400px <= width < 800px)`.
+type MediaFeature struct {
+	Name    []byte      // lowercase name, including the min- or max- prefix for plain features
+	Value   *MediaValue // value of a plain feature
+	Left    *MediaValue // value before the name in range syntax
+	LeftOp  MediaComparison
+	Right   *MediaValue // value after the name in range syntax
+	RightOp MediaComparison
+}
+
+// MediaValue is the value of a media feature: a number, a dimension, an identifier, or a ratio.
+type MediaValue struct {
+	Data     []byte  // value as in the source without whitespace, where identifiers are lowercase
+	Num, Den float64 // number, or numerator and denominator of a ratio
+	Unit     []byte  // lowercase unit of a dimension
+	IsIdent  bool
+	IsRatio  bool
+}
+
+////////////////////////////////////////////////////////////////
+
+// ParseMediaQueryList parses the tokens of a media query list, such as the prelude of a @media rule. Media queries that cannot be parsed are marked invalid, as they never match, and the first error is returned.
+func ParseMediaQueryList(tokens []Token) (MediaQueryList, error) {
+	var err error
+	list := MediaQueryList{}
+	start := 0
+	level := 0
+	for i := 0; i <= len(tokens); i++ {
+		if i < len(tokens) {
+			if tt := tokens[i].TokenType; tt == FunctionToken || tt == LeftParenthesisToken {
+				level++
+				continue
+			} else if tt == RightParenthesisToken {
+				level--
+				continue
+			} else if tt != CommaToken || 0 < level {
+				continue
+			}
+		}
+		p := &tokenParser{tokens: tokens[start:i]}
+		p.skipWhitespace()
+		if i == len(tokens) && len(list) == 0 && p.i == len(p.tokens) {
+			break // empty list
+		}
+		query := p.parseMediaQuery()
+		if p.err == nil && p.i < len(p.tokens) {
+			p.fail("media query")
+		}
+		if p.err != nil {
+			if err == nil {
+				err = p.err
+			}
+			query = MediaQuery{Invalid: true}
+		}
+		list = append(list, query)
+		start = i + 1
+	}
+	return list, err
+}
+
+// MediaQueryList parses the prelude of a @media rule.
+func (r *AtRule) MediaQueryList() (MediaQueryList, error) {
+	return ParseMediaQueryList(r.Prelude)
+}
+
+func (p *tokenParser) isIdent(t Token, name string) bool {
+	return t.TokenType == IdentToken && parse.EqualFold(t.Data, []byte(name))
+}
+
+func (p *tokenParser) parseMediaQuery() MediaQuery {
+	query := MediaQuery{}
+	if t := p.peek(0); t.TokenType != IdentToken || p.isIdent(t, "not") && p.peek(1).TokenType == WhitespaceToken && p.peek(2).TokenType == LeftParenthesisToken {
+		query.Condition = p.parseMediaCondition(true)
+		return query
+	}
+
+	if p.isIdent(p.peek(0), "not") {
+		query.Not = true
+		p.i++
+		p.skipWhitespace()
+	} else if p.isIdent(p.peek(0), "only") {
+		query.Only = true
+		p.i++
+		p.skipWhitespace()
+	}
+	t := p.peek(0)
+	if t.TokenType != IdentToken || p.isIdent(t, "not") || p.isIdent(t, "and") || p.isIdent(t, "or") || p.isIdent(t, "only") || p.isIdent(t, "layer") {
+		p.fail("media query")
+		return query
+	}
+	query.Type = parse.ToLower(parse.Copy(t.Data))
+	p.i++
+	p.skipWhitespace()
+
+	if p.isIdent(p.peek(0), "and") {
+		p.i++
+		p.skipWhitespace()
+		query.Condition = p.parseMediaCondition(false)
+	}
+	return query
+}
+
+// parseMediaCondition parses a media condition, which may not contain or when allowOr is false.
+func (p *tokenParser) parseMediaCondition(allowOr bool) *MediaCondition {
+	if p.isIdent(p.peek(0), "not") {
+		p.i++
+		p.skipWhitespace()
+		cond := p.parseMediaInParens()
+		p.skipWhitespace()
+		return &MediaCondition{Type: MediaNotCondition, Conditions: []*MediaCondition{cond}}
+	}
+
+	cond := p.parseMediaInParens()
+	p.skipWhitespace()
+	var op MediaConditionType
+	if p.isIdent(p.peek(0), "and") {
+		op = MediaAndCondition
+	} else if allowOr && p.isIdent(p.peek(0), "or") {
+		op = MediaOrCondition
+	} else {
+		return cond
+	}
+
+	conds := []*MediaCondition{cond}
+	for p.err == nil && (op == MediaAndCondition && p.isIdent(p.peek(0), "and") || op == MediaOrCondition && p.isIdent(p.peek(0), "or")) {
+		p.i++
+		p.skipWhitespace()
+		conds = append(conds, p.parseMediaInParens())
+		p.skipWhitespace()
+	}
+	return &MediaCondition{Type: op, Conditions: conds}
+}
+
+func (p *tokenParser) parseMediaInParens() *MediaCondition {
+	start := p.i
+	if t := p.peek(0); t.TokenType == FunctionToken {
+		p.skipBlock()
+		return &MediaCondition{Type: MediaGeneralEnclosed, Tokens: p.tokens[start:p.i]}
+	} else if t.TokenType != LeftParenthesisToken {
+		p.fail("media condition")
+		return nil
+	}
+	p.i++
+	p.skipWhitespace()
+
+	// try a nested condition, then a media feature, and otherwise consider it general enclosed
+	if t := p.peek(0); t.TokenType == LeftParenthesisToken || t.TokenType == FunctionToken || p.isIdent(t, "not") {
+		q := &tokenParser{tokens: p.tokens, i: p.i}
+		cond := q.parseMediaCondition(true)
+		if q.err == nil && q.peek(0).TokenType == RightParenthesisToken && cond.Type != MediaGeneralEnclosed {
+			p.i = q.i + 1
+			return cond
+		}
+	} else {
+		q := &tokenParser{tokens: p.tokens, i: p.i}
+		feature := q.parseMediaFeature()
+		q.skipWhitespace()
+		if q.err == nil && q.peek(0).TokenType == RightParenthesisToken {
+			p.i = q.i + 1
+			return &MediaCondition{Type: MediaFeatureCondition, Feature: feature}
+		}
+	}
+	p.i = start
+	p.skipBlock()
+	return &MediaCondition{Type: MediaGeneralEnclosed, Tokens: p.tokens[start:p.i]}
+}
+
+// skipBlock skips a parenthesized block or function starting at the current token.
+func (p *tokenParser) skipBlock() {
+	level := 0
+	for ; ; p.i++ {
+		if tt := p.peek(0).TokenType; tt == ErrorToken {
+			p.fail("media condition")
+			return
+		} else if tt == FunctionToken || tt == LeftParenthesisToken {
+			level++
+		} else if tt == RightParenthesisToken {
+			if level--; level == 0 {
+				p.i++
+				return
+			}
+		}
+	}
+}
+
+func (p *tokenParser) parseMediaFeature() *MediaFeature {
+	feature := &MediaFeature{}
+	if t := p.peek(0); t.TokenType == IdentToken {
+		feature.Name = parse.ToLower(parse.Copy(t.Data))
+		p.i++
+		p.skipWhitespace()
+		if p.peek(0).TokenType == ColonToken {
+			p.i++
+			p.skipWhitespace()
+			feature.Value = p.parseMediaValue()
+			return feature
+		} else if op, ok := p.parseMediaComparison(); ok {
+			p.skipWhitespace()
+			feature.RightOp = op
+			feature.Right = p.parseMediaValue()
+		}
+		return feature
+	}
+
+	feature.Left = p.parseMediaValue()
+	p.skipWhitespace()
+	op, ok := p.parseMediaComparison()
+	if !ok {
+		p.fail("media feature")
+		return nil
+	}
+	feature.LeftOp = op
+	p.skipWhitespace()
+	if t := p.peek(0); t.TokenType != IdentToken {
+		p.fail("media feature")
+		return nil
+	} else {
+		feature.Name = parse.ToLower(parse.Copy(t.Data))
+		p.i++
+	}
+	p.skipWhitespace()
+	if op, ok := p.parseMediaComparison(); ok {
+		if op == MediaEqual || (op == MediaLess || op == MediaLessEqual) != (feature.LeftOp == MediaLess || feature.LeftOp == MediaLessEqual) {
+			p.fail("media feature") // both comparisons must go in the same direction
+			return nil
+		}
+		p.skipWhitespace()
+		feature.RightOp = op
+		feature.Right = p.parseMediaValue()
+	}
+	return feature
+}
+
+func (p *tokenParser) parseMediaComparison() (MediaComparison, bool) {
+	t := p.peek(0)
+	if p.isDelim(t, '=') {
+		p.i++
+		return MediaEqual, true
+	} else if p.isDelim(t, '<') || p.isDelim(t, '>') {
+		p.i++
+		if p.isDelim(p.peek(0), '=') {
+			p.i++
+			if t.Data[0] == '<' {
+				return MediaLessEqual, true
+			}
+			return MediaGreaterEqual, true
+		} else if t.Data[0] == '<' {
+			return MediaLess, true
+		}
+		return MediaGreater, true
+	}
+	return 0, false
+}
+
+func (p *tokenParser) parseMediaValue() *MediaValue {
+	t := p.peek(0)
+	switch t.TokenType {
+	case IdentToken:
+		p.i++
+		data := parse.ToLower(parse.Copy(t.Data))
+		return &MediaValue{Data: data, IsIdent: true}
+	case NumberToken, DimensionToken:
+		p.i++
+		n, unit := parse.Dimension(t.Data)
+		num, _ := strconv.ParseFloat(string(t.Data[:n]), 64)
+		value := &MediaValue{Data: t.Data, Num: num}
+		if unit != 0 {
+			value.Unit = parse.ToLower(parse.Copy(t.Data[n:]))
+			return value
+		}
+
+		// ratio
+		i := p.i
+		p.skipWhitespace()
+		if p.isDelim(p.peek(0), '/') {
+			p.i++
+			p.skipWhitespace()
+			if den := p.peek(0); den.TokenType == NumberToken {
+				p.i++
+				value.Den, _ = strconv.ParseFloat(string(den.Data), 64)
+				value.IsRatio = true
+				value.Data = append(append(parse.Copy(t.Data), '/'), den.Data...)
+				return value
+			}
+		}
+		p.i = i
+		return value
+	}
+	p.fail("media feature")
+	return nil
+}
+
+////////////////////////////////////////////////////////////////
+
+// MediaEnv describes the environment in which media queries are evaluated. Zero fields take the defaults listed below, and the discrete features default to those of a typical desktop screen. Width and Height have no default, so they must be set for queries such as (min-width: 1px) to match.
+type MediaEnv struct {
+	Type         string            // media type such as screen or print, defaults to screen
+	Width        float64           // viewport width in CSS pixels
+	Height       float64           // viewport height in CSS pixels
+	DeviceWidth  float64           // screen width in CSS pixels, defaults to Width
+	DeviceHeight float64           // screen height in CSS pixels, defaults to Height
+	Resolution   float64           // device pixel ratio in dppx, defaults to 1
+	Color        int               // bits per color component, defaults to 8 unless Monochrome is set
+	ColorIndex   int               // number of entries in the color lookup table
+	Monochrome   int               // bits per pixel of a monochrome device
+	FontSize     float64           // font size in CSS pixels for em and rem units, defaults to 16
+	Features     map[string]string // discrete features such as prefers-color-scheme: dark, which override the defaults
+}
+
+// defaultMediaFeatures are the discrete media features of a typical desktop screen.
+var defaultMediaFeatures = map[string]string{
+	"any-hover":                    "hover",
+	"any-pointer":                  "fine",
+	"color-gamut":                  "srgb",
+	"display-mode":                 "browser",
+	"dynamic-range":                "standard",
+	"forced-colors":                "none",
+	"grid":                         "0",
+	"hover":                        "hover",
+	"inverted-colors":              "none",
+	"overflow-block":               "scroll",
+	"overflow-inline":              "scroll",
+	"pointer":                      "fine",
+	"prefers-color-scheme":         "light",
+	"prefers-contrast":             "no-preference",
+	"prefers-reduced-data":         "no-preference",
+	"prefers-reduced-motion":       "no-preference",
+	"prefers-reduced-transparency": "no-preference",
+	"scan":                         "progressive",
+	"scripting":                    "enabled",
+	"update":                       "fast",
+	"video-dynamic-range":          "standard",
+}
+
+// mediaResult is the three-valued result of evaluating a media condition.
+type mediaResult int
+
+const (
+	mediaFalse mediaResult = iota
+	mediaTrue
+	mediaUnknown
+)
+
+func mediaBool(b bool) mediaResult {
+	if b {
+		return mediaTrue
+	}
+	return mediaFalse
+}
+
+// Matches returns true if any of the media queries matches the environment, or if the list is empty.
+func (l MediaQueryList) Matches(env *MediaEnv) bool {
+	if len(l) == 0 {
+		return true
+	}
+	for _, query := range l {
+		if query.Matches(env) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true if the media query matches the environment. Unknown media features and general enclosed expressions evaluate to unknown, which does not match.
+func (q MediaQuery) Matches(env *MediaEnv) bool {
+	if q.Invalid {
+		return false
+	}
+	res := mediaTrue
+	if q.Type != nil {
+		typ := env.Type
+		if typ == "" {
+			typ = "screen"
+		}
+		res = mediaBool(string(q.Type) == "all" || string(q.Type) == typ)
+	}
+	if res == mediaTrue && q.Condition != nil {
+		res = q.Condition.eval(env)
+	}
+	if q.Not {
+		if res == mediaUnknown {
+			return false
+		}
+		return res == mediaFalse
+	}
+	return res == mediaTrue
+}
+
+// Matches returns true if the media condition matches the environment.
+func (c *MediaCondition) Matches(env *MediaEnv) bool {
+	return c.eval(env) == mediaTrue
+}
+
+func (c *MediaCondition) eval(env *MediaEnv) mediaResult {
+	switch c.Type {
+	case MediaFeatureCondition:
+		return c.Feature.eval(env)
+	case MediaNotCondition:
+		switch c.Conditions[0].eval(env) {
+		case mediaTrue:
+			return mediaFalse
+		case mediaFalse:
+			return mediaTrue
+		}
+		return mediaUnknown
+	case MediaAndCondition:
+		res := mediaTrue
+		for _, cond := range c.Conditions {
+			if r := cond.eval(env); r == mediaFalse {
+				return mediaFalse
+			} else if r == mediaUnknown {
+				res = mediaUnknown
+			}
+		}
+		return res
+	case MediaOrCondition:
+		res := mediaFalse
+		for _, cond := range c.Conditions {
+			if r := cond.eval(env); r == mediaTrue {
+				return mediaTrue
+			} else if r == mediaUnknown {
+				res = mediaUnknown
+			}
+		}
+		return res
+	}
+	return mediaUnknown
+}
+
+// rangeFeature returns the value of a numeric media feature and how to convert values to its unit, or false if it is not a range feature.
+func (env *MediaEnv) rangeFeature(name string) (float64, func(*MediaValue) (float64, bool), bool) {
+	fontSize := env.FontSize
+	if fontSize == 0 {
+		fontSize = 16
+	}
+	length := func(v *MediaValue) (float64, bool) {
+		if v.IsIdent || v.IsRatio {
+			return 0, false
+		} else if v.Unit == nil {
+			return v.Num, v.Num == 0
+		}
+		switch string(v.Unit) {
+		case "px":
+			return v.Num, true
+		case "cm":
+			return v.Num * 96.0 / 2.54, true
+		case "mm":
+			return v.Num * 96.0 / 25.4, true
+		case "q":
+			return v.Num * 96.0 / 101.6, true
+		case "in":
+			return v.Num * 96.0, true
+		case "pt":
+			return v.Num * 96.0 / 72.0, true
+		case "pc":
+			return v.Num * 16.0, true
+		case "em", "rem":
+			return v.Num * fontSize, true
+		}
+		return 0, false
+	}
+	ratio := func(v *MediaValue) (float64, bool) {
+		if v.IsRatio {
+			if v.Den == 0 {
+				return 0, false
+			}
+			return v.Num / v.Den, true
+		}
+		return v.Num, !v.IsIdent && v.Unit == nil
+	}
+	integer := func(v *MediaValue) (float64, bool) {
+		return v.Num, !v.IsIdent && !v.IsRatio && v.Unit == nil
+	}
+
+	deviceWidth, deviceHeight := env.DeviceWidth, env.DeviceHeight
+	if deviceWidth == 0 {
+		deviceWidth = env.Width
+	}
+	if deviceHeight == 0 {
+		deviceHeight = env.Height
+	}
+	switch name {
+	case "width":
+		return env.Width, length, true
+	case "height":
+		return env.Height, length, true
+	case "device-width":
+		return deviceWidth, length, true
+	case "device-height":
+		return deviceHeight, length, true
+	case "aspect-ratio", "device-aspect-ratio":
+		w, h := env.Width, env.Height
+		if name == "device-aspect-ratio" {
+			w, h = deviceWidth, deviceHeight
+		}
+		if h == 0 {
+			return 0, nil, false
+		}
+		return w / h, ratio, true
+	case "resolution":
+		resolution := env.Resolution
+		if resolution == 0 {
+			resolution = 1
+		}
+		return resolution, func(v *MediaValue) (float64, bool) {
+			if v.IsIdent || v.IsRatio {
+				return 0, false
+			}
+			switch string(v.Unit) {
+			case "dppx", "x":
+				return v.Num, true
+			case "dpi":
+				return v.Num / 96.0, true
+			case "dpcm":
+				return v.Num * 2.54 / 96.0, true
+			}
+			return 0, false
+		}, true
+	case "color":
+		color := env.Color
+		if color == 0 && env.Monochrome == 0 {
+			color = 8
+		}
+		return float64(color), integer, true
+	case "color-index":
+		return float64(env.ColorIndex), integer, true
+	case "monochrome":
+		return float64(env.Monochrome), integer, true
+	}
+	return 0, nil, false
+}
+
+func compareMedia(a float64, op MediaComparison, b float64) bool {
+	switch op {
+	case MediaLess:
+		return a < b
+	case MediaLessEqual:
+		return a <= b
+	case MediaGreater:
+		return a > b
+	case MediaGreaterEqual:
+		return a >= b
+	}
+	return a == b
+}
+
+func (f *MediaFeature) eval(env *MediaEnv) mediaResult {
+	name := string(f.Name)
+	isRange := f.Left != nil || f.Right != nil
+	op := MediaEqual
+	if f.Value != nil && (bytes.HasPrefix(f.Name, []byte("min-")) || bytes.HasPrefix(f.Name, []byte("max-"))) {
+		op = MediaGreaterEqual
+		if name[1] == 'a' {
+			op = MediaLessEqual
+		}
+		name = name[4:]
+		isRange = true
+	}
+
+	if actual, convert, ok := env.rangeFeature(name); ok {
+		if f.Value == nil && !isRange {
+			return mediaBool(actual != 0) // boolean context
+		}
+		res := true
+		if f.Value != nil {
+			v, ok := convert(f.Value)
+			if !ok {
+				return mediaUnknown
+			}
+			res = compareMedia(actual, op, v)
+		}
+		if f.Left != nil {
+			v, ok := convert(f.Left)
+			if !ok {
+				return mediaUnknown
+			}
+			res = res && compareMedia(actual, f.LeftOp.flip(), v)
+		}
+		if f.Right != nil {
+			v, ok := convert(f.Right)
+			if !ok {
+				return mediaUnknown
+			}
+			res = res && compareMedia(actual, f.RightOp, v)
+		}
+		return mediaBool(res)
+	} else if isRange {
+		return mediaUnknown // discrete features have no range syntax
+	}
+
+	var actual string
+	if name == "orientation" {
+		actual = "landscape"
+		if env.Width <= env.Height {
+			actual = "portrait"
+		}
+	} else if value, ok := env.Features[name]; ok {
+		actual = value
+	} else if value, ok := defaultMediaFeatures[name]; ok {
+		actual = value
+	} else {
+		return mediaUnknown
+	}
+	if f.Value == nil {
+		return mediaBool(actual != "none" && actual != "no-preference" && actual != "0")
+	}
+	return mediaBool(string(f.Value.Data) == actual)
+}
+
+// MatchingRules returns the rules that apply in the environment, where @media rules are replaced by their contents when they match and are left out otherwise. The blocks of other rules are filtered as well, so that nested @media rules are resolved.
+func (env *MediaEnv) MatchingRules(rules Block) Block {
+	matching := Block{}
+	for _, n := range rules {
+		switch rule := n.(type) {
+		case *AtRule:
+			if rule.HasBlock && parse.EqualFold(rule.Name, []byte("@media")) {
+				if list, _ := rule.MediaQueryList(); list.Matches(env) {
+					matching = append(matching, env.MatchingRules(rule.Block)...)
+				}
+				continue
+			} else if 0 < len(rule.Block) {
+				atRule := *rule
+				atRule.Block = env.MatchingRules(rule.Block)
+				n = &atRule
+			}
+		case *QualifiedRule:
+			qualifiedRule := *rule
+			qualifiedRule.Block = env.MatchingRules(rule.Block)
+			n = &qualifiedRule
+		}
+		matching = append(matching, n)
+	}
+	return matching
+}
+
+////////////////////////////////////////////////////////////////
+
+// String returns the CSS representation of the media query list.
+func (l MediaQueryList) String() string {
+	b := []byte{}
+	for i, query := range l {
+		if i != 0 {
+			b = append(b, ',')
+		}
+		b = query.appendCSS(b)
+	}
+	return string(b)
+}
+
+// String returns the CSS representation of the media query.
+func (q MediaQuery) String() string {
+	return string(q.appendCSS(nil))
+}
+
+// String returns the CSS representation of the media condition.
+func (c *MediaCondition) String() string {
+	return string(c.appendCSS(nil))
+}
+
+func (q MediaQuery) appendCSS(b []byte) []byte {
+	if q.Invalid {
+		return append(b, "not all"...)
+	} else if q.Not {
+		b = append(b, "not "...)
+	} else if q.Only {
+		b = append(b, "only "...)
+	}
+	if q.Type != nil {
+		b = append(b, q.Type...)
+		if q.Condition == nil {
+			return b
+		}
+		b = append(b, " and "...)
+	}
+	return q.Condition.appendCSS(b)
+}
+
+func (c *MediaCondition) appendCSS(b []byte) []byte {
+	switch c.Type {
+	case MediaFeatureCondition:
+		return c.Feature.appendCSS(b)
+	case MediaNotCondition:
+		b = append(b, "not "...)
+		return c.Conditions[0].appendOperand(b)
+	case MediaAndCondition, MediaOrCondition:
+		for i, cond := range c.Conditions {
+			if i != 0 {
+				if c.Type == MediaAndCondition {
+					b = append(b, " and "...)
+				} else {
+					b = append(b, " or "...)
+				}
+			}
+			b = cond.appendOperand(b)
+		}
+		return b
+	}
+	return appendTokens(b, c.Tokens)
+}
+
+// appendOperand appends a condition that is an operand of not, and, or or, which requires parentheses unless it is a feature or general enclosed.
+func (c *MediaCondition) appendOperand(b []byte) []byte {
+	if c.Type == MediaFeatureCondition || c.Type == MediaGeneralEnclosed {
+		return c.appendCSS(b)
+	}
+	b = append(b, '(')
+	b = c.appendCSS(b)
+	return append(b, ')')
+}
+
+func (f *MediaFeature) appendCSS(b []byte) []byte {
+	b = append(b, '(')
+	if f.Left != nil {
+		b = append(b, f.Left.Data...)
+		b = append(b, f.LeftOp.String()...)
+	}
+	b = append(b, f.Name...)
+	if f.Value != nil {
+		b = append(b, ':')
+		b = append(b, f.Value.Data...)
+	} else if f.Right != nil {
+		b = append(b, f.RightOp.String()...)
+		b = append(b, f.Right.Data...)
+	}
+	return append(b, ')')
+}
+
+// String returns the CSS representation of the media feature.
+func (f *MediaFeature) String() string {
+	return string(f.appendCSS(nil))
+}
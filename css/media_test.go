@@ -0,0 +1,165 @@
+package css
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestParseMediaQueryList(t *testing.T) {
+	var tests = []struct {
+		media    string
+		expected string
+	}{
+		{"", ""},
+		{"screen", "screen"},
+		{"SCREEN , print", "screen,print"},
+		{"only screen and (color)", "only screen and (color)"},
+		{"not print and (min-width: 400px) and (max-width:800PX)", "not print and (min-width:400px) and (max-width:800PX)"},
+		{"(min-resolution: 2dppx), (orientation: PORTRAIT)", "(min-resolution:2dppx),(orientation:portrait)"},
+		{"(width >= 600px)", "(width>=600px)"},
+		{"(400px <= width < 800px)", "(400px<=width<800px)"},
+		{"(800px > width >= 400px)", "(800px>width>=400px)"},
+		{"(aspect-ratio: 16 / 9)", "(aspect-ratio:16/9)"},
+		{"(width = 0)", "(width=0)"},
+		{"not (color)", "not (color)"},
+		{"(color) and (hover) and (pointer: fine)", "(color) and (hover) and (pointer:fine)"},
+		{"(color) or (not (hover))", "(color) or (not (hover))"},
+		{"((color) and (hover)) or (monochrome)", "((color) and (hover)) or (monochrome)"},
+		{"(not (color)) and (hover)", "(not (color)) and (hover)"},
+		{"screen and (foo bar), (unknown-function())", "screen and (foo bar),(unknown-function())"},
+		{"fn(a, b) or (color)", "fn(a, b) or (color)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.media, func(t *testing.T) {
+			list, err := ParseMediaQueryList(lexSelector(tt.media))
+			test.Error(t, err)
+			test.String(t, list.String(), tt.expected)
+
+			list2, err := ParseMediaQueryList(lexSelector(tt.expected))
+			test.Error(t, err)
+			test.String(t, list2.String(), tt.expected)
+		})
+	}
+}
+
+func TestParseMediaQueryListError(t *testing.T) {
+	var tests = []struct {
+		media    string
+		expected string
+		err      string
+	}{
+		{"screen and", "not all", "CSS parse error: unexpected ending in media condition"},
+		{"screen, and", "screen,not all", "CSS parse error: unexpected token 'and' in media query"},
+		{"only (color)", "not all", "CSS parse error: unexpected token '(' in media query"},
+		{"screen and (color) or (hover)", "not all", "CSS parse error: unexpected token 'or' in media query"},
+		{"(color) and (hover) or (pointer)", "not all", "CSS parse error: unexpected token 'or' in media query"},
+		{"screen print, print", "not all,print", "CSS parse error: unexpected token 'print' in media query"},
+		{"screen,,print", "screen,not all,print", "CSS parse error: unexpected ending in media condition"},
+		{"(color", "not all", "CSS parse error: unexpected ending in media condition"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.media, func(t *testing.T) {
+			list, err := ParseMediaQueryList(lexSelector(tt.media))
+			test.That(t, err != nil, "must fail")
+			test.String(t, err.Error(), tt.err)
+			test.String(t, list.String(), tt.expected)
+		})
+	}
+}
+
+func TestMediaQueryMatches(t *testing.T) {
+	env := &MediaEnv{
+		Width:      1000,
+		Height:     800,
+		Resolution: 2,
+		Features: map[string]string{
+			"prefers-color-scheme": "dark",
+			"hover":                "none",
+		},
+	}
+	var tests = []struct {
+		media    string
+		expected bool
+	}{
+		{"", true},
+		{"all", true},
+		{"screen", true},
+		{"print", false},
+		{"not print", true},
+		{"not screen", false},
+		{"only screen", true},
+		{"tv, screen", true},
+		{"(width)", true},
+		{"(min-width: 1000px)", true},
+		{"(min-width: 1001px)", false},
+		{"(max-width: 62.5em)", true},
+		{"(max-width: 62rem)", false},
+		{"(width: 1000px)", true},
+		{"(width > 1000px)", false},
+		{"(1000px <= width < 1200px)", true},
+		{"(1200px > width > 1000px)", false},
+		{"(width < 26.5cm)", true},
+		{"(height >= 8.3in)", true},
+		{"(width: 0)", false},
+		{"(width: 100)", false},
+		{"(width: foo)", false},
+		{"(aspect-ratio: 5/4)", true},
+		{"(min-aspect-ratio: 16/9)", false},
+		{"(aspect-ratio > 1)", true},
+		{"(orientation: landscape)", true},
+		{"(orientation: portrait)", false},
+		{"(min-resolution: 2dppx)", true},
+		{"(resolution >= 192dpi)", true},
+		{"(resolution > 2x)", false},
+		{"(color)", true},
+		{"(min-color: 8)", true},
+		{"(monochrome)", false},
+		{"(prefers-color-scheme: dark)", true},
+		{"(prefers-color-scheme: light)", false},
+		{"(prefers-reduced-motion)", false},
+		{"(prefers-reduced-motion: no-preference)", true},
+		{"(hover)", false},
+		{"(hover: none)", true},
+		{"(pointer: fine)", true},
+		{"(grid)", false},
+		{"(unknown)", false},
+		{"not (unknown)", false},
+		{"(unknown) or (color)", true},
+		{"(unknown) and (color)", false},
+		{"not screen and (unknown)", false},
+		{"not (monochrome)", true},
+		{"(color) and (not (hover))", true},
+		{"(min-prefers-color-scheme: dark)", false},
+		{"(foo bar) or (width)", true},
+		{"screen and", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.media, func(t *testing.T) {
+			list, _ := ParseMediaQueryList(lexSelector(tt.media))
+			test.T(t, list.Matches(env), tt.expected)
+		})
+	}
+
+	print := &MediaEnv{Type: "print", Width: 500, Height: 700}
+	list, err := ParseMediaQueryList(lexSelector("print and (orientation: portrait) and (device-width <= 500px)"))
+	test.Error(t, err)
+	test.That(t, list.Matches(print))
+	test.That(t, !list.Matches(env))
+}
+
+func TestMatchingRules(t *testing.T) {
+	s, err := ParseStylesheet(parse.NewInputString("a{x:y} @media print { b{x:y} } @media screen and (min-width: 500px) { c{x:y} @media (prefers-color-scheme: dark) { d{x:y} } } @supports (display: grid) { @media (max-width: 400px) { e{x:y} } f{x:y} } @font-face { font-family: x }"), false)
+	test.Error(t, err)
+
+	rules := []string{}
+	for _, n := range (&MediaEnv{Width: 800, Height: 600}).MatchingRules(s.Rules) {
+		rules = append(rules, n.String())
+	}
+	test.String(t, strings.Join(rules, " "), "a{x:y;} c{x:y;} @supports(display:grid){f{x:y;}} @font-face{font-family:x;}")
+
+	// the original tree is unchanged
+	test.String(t, s.Rules[3].String(), "@supports(display:grid){@media(max-width:400px){e{x:y;}}f{x:y;}}")
+}
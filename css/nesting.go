@@ -0,0 +1,164 @@
+package css
+
+// Denest flattens nested style rules into top-level style rules for browsers that do not support CSS Nesting, see https://www.w3.org/TR/css-nesting-1/. Nested selectors are combined with every selector of their parent, where the nesting selector & is replaced by the parent selector, or by :is() of the parent selector when it is not a compound selector that can be joined, and a nested selector without & is a descendant of the parent or starts with a combinator. Declarations after nested rules are kept in order by repeating the parent selectors, and nested group rules such as @media are moved out of the style rule and wrap the declarations and rules they contain. Unlike the nesting selector, which behaves as :is(), the combined selectors have the specificity of the selectors they are made of.
+func (s *Stylesheet) Denest() {
+	s.Rules = denestRules(s.Rules)
+}
+
+func denestRules(rules Block) Block {
+	flat := Block{}
+	for _, n := range rules {
+		switch n := n.(type) {
+		case *QualifiedRule:
+			flat = append(flat, denestRule(n.Selectors, n.Block)...)
+		case *AtRule:
+			n.Block = denestRules(n.Block)
+			flat = append(flat, n)
+		default:
+			flat = append(flat, n)
+		}
+	}
+	return flat
+}
+
+// denestRule returns the flattened rules for the contents of a style rule with the given selectors, which have already been resolved against their parents.
+func denestRule(selectors [][]Token, block Block) Block {
+	flat := Block{}
+	var decls *QualifiedRule // rule that collects consecutive declarations
+	for _, n := range block {
+		switch n := n.(type) {
+		case *QualifiedRule:
+			decls = nil
+			flat = append(flat, denestRule(resolveSelectors(selectors, n.Selectors), n.Block)...)
+			continue
+		case *AtRule:
+			if n.HasBlock && n.Tokens == nil {
+				decls = nil
+				flat = append(flat, &AtRule{
+					Name:     n.Name,
+					Prelude:  n.Prelude,
+					HasBlock: true,
+					Block:    denestRule(selectors, n.Block),
+				})
+				continue
+			}
+		}
+		if decls == nil {
+			decls = &QualifiedRule{Selectors: selectors, Block: Block{}}
+			flat = append(flat, decls)
+		}
+		decls.Block = append(decls.Block, n)
+	}
+	if len(flat) == 0 {
+		flat = append(flat, &QualifiedRule{Selectors: selectors, Block: Block{}})
+	}
+	return flat
+}
+
+// resolveSelectors returns the selectors of a nested style rule combined with every selector of its parent rule. A nested selector with more than one & is combined with all parent selectors at once, so that every & can match any of them.
+func resolveSelectors(parents, selectors [][]Token) [][]Token {
+	var is []Token // :is() of all parent selectors
+	resolved := make([][]Token, 0, len(parents)*len(selectors))
+	for i, parent := range parents {
+		for _, sel := range selectors {
+			if len(parents) == 1 || countNesting(sel) < 2 {
+				resolved = append(resolved, resolveSelector(parent, sel))
+			} else if i == 0 {
+				if is == nil {
+					is = isSelector(parents)
+				}
+				resolved = append(resolved, resolveSelector(is, sel))
+			}
+		}
+	}
+	return resolved
+}
+
+// resolveSelector replaces each & in the selector by the parent selector, or prepends the parent selector if there is none. The parent selector is wrapped in :is() unless & starts the selector, or the parent is a compound selector and & starts a compound selector.
+func resolveSelector(parent, sel []Token) []Token {
+	resolved := []Token{}
+	if countNesting(sel) == 0 {
+		resolved = append(resolved, parent...)
+		if len(sel) == 0 {
+			return resolved
+		} else if !isCombinator(sel[0]) {
+			resolved = append(resolved, Token{TokenType: WhitespaceToken, Data: wsBytes})
+		}
+		return append(resolved, sel...)
+	}
+
+	var is []Token // parent wrapped in :is()
+	compound := isCompoundSelector(parent)
+	for i, t := range sel {
+		if t.TokenType != DelimToken || t.Data[0] != '&' {
+			resolved = append(resolved, t)
+		} else if i == 0 || compound && startsCompound(sel[i-1]) {
+			resolved = append(resolved, parent...)
+		} else {
+			if is == nil {
+				is = isSelector([][]Token{parent})
+			}
+			resolved = append(resolved, is...)
+		}
+	}
+	return resolved
+}
+
+// countNesting returns the number of nesting selectors & in the selector.
+func countNesting(sel []Token) int {
+	n := 0
+	for _, t := range sel {
+		if t.TokenType == DelimToken && t.Data[0] == '&' {
+			n++
+		}
+	}
+	return n
+}
+
+// isSelector returns the selector :is() of the given selectors.
+func isSelector(selectors [][]Token) []Token {
+	is := []Token{{TokenType: ColonToken, Data: []byte(":")}, {TokenType: FunctionToken, Data: []byte("is(")}}
+	for i, sel := range selectors {
+		if i != 0 {
+			is = append(is, Token{TokenType: CommaToken, Data: []byte(",")})
+		}
+		is = append(is, sel...)
+	}
+	return append(is, Token{TokenType: RightParenthesisToken, Data: []byte(")")})
+}
+
+// isCompoundSelector returns true if the selector has no combinators or commas outside of its functions and attribute selectors.
+func isCompoundSelector(sel []Token) bool {
+	level := 0
+	for _, t := range sel {
+		switch t.TokenType {
+		case FunctionToken, LeftParenthesisToken, LeftBracketToken:
+			level++
+		case RightParenthesisToken, RightBracketToken:
+			level--
+		case WhitespaceToken, CommaToken:
+			if level == 0 {
+				return false
+			}
+		default:
+			if level == 0 && isCombinator(t) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isCombinator returns true if the token is the combinator >, +, ~, or ||.
+func isCombinator(t Token) bool {
+	return t.TokenType == ColumnToken || t.TokenType == DelimToken && (t.Data[0] == '>' || t.Data[0] == '+' || t.Data[0] == '~')
+}
+
+// startsCompound returns true if a compound selector starts after the token.
+func startsCompound(prev Token) bool {
+	switch prev.TokenType {
+	case WhitespaceToken, CommaToken, FunctionToken, LeftParenthesisToken:
+		return true
+	}
+	return isCombinator(prev)
+}
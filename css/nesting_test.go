@@ -0,0 +1,56 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestDenest(t *testing.T) {
+	var tests = []struct {
+		css      string
+		expected string
+	}{
+		{"a{x:y;}", "a{x:y;}"},
+		{"a{}", "a{}"},
+		{".a{color:red;&:hover{color:blue;}}", ".a{color:red;}.a:hover{color:blue;}"},
+		{".a{.b{x:y;}}", ".a .b{x:y;}"},
+		{".a{& .b{x:y;}}", ".a .b{x:y;}"},
+		{".a{&.b{x:y;}}", ".a.b{x:y;}"},
+		{".a{.b &{x:y;}}", ".b .a{x:y;}"},
+		{".a{& + &{x:y;}}", ".a+.a{x:y;}"},
+		{".a{>.b{x:y;}}", ".a>.b{x:y;}"},
+		{".a{~ .b{x:y;}}", ".a~.b{x:y;}"},
+		{".a{:is(&) .b{x:y;}}", ":is(.a) .b{x:y;}"},
+		{"div{.x&{x:y;}}", ".x:is(div){x:y;}"},
+		{".a .b{.c&{x:y;}}", ".c:is(.a .b){x:y;}"},
+		{".a .b{&.c{x:y;}}", ".a .b.c{x:y;}"},
+		{".a .b{.c &{x:y;}}", ".c :is(.a .b){x:y;}"},
+		{".a,.b{& + &{x:y;}}", ":is(.a,.b)+:is(.a,.b){x:y;}"},
+		{".a,.b{&:hover{x:y;}}", ".a:hover,.b:hover{x:y;}"},
+		{".a>.b{.c &{x:y;}}", ".c :is(.a>.b){x:y;}"},
+		{":not(.a .b){&.c{x:y;}}", ":not(.a .b).c{x:y;}"},
+		{".a,.b{.c,.d{x:y;}}", ".a .c,.a .d,.b .c,.b .d{x:y;}"},
+		{"a b{&>c{x:y;}}", "a b>c{x:y;}"},
+		{".a{.b{.c{x:y;}}}", ".a .b .c{x:y;}"},
+		{".a{.b{&:hover{x:y;}}z:w;}", ".a .b:hover{x:y;}.a{z:w;}"},
+		{".a{x:y;.b{}z:w;}", ".a{x:y;}.a .b{}.a{z:w;}"},
+		{".a{&:hover{}}", ".a:hover{}"},
+		{".a{@media print{x:y;}}", "@media print{.a{x:y;}}"},
+		{".a{x:y;@media print{x:z;.b &{x:w;}}}", ".a{x:y;}@media print{.a{x:z;}.b .a{x:w;}}"},
+		{".a{@media print{@supports (display:grid){.b{x:y;}}}}", "@media print{@supports(display:grid){.a .b{x:y;}}}"},
+		{"@media print{.a{&.b{x:y;}}}", "@media print{.a.b{x:y;}}"},
+		{"@font-face{font:x;}", "@font-face{font:x;}"},
+		{"@unknown{{} lala }", "@unknown{{} lala }"},
+		{"/*a*/.a{/*b*/x:y;.c{}}", "/*a*/.a{x:y;}.a .c{}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.css, func(t *testing.T) {
+			s, err := ParseStylesheet(parse.NewInputString(tt.css), false)
+			test.Error(t, err)
+			s.Denest()
+			test.String(t, s.String(), tt.expected)
+		})
+	}
+}
@@ -65,10 +65,11 @@ func (tt GrammarType) String() string {
 // State is the state function the parser currently is in.
 type State func(*Parser) GrammarType
 
-// Token is a single TokenType and its associated data.
+// Token is a single TokenType and its associated data. Offset is the byte offset of the token in the input, which is zero for tokens that are not in the input such as those inserted by Denest.
 type Token struct {
 	TokenType
-	Data []byte
+	Data   []byte
+	Offset int
 }
 
 func (t Token) String() string {
@@ -81,12 +82,16 @@ type Parser struct {
 	state  []State
 	err    string
 	errPos int
+	errs   []parseError
 
 	buf   []Token
 	level int
 
 	data        []byte
 	tt          TokenType
+	offset      int // offset of the current grammar
+	tokenOffset int // offset of the last popped token
+	wsOffset    int // offset of the whitespace before the last popped token
 	keepWS      bool
 	prevWS      bool
 	prevEnd     bool
@@ -109,6 +114,11 @@ func NewParser(r *parse.Input, isInline bool) *Parser {
 	return p
 }
 
+type parseError struct {
+	msg    string
+	offset int
+}
+
 // HasParseError returns true if there is a parse error (and not a read error).
 func (p *Parser) HasParseError() bool {
 	return p.err != ""
@@ -123,21 +133,42 @@ func (p *Parser) Err() error {
 	return p.l.Err()
 }
 
+// Errors returns all parse errors encountered so far, including their line and column. The parser recovers from parse errors, so that a stylesheet can be parsed completely to report all of its errors.
+func (p *Parser) Errors() []error {
+	errs := make([]error, 0, len(p.errs))
+	for _, err := range p.errs {
+		errs = append(errs, parse.NewError(buffer.NewReader(p.l.r.Bytes()), err.offset, err.msg))
+	}
+	return errs
+}
+
+// Offset returns the byte offset in the input of the current Grammar, which is the offset of the token returned by Next.
+func (p *Parser) Offset() int {
+	return p.offset
+}
+
+func (p *Parser) setError(msg string, offset int) {
+	p.err, p.errPos = msg, offset
+	p.errs = append(p.errs, parseError{msg, offset})
+}
+
 // Next returns the next Grammar. It returns ErrorGrammar when an error was encountered. Using Err() one can retrieve the error message.
 func (p *Parser) Next() (GrammarType, TokenType, []byte) {
 	p.err = ""
 
 	if p.prevEnd {
 		p.tt, p.data = RightBraceToken, endBytes
+		p.offset = p.l.r.Offset() - len(endBytes)
 		p.prevEnd = false
 	} else {
 		p.tt, p.data = p.popToken(true)
+		p.offset = p.tokenOffset
 	}
 	gt := p.state[len(p.state)-1](p)
 	return gt, p.tt, p.data
 }
 
-// Values returns a slice of Tokens for the last Grammar. Only AtRuleGrammar, BeginAtRuleGrammar, BeginRulesetGrammar and Declaration will return the at-rule components, ruleset selector and declaration values respectively.
+// Values returns a slice of Tokens for the last Grammar. Only AtRuleGrammar, BeginAtRuleGrammar, BeginRulesetGrammar and Declaration will return the at-rule components, ruleset selector and declaration values respectively. Whitespace tokens that replace whitespace and comments have the offset of the first whitespace.
 func (p *Parser) Values() []Token {
 	return p.buf
 }
@@ -148,6 +179,9 @@ func (p *Parser) popToken(allowComment bool) (TokenType, []byte) {
 	tt, data := p.l.Next()
 	for !p.keepWS && tt == WhitespaceToken || tt == CommentToken {
 		if tt == WhitespaceToken {
+			if !p.prevWS {
+				p.wsOffset = p.l.r.Offset() - len(data)
+			}
 			p.prevWS = true
 		} else {
 			p.prevComment = true
@@ -157,15 +191,22 @@ func (p *Parser) popToken(allowComment bool) (TokenType, []byte) {
 		}
 		tt, data = p.l.Next()
 	}
+	p.tokenOffset = p.l.r.Offset() - len(data)
 	return tt, data
 }
 
+// skipToken replaces the current token by the next token.
+func (p *Parser) skipToken() {
+	p.tt, p.data = p.popToken(false)
+	p.offset = p.tokenOffset
+}
+
 func (p *Parser) initBuf() {
 	p.buf = p.buf[:0]
 }
 
-func (p *Parser) pushBuf(tt TokenType, data []byte) {
-	p.buf = append(p.buf, Token{tt, data})
+func (p *Parser) pushBuf(tt TokenType, data []byte, offset int) {
+	p.buf = append(p.buf, Token{tt, data, offset})
 }
 
 ////////////////////////////////////////////////////////////////
@@ -174,7 +215,7 @@ func (p *Parser) parseStylesheet() GrammarType {
 	if p.tt == CDOToken || p.tt == CDCToken {
 		return TokenGrammar
 	} else if p.tt == AtKeywordToken {
-		return p.parseAtRule()
+		return p.parseAtRule(false)
 	} else if p.tt == CommentToken {
 		return CommentGrammar
 	} else if p.tt == ErrorToken {
@@ -184,11 +225,20 @@ func (p *Parser) parseStylesheet() GrammarType {
 }
 
 func (p *Parser) parseDeclarationList() GrammarType {
+	return p.parseBlockContents(false)
+}
+
+// parseBlockContents parses the declarations and at-rules of a declaration list, and nested style rules and nested group rules when nesting is true, see https://www.w3.org/TR/css-nesting-1/.
+func (p *Parser) parseBlockContents(nesting bool) GrammarType {
 	if p.tt == CommentToken {
-		p.tt, p.data = p.popToken(false)
+		p.skipToken()
 	}
 	for p.tt == SemicolonToken {
-		p.tt, p.data = p.popToken(false)
+		p.skipToken()
+	}
+
+	if nesting && p.isNestedRuleStart() {
+		return p.parseQualifiedRule()
 	}
 
 	// IE hack: *color:red;
@@ -201,31 +251,78 @@ func (p *Parser) parseDeclarationList() GrammarType {
 	if p.tt == ErrorToken {
 		return ErrorGrammar
 	} else if p.tt == AtKeywordToken {
-		return p.parseAtRule()
+		return p.parseAtRule(nesting)
 	} else if p.tt == IdentToken || p.tt == DelimToken {
-		return p.parseDeclaration()
+		return p.parseDeclaration(nesting)
 	} else if p.tt == CustomPropertyNameToken {
 		return p.parseCustomProperty()
 	}
 
 	// parse error
 	p.initBuf()
-	p.l.r.Move(-len(p.data))
-	p.err, p.errPos = fmt.Sprintf("CSS parse error: unexpected token '%s' in declaration", string(p.data)), p.l.r.Offset()
-	p.l.r.Move(len(p.data))
+	p.setError(fmt.Sprintf("CSS parse error: unexpected token '%s' in declaration", string(p.data)), p.offset)
 
 	if p.tt == RightBraceToken {
 		// right brace token will occur when we've had a decl error that ended in a right brace token
 		// as these are not handled by decl error, we handle it here explicitly. Normally its used to end eg. the qual rule.
-		p.pushBuf(p.tt, p.data)
+		p.pushBuf(p.tt, p.data, p.offset)
 		return ErrorGrammar
 	}
-	return p.parseDeclarationError(p.tt, p.data)
+	return p.parseDeclarationError(p.tt, p.data, p.offset)
+}
+
+// isNestedRuleStart returns true if the current token can only start a nested style rule and not a declaration. Identifiers are parsed as declarations first, and as style rules only when a block starts before the end of the declaration, see parseNestedRule.
+func (p *Parser) isNestedRuleStart() bool {
+	switch p.tt {
+	case HashToken, ColonToken, LeftBracketToken, ColumnToken:
+		return true
+	case DelimToken:
+		switch p.data[0] {
+		case '&', '.', '>', '+', '~', '|':
+			return true
+		}
+		// the IE hack *color:red; and invalid declarations
+		return p.isNestedRule(p.tt)
+	}
+	return false
+}
+
+// isNestedRule returns true if the declaration continuing with the given token is a nested style rule instead, which is the case when a block starts before the end of the declaration. The lexer is rewound afterwards.
+func (p *Parser) isNestedRule(tt TokenType) bool {
+	offset := p.l.r.Offset()
+	defer func() {
+		p.l.r.Move(offset - p.l.r.Offset())
+		p.l.r.Skip()
+	}()
+
+	level := 0
+	for {
+		if tt == LeftBraceToken && level == 0 {
+			return true
+		} else if (tt == SemicolonToken || tt == RightBraceToken) && level == 0 || tt == ErrorToken {
+			return false
+		} else if tt == LeftParenthesisToken || tt == LeftBraceToken || tt == LeftBracketToken || tt == FunctionToken {
+			level++
+		} else if tt == RightParenthesisToken || tt == RightBraceToken || tt == RightBracketToken {
+			level--
+		}
+		tt, _ = p.l.Next()
+	}
+}
+
+// parseNestedRule parses the declaration that started at offset as a nested style rule instead, after a block was found before the end of the declaration as in a:hover{...}. The lexer is rewound to offset.
+func (p *Parser) parseNestedRule(offset int) GrammarType {
+	p.l.r.Move(offset - p.l.r.Offset())
+	p.l.r.Skip()
+	p.level = 0
+	p.tt, p.data = p.popToken(false)
+	p.offset = p.tokenOffset
+	return p.parseQualifiedRule()
 }
 
 ////////////////////////////////////////////////////////////////
 
-func (p *Parser) parseAtRule() GrammarType {
+func (p *Parser) parseAtRule(nested bool) GrammarType {
 	p.initBuf()
 	parse.ToLower(p.data)
 	atRuleName := p.data
@@ -241,7 +338,9 @@ func (p *Parser) parseAtRule() GrammarType {
 	for {
 		tt, data := p.popToken(false)
 		if tt == LeftBraceToken && p.level == 0 {
-			if atRule == Font_Face || atRule == Page {
+			if nested && isNestedGroupRule(atRule) {
+				p.state = append(p.state, (*Parser).parseNestedAtRuleDeclarationList)
+			} else if atRule == Font_Face || atRule == Page {
 				p.state = append(p.state, (*Parser).parseAtRuleDeclarationList)
 			} else if atRule == Document || atRule == Keyframes || atRule == Media || atRule == Supports {
 				p.state = append(p.state, (*Parser).parseAtRuleRuleList)
@@ -266,15 +365,24 @@ func (p *Parser) parseAtRule() GrammarType {
 		if len(data) == 1 && (data[0] == ',' || data[0] == ':') {
 			skipWS = true
 		} else if p.prevWS && !skipWS && tt != RightParenthesisToken {
-			p.pushBuf(WhitespaceToken, wsBytes)
+			p.pushBuf(WhitespaceToken, wsBytes, p.wsOffset)
 		} else {
 			skipWS = false
 		}
 		if tt == LeftParenthesisToken {
 			skipWS = true
 		}
-		p.pushBuf(tt, data)
+		p.pushBuf(tt, data, p.tokenOffset)
+	}
+}
+
+// isNestedGroupRule returns true for conditional group rules and other at-rules that may be nested in style rules and contain declarations and nested style rules.
+func isNestedGroupRule(atRule Hash) bool {
+	switch atRule {
+	case Media, Supports, Document, Container, Layer, Scope, Starting_Style:
+		return true
 	}
+	return false
 }
 
 func (p *Parser) parseAtRuleRuleList() GrammarType {
@@ -282,7 +390,7 @@ func (p *Parser) parseAtRuleRuleList() GrammarType {
 		p.state = p.state[:len(p.state)-1]
 		return EndAtRuleGrammar
 	} else if p.tt == AtKeywordToken {
-		return p.parseAtRule()
+		return p.parseAtRule(false)
 	} else {
 		return p.parseQualifiedRule()
 	}
@@ -290,7 +398,7 @@ func (p *Parser) parseAtRuleRuleList() GrammarType {
 
 func (p *Parser) parseAtRuleDeclarationList() GrammarType {
 	for p.tt == SemicolonToken {
-		p.tt, p.data = p.popToken(false)
+		p.skipToken()
 	}
 	if p.tt == RightBraceToken || p.tt == ErrorToken {
 		p.state = p.state[:len(p.state)-1]
@@ -299,6 +407,17 @@ func (p *Parser) parseAtRuleDeclarationList() GrammarType {
 	return p.parseDeclarationList()
 }
 
+func (p *Parser) parseNestedAtRuleDeclarationList() GrammarType {
+	for p.tt == SemicolonToken {
+		p.skipToken()
+	}
+	if p.tt == RightBraceToken || p.tt == ErrorToken {
+		p.state = p.state[:len(p.state)-1]
+		return EndAtRuleGrammar
+	}
+	return p.parseBlockContents(true)
+}
+
 func (p *Parser) parseAtRuleUnknown() GrammarType {
 	p.keepWS = true
 	if p.tt == RightBraceToken && p.level == 0 || p.tt == ErrorToken {
@@ -321,20 +440,22 @@ func (p *Parser) parseQualifiedRule() GrammarType {
 	skipWS := true
 	var tt TokenType
 	var data []byte
+	var offset int
 	for {
 		if first {
-			tt, data = p.tt, p.data
+			tt, data, offset = p.tt, p.data, p.offset
 			p.tt = WhitespaceToken
 			p.data = emptyBytes
 			first = false
 		} else {
 			tt, data = p.popToken(false)
+			offset = p.tokenOffset
 		}
 		if tt == LeftBraceToken && p.level == 0 {
 			p.state = append(p.state, (*Parser).parseQualifiedRuleDeclarationList)
 			return BeginRulesetGrammar
 		} else if tt == ErrorToken {
-			p.err, p.errPos = "CSS parse error: unexpected ending in qualified rule", p.l.r.Offset()
+			p.setError("CSS parse error: unexpected ending in qualified rule", offset)
 			return ErrorGrammar
 		} else if tt == LeftParenthesisToken || tt == LeftBraceToken || tt == LeftBracketToken || tt == FunctionToken {
 			p.level++
@@ -342,12 +463,12 @@ func (p *Parser) parseQualifiedRule() GrammarType {
 			p.level--
 		}
 		if len(data) == 1 && (data[0] == ',' || data[0] == '>' || data[0] == '+' || data[0] == '~') {
-			if data[0] == ',' {
+			if data[0] == ',' && p.level == 0 {
 				return QualifiedRuleGrammar
 			}
 			skipWS = true
 		} else if p.prevWS && !skipWS && !inAttrSel {
-			p.pushBuf(WhitespaceToken, wsBytes)
+			p.pushBuf(WhitespaceToken, wsBytes, p.wsOffset)
 		} else {
 			skipWS = false
 		}
@@ -356,40 +477,45 @@ func (p *Parser) parseQualifiedRule() GrammarType {
 		} else if tt == RightBracketToken {
 			inAttrSel = false
 		}
-		p.pushBuf(tt, data)
+		p.pushBuf(tt, data, offset)
 	}
 }
 
 func (p *Parser) parseQualifiedRuleDeclarationList() GrammarType {
 	for p.tt == SemicolonToken {
-		p.tt, p.data = p.popToken(false)
+		p.skipToken()
 	}
 	if p.tt == RightBraceToken || p.tt == ErrorToken {
 		p.state = p.state[:len(p.state)-1]
 		return EndRulesetGrammar
 	}
-	return p.parseDeclarationList()
+	return p.parseBlockContents(true)
 }
 
-func (p *Parser) parseDeclaration() GrammarType {
+// parseDeclaration parses a declaration, or a nested style rule when nesting is set and a block starts before the end of the declaration.
+func (p *Parser) parseDeclaration(nesting bool) GrammarType {
 	p.initBuf()
-	parse.ToLower(p.data)
 
-	ttName, dataName := p.tt, p.data
+	ttName, dataName, offsetName := p.tt, p.data, p.offset
 	tt, data := p.popToken(false)
 	if tt != ColonToken {
-		p.l.r.Move(-len(data))
-		p.err, p.errPos = "CSS parse error: expected colon in declaration", p.l.r.Offset()
-		p.l.r.Move(len(data))
-		p.pushBuf(ttName, dataName)
-		return p.parseDeclarationError(tt, data)
+		if nesting && p.isNestedRule(tt) {
+			return p.parseNestedRule(offsetName)
+		}
+		parse.ToLower(dataName)
+		p.setError("CSS parse error: expected colon in declaration", p.tokenOffset)
+		p.pushBuf(ttName, dataName, p.offset)
+		return p.parseDeclarationError(tt, data, p.tokenOffset)
 	}
 
 	skipWS := true
 	for {
 		tt, data := p.popToken(false)
-		if (tt == SemicolonToken || tt == RightBraceToken) && p.level == 0 || tt == ErrorToken {
+		if tt == LeftBraceToken && p.level == 0 && nesting {
+			return p.parseNestedRule(offsetName)
+		} else if (tt == SemicolonToken || tt == RightBraceToken) && p.level == 0 || tt == ErrorToken {
 			p.prevEnd = (tt == RightBraceToken)
+			parse.ToLower(dataName)
 			return DeclarationGrammar
 		} else if tt == LeftParenthesisToken || tt == LeftBraceToken || tt == LeftBracketToken || tt == FunctionToken {
 			p.level++
@@ -399,22 +525,22 @@ func (p *Parser) parseDeclaration() GrammarType {
 		if len(data) == 1 && (data[0] == ',' || data[0] == '/' || data[0] == ':' || data[0] == '!' || data[0] == '=') {
 			skipWS = true
 		} else if (p.prevWS || p.prevComment) && !skipWS {
-			p.pushBuf(WhitespaceToken, wsBytes)
+			p.pushBuf(WhitespaceToken, wsBytes, p.wsOffset)
 		} else {
 			skipWS = false
 		}
-		p.pushBuf(tt, data)
+		p.pushBuf(tt, data, p.tokenOffset)
 	}
 }
 
-func (p *Parser) parseDeclarationError(tt TokenType, data []byte) GrammarType {
+func (p *Parser) parseDeclarationError(tt TokenType, data []byte, offset int) GrammarType {
 	// we're on the offending (tt,data), keep popping tokens till we reach ;, }, or EOF
 	p.tt, p.data = tt, data
 	for {
 		if (tt == SemicolonToken || tt == RightBraceToken) && p.level == 0 || tt == ErrorToken {
 			p.prevEnd = (tt == RightBraceToken)
 			if tt == SemicolonToken {
-				p.pushBuf(tt, data)
+				p.pushBuf(tt, data, offset)
 			}
 			return ErrorGrammar
 		} else if tt == LeftParenthesisToken || tt == LeftBraceToken || tt == LeftBracketToken || tt == FunctionToken {
@@ -424,28 +550,28 @@ func (p *Parser) parseDeclarationError(tt TokenType, data []byte) GrammarType {
 		}
 
 		if p.prevWS {
-			p.pushBuf(WhitespaceToken, wsBytes)
+			p.pushBuf(WhitespaceToken, wsBytes, p.wsOffset)
 		}
-		p.pushBuf(tt, data)
+		p.pushBuf(tt, data, offset)
 
 		tt, data = p.popToken(false)
+		offset = p.tokenOffset
 	}
 }
 
 func (p *Parser) parseCustomProperty() GrammarType {
 	p.initBuf()
-	if tt, data := p.popToken(false); tt != ColonToken {
-		p.l.r.Move(-len(data))
-		p.err, p.errPos = "CSS parse error: expected colon in custom property", p.l.r.Offset()
-		p.l.r.Move(len(data))
+	if tt, _ := p.popToken(false); tt != ColonToken {
+		p.setError("CSS parse error: expected colon in custom property", p.tokenOffset)
 		return ErrorGrammar
 	}
+	offset := p.l.r.Offset()
 	val := []byte{}
 	for {
 		tt, data := p.l.Next()
 		if (tt == SemicolonToken || tt == RightBraceToken) && p.level == 0 || tt == ErrorToken {
 			p.prevEnd = (tt == RightBraceToken)
-			p.pushBuf(CustomPropertyValueToken, val)
+			p.pushBuf(CustomPropertyValueToken, val, offset)
 			return CustomPropertyGrammar
 		} else if tt == LeftParenthesisToken || tt == LeftBraceToken || tt == LeftBracketToken || tt == FunctionToken {
 			p.level++
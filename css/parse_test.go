@@ -3,6 +3,7 @@ package css
 import (
 	"fmt"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/tdewolff/parse/v2"
@@ -74,6 +75,7 @@ func TestParse(t *testing.T) {
 		{false, "a[x={}]{x:y;}", "a[x={}]{x:y;}"},
 		{false, "a[x=,]{x:y;}", "a[x=,]{x:y;}"},
 		{false, "a[x=+]{x:y;}", "a[x=+]{x:y;}"},
+		{false, ":is(a, b), c:not(d ,e) {x:y;}", ":is(a,b),c:not(d,e){x:y;}"},
 		{false, ".cla .ss > #id { x:y; }", ".cla .ss>#id{x:y;}"},
 		{false, ".cla /*a*/ /*b*/ .ss{}", ".cla .ss{}"},
 		{false, "a{x:f(a(),b);}", "a{x:f(a(),b);}"},
@@ -82,6 +84,24 @@ func TestParse(t *testing.T) {
 		{false, "@media { @viewport }", "@media{@viewport;}"},
 		{false, "table { @unknown }", "table{@unknown;}"},
 
+		// nesting
+		{false, ".a { color: red; &:hover { color: blue; } }", ".a{color:red;&:hover{color:blue;}}"},
+		{false, ".a { .b & { x:y; } }", ".a{.b &{x:y;}}"},
+		{false, ".a { > .b { x:y } + .c{x:y} }", ".a{>.b{x:y;}+.c{x:y;}}"},
+		{false, "a { b:hover { x:y } c:d; }", "a{b:hover{x:y;}c:d;}"},
+		{false, "a { div, p { x:y } z:w }", "a{div,p{x:y;}z:w;}"},
+		{false, "a { :is(b, c) { x:y } #id{} [x=\"{\"]{} }", "a{:is(b,c){x:y;}#id{}[x=\"{\"]{}}"},
+		{false, "a { *color: red; * { x:y } }", "a{*color:red;*{x:y;}}"},
+		{false, "a { b { c { x:y } } }", "a{b{c{x:y;}}}"},
+		{false, "a { Div P { x:y } Div:Hover{} COLOR:Red }", "a{Div P{x:y;}Div:Hover{}color:Red;}"},
+		{false, "a { b:is(c) { x:y } d:f(e) }", "a{b:is(c){x:y;}d:f(e);}"},
+		{false, "a { b > c{} d ~ e{} f|g{} }", "a{b>c{}d~e{}f|g{}}"},
+		{false, ".a { @media (min-width: 1px) { color: red; & .b { x:y } } }", ".a{@media(min-width:1px){color:red;& .b{x:y;}}}"},
+		{false, ".a { @supports (display:grid) { @media print { x:y } } }", ".a{@supports(display:grid){@media print{x:y;}}}"},
+		{false, ".a { @container (width > 1px) { x:y } @layer base { x:y } }", ".a{@container(width > 1px){x:y;}@layer base{x:y;}}"},
+		{false, "@media print { .a { &.b { x:y } } }", "@media print{.a{&.b{x:y;}}}"},
+		{true, "a { x:y }", "ERROR(a { x:y })"},
+
 		// early endings
 		{false, "selector{", "selector{"},
 		{false, "@media{selector{", "@media{selector{"},
@@ -154,7 +174,7 @@ func TestParse(t *testing.T) {
 			break
 		}
 	}
-	test.T(t, Token{IdentToken, []byte("data")}.String(), "Ident('data')")
+	test.T(t, Token{TokenType: IdentToken, Data: []byte("data")}.String(), "Ident('data')")
 }
 
 func TestParseError(t *testing.T) {
@@ -192,6 +212,68 @@ func TestParseError(t *testing.T) {
 	}
 }
 
+func TestParseErrors(t *testing.T) {
+	p := NewParser(parse.NewInputString("a{color 0; margin:0; b:hover{x:y} div p{}}\nb{\n  padding;\n  --x 1}\nc"), false)
+	for {
+		if gt, _, _ := p.Next(); gt == ErrorGrammar && !p.HasParseError() {
+			break
+		}
+	}
+	errs := p.Errors()
+	test.T(t, len(errs), 4)
+
+	var positions []string
+	for _, err := range errs {
+		perr, ok := err.(*parse.Error)
+		test.That(t, ok, "must be *parse.Error")
+		line, col, _ := perr.Position()
+		positions = append(positions, fmt.Sprintf("%d:%d %s", line, col, perr.Message))
+	}
+	test.T(t, positions, []string{
+		"1:9 CSS parse error: expected colon in declaration",
+		"3:10 CSS parse error: expected colon in declaration",
+		"4:7 CSS parse error: expected colon in custom property",
+		"5:2 CSS parse error: unexpected ending in qualified rule",
+	})
+}
+
+func TestParseGrammarOffsets(t *testing.T) {
+	css := "@import 'a.css';\n/*c*/ a > b, c{color: red /*x*/ blue; --x: 1 ;@media print{d{top:0}}}"
+	p := NewParser(parse.NewInputString(css), false)
+	offsets := []string{}
+	for {
+		gt, _, data := p.Next()
+		if gt == ErrorGrammar {
+			break
+		}
+		offsets = append(offsets, fmt.Sprintf("%v@%d", gt, p.Offset()))
+		if gt != CommentGrammar && gt != BeginAtRuleGrammar && gt != AtRuleGrammar && gt != QualifiedRuleGrammar && gt != BeginRulesetGrammar {
+			test.That(t, strings.HasPrefix(css[p.Offset():], string(data)), "grammar", gt, "at", p.Offset())
+		}
+		for _, val := range p.Values() {
+			if val.TokenType == WhitespaceToken {
+				test.That(t, css[val.Offset] == ' ' || css[val.Offset] == '/', "whitespace at", val.Offset)
+			} else {
+				test.That(t, strings.HasPrefix(css[val.Offset:], string(val.Data)), "token", val, "at", val.Offset)
+			}
+		}
+	}
+	test.T(t, offsets, []string{
+		"AtRule@0",
+		"Comment@17",
+		"QualifiedRule@23",
+		"BeginRuleset@30",
+		"Declaration@32",
+		"CustomProperty@55",
+		"BeginAtRule@63",
+		"BeginRuleset@76",
+		"Declaration@78",
+		"EndRuleset@83",
+		"EndAtRule@84",
+		"EndRuleset@85",
+	})
+}
+
 func TestParseOffset(t *testing.T) {
 	z := parse.NewInputString(`div{background:url(link);}`)
 	p := NewParser(z, false)
@@ -0,0 +1,547 @@
+package css
+
+// typeSyntax are the value definition syntaxes of the data types that are not built-in, see https://www.w3.org/TR/css-values-4/. The built-in types are <number>, <integer>, <length>, <percentage>, <length-percentage>, <angle>, <time>, <frequency>, <resolution>, <flex>, <zero>, <ident>, <custom-ident>, <dashed-ident>, <string>, <url>, <color>, <hex-color>, <image>, <line-names>, and <declaration-value>, which matches any value.
+var typeSyntax = map[string]string{
+	"absolute-size":                    "xx-small | x-small | small | medium | large | x-large | xx-large | xxx-large",
+	"alpha-value":                      "<number> | <percentage>",
+	"angle-percentage":                 "<angle> | <percentage>",
+	"attachment":                       "scroll | fixed | local",
+	"auto-repeat":                      "repeat( [ auto-fill | auto-fit ] , [ <line-names>? <track-size> ]+ <line-names>? )",
+	"baseline-position":                "[ first | last ]? baseline",
+	"basic-shape":                      "<inset()> | <circle()> | <ellipse()> | <polygon()> | <path()> | <rect()> | <xywh()>",
+	"bg-clip":                          "<visual-box> | border-area | text",
+	"bg-image":                         "none | <image>",
+	"bg-layer":                         "<bg-image> || <position> [ / <bg-size> ]? || <repeat-style> || <attachment> || <bg-clip> || <visual-box>",
+	"bg-size":                          "[ <length-percentage [0,∞]> | auto ]{1,2} | cover | contain",
+	"blend-mode":                       "normal | multiply | screen | overlay | darken | lighten | color-dodge | color-burn | hard-light | soft-light | difference | exclusion | hue | saturation | color | luminosity",
+	"border-radius-corner":             "<length-percentage [0,∞]>{1,2}",
+	"circle()":                         "circle( <shape-radius>? [ at <position> ]? )",
+	"compositing-operator":             "add | subtract | intersect | exclude",
+	"content-distribution":             "space-between | space-around | space-evenly | stretch",
+	"content-list":                     "[ <string> | <image> | <counter> | <quote> | contents ]+",
+	"content-position":                 "center | start | end | flex-start | flex-end",
+	"coord-box":                        "<visual-box> | fill-box | stroke-box | view-box",
+	"counter":                          "counter( <custom-ident> [ , <counter-style> ]? ) | counters( <custom-ident> , <string> [ , <counter-style> ]? )",
+	"counter-style":                    "<custom-ident> | symbols( <symbols-type>? [ <string> | <image> ]+ )",
+	"cubic-bezier()":                   "cubic-bezier( <number [0,1]> , <number> , <number [0,1]> , <number> )",
+	"cursor-predefined":                "auto | default | none | context-menu | help | pointer | progress | wait | cell | crosshair | text | vertical-text | alias | copy | move | no-drop | not-allowed | grab | grabbing | e-resize | n-resize | ne-resize | nw-resize | s-resize | se-resize | sw-resize | w-resize | ew-resize | ns-resize | nesw-resize | nwse-resize | col-resize | row-resize | all-scroll | zoom-in | zoom-out",
+	"display-box":                      "contents | none",
+	"display-inside":                   "flow | flow-root | table | flex | grid | ruby",
+	"display-internal":                 "table-row-group | table-header-group | table-footer-group | table-row | table-cell | table-column-group | table-column | table-caption | ruby-base | ruby-text | ruby-base-container | ruby-text-container",
+	"display-legacy":                   "inline-block | inline-table | inline-flex | inline-grid",
+	"display-listitem":                 "<display-outside>? && [ flow | flow-root ]? && list-item",
+	"display-outside":                  "block | inline | run-in",
+	"easing-function":                  "linear | ease | ease-in | ease-out | ease-in-out | step-start | step-end | <linear()> | <cubic-bezier()> | <steps()>",
+	"ellipse()":                        "ellipse( [ <shape-radius>{2} ]? [ at <position> ]? )",
+	"family-name":                      "<string> | <custom-ident>+",
+	"filter-function":                  "blur( <length [0,∞]>? ) | brightness( <alpha-value>? ) | contrast( <alpha-value>? ) | drop-shadow( <color>? && <length>{2,3} ) | grayscale( <alpha-value>? ) | hue-rotate( [ <angle> | <zero> ]? ) | invert( <alpha-value>? ) | opacity( <alpha-value>? ) | saturate( <alpha-value>? ) | sepia( <alpha-value>? )",
+	"final-bg-layer":                   "<color> || <bg-image> || <position> [ / <bg-size> ]? || <repeat-style> || <attachment> || <bg-clip> || <visual-box>",
+	"font-weight-absolute":             "normal | bold | <number [1,1000]>",
+	"font-width":                       "normal | ultra-condensed | extra-condensed | condensed | semi-condensed | semi-expanded | expanded | extra-expanded | ultra-expanded",
+	"generic-family":                   "serif | sans-serif | monospace | cursive | fantasy | system-ui | ui-serif | ui-sans-serif | ui-monospace | ui-rounded | math | emoji | fangsong",
+	"geometry-box":                     "<visual-box> | margin-box | fill-box | stroke-box | view-box",
+	"grid-line":                        "auto | <custom-ident> | [ <integer> && <custom-ident>? ] | [ span && [ <integer [1,∞]> || <custom-ident> ] ]",
+	"inflexible-breadth":               "<length-percentage [0,∞]> | min-content | max-content | auto",
+	"inset()":                          "inset( <length-percentage>{1,4} [ round <'border-radius'> ]? )",
+	"keyframes-name":                   "<custom-ident> | <string>",
+	"line-style":                       "none | hidden | dotted | dashed | solid | double | groove | ridge | inset | outset",
+	"line-width":                       "<length [0,∞]> | thin | medium | thick",
+	"linear()":                         "linear( [ <number> && <percentage>{0,2} ]# )",
+	"mask-layer":                       "<mask-reference> || <position> [ / <bg-size> ]? || <repeat-style> || <geometry-box> || [ <geometry-box> | no-clip ] || <compositing-operator> || <masking-mode>",
+	"mask-reference":                   "none | <image>",
+	"masking-mode":                     "alpha | luminance | match-source",
+	"overflow-position":                "unsafe | safe",
+	"paint":                            "none | <color> | <url> [ none | <color> ]? | context-fill | context-stroke",
+	"path()":                           "path( [ <'fill-rule'> , ]? <string> )",
+	"polygon()":                        "polygon( [ <'fill-rule'> , ]? [ <length-percentage> <length-percentage> ]# )",
+	"position":                         "[ left | center | right | top | bottom | <length-percentage> ] | [ left | center | right | <length-percentage> ] [ top | center | bottom | <length-percentage> ] | [ center | [ left | right ] <length-percentage>? ] && [ center | [ top | bottom ] <length-percentage>? ]",
+	"quote":                            "open-quote | close-quote | no-open-quote | no-close-quote",
+	"ratio":                            "<number [0,∞]> [ / <number [0,∞]> ]?",
+	"rect()":                           "rect( [ <length-percentage> | auto ]{4} [ round <'border-radius'> ]? )",
+	"relative-size":                    "larger | smaller",
+	"repeat-style":                     "repeat-x | repeat-y | [ repeat | space | round | no-repeat ]{1,2}",
+	"self-position":                    "center | start | end | self-start | self-end | flex-start | flex-end",
+	"shadow":                           "<color>? && [ <length>{2} <length [0,∞]>? <length>? ] && inset?",
+	"shape-radius":                     "<length-percentage [0,∞]> | closest-side | farthest-side",
+	"single-animation":                 "<time [0,∞]> || <easing-function> || <time> || <single-animation-iteration-count> || <single-animation-direction> || <single-animation-fill-mode> || <single-animation-play-state> || [ none | <keyframes-name> ]",
+	"single-animation-composition":     "replace | add | accumulate",
+	"single-animation-direction":       "normal | reverse | alternate | alternate-reverse",
+	"single-animation-fill-mode":       "none | forwards | backwards | both",
+	"single-animation-iteration-count": "infinite | <number [0,∞]>",
+	"single-animation-play-state":      "running | paused",
+	"single-transition":                "[ none | <single-transition-property> ] || <time [0,∞]> || <easing-function> || <time> || <transition-behavior-value>",
+	"single-transition-property":       "all | <custom-ident>",
+	"step-position":                    "jump-start | jump-end | jump-none | jump-both | start | end",
+	"steps()":                          "steps( <integer [1,∞]> [ , <step-position> ]? )",
+	"symbols-type":                     "cyclic | numeric | alphabetic | symbolic | fixed",
+	"text-shadow":                      "<color>? && <length>{2} <length [0,∞]>?",
+	"track-breadth":                    "<length-percentage [0,∞]> | <flex [0,∞]> | min-content | max-content | auto",
+	"track-list":                       "[ <line-names>? [ <track-size> | <track-repeat> | <auto-repeat> ] ]+ <line-names>?",
+	"track-repeat":                     "repeat( <integer [1,∞]> , [ <line-names>? <track-size> ]+ <line-names>? )",
+	"track-size":                       "<track-breadth> | minmax( <inflexible-breadth> , <track-breadth> ) | fit-content( <length-percentage [0,∞]> )",
+	"transform-function":               "matrix( <number>#{6} ) | translate( <length-percentage> [ , <length-percentage> ]? ) | translatex( <length-percentage> ) | translatey( <length-percentage> ) | translatez( <length> ) | translate3d( <length-percentage> , <length-percentage> , <length> ) | scale( <number-percentage>#{1,2} ) | scalex( <number-percentage> ) | scaley( <number-percentage> ) | scalez( <number-percentage> ) | scale3d( <number-percentage>#{3} ) | rotate( <angle> | <zero> ) | rotatex( <angle> | <zero> ) | rotatey( <angle> | <zero> ) | rotatez( <angle> | <zero> ) | rotate3d( <number> , <number> , <number> , [ <angle> | <zero> ] ) | skew( [ <angle> | <zero> ] [ , [ <angle> | <zero> ] ]? ) | skewx( <angle> | <zero> ) | skewy( <angle> | <zero> ) | matrix3d( <number>#{16} ) | perspective( <length [0,∞]> | none )",
+	"number-percentage":                "<number> | <percentage>",
+	"transition-behavior-value":        "normal | allow-discrete",
+	"visual-box":                       "content-box | padding-box | border-box",
+	"xywh()":                           "xywh( <length-percentage>{2} <length-percentage [0,∞]>{2} [ round <'border-radius'> ]? )",
+}
+
+// propertySyntax are the value definition syntaxes of the standard properties, see https://www.w3.org/TR/css-values-4/#value-defs. The syntaxes of some properties are simplified.
+var propertySyntax = map[string]string{
+	"accent-color":              "auto | <color>",
+	"align-content":             "normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position>",
+	"align-items":               "normal | stretch | <baseline-position> | <overflow-position>? <self-position>",
+	"align-self":                "auto | normal | stretch | <baseline-position> | <overflow-position>? <self-position>",
+	"alignment-baseline":        "baseline | text-bottom | alphabetic | ideographic | middle | central | mathematical | text-top",
+	"all":                       "initial | inherit | unset | revert | revert-layer",
+	"animation":                 "<single-animation>#",
+	"animation-composition":     "<single-animation-composition>#",
+	"animation-delay":           "<time>#",
+	"animation-direction":       "<single-animation-direction>#",
+	"animation-duration":        "[ auto | <time [0,∞]> ]#",
+	"animation-fill-mode":       "<single-animation-fill-mode>#",
+	"animation-iteration-count": "<single-animation-iteration-count>#",
+	"animation-name":            "[ none | <keyframes-name> ]#",
+	"animation-play-state":      "<single-animation-play-state>#",
+	"animation-timeline":        "[ auto | none | <dashed-ident> | scroll( [ root | nearest | self ]? || [ block | inline | x | y ]? ) | view( [ block | inline | x | y ]? || [ auto | <length-percentage> ]{1,2}? ) ]#",
+	"animation-timing-function": "<easing-function>#",
+	"appearance":                "none | auto | base | menulist-button | textfield",
+	"aspect-ratio":              "auto || <ratio>",
+
+	"backdrop-filter":            "none | [ <filter-function> | <url> ]+",
+	"backface-visibility":        "visible | hidden",
+	"background":                 "[ <bg-layer> , ]* <final-bg-layer>",
+	"background-attachment":      "<attachment>#",
+	"background-blend-mode":      "<blend-mode>#",
+	"background-clip":            "<bg-clip>#",
+	"background-color":           "<color>",
+	"background-image":           "<bg-image>#",
+	"background-origin":          "<visual-box>#",
+	"background-position":        "<position>#",
+	"background-position-x":      "[ center | [ [ left | right | x-start | x-end ]? <length-percentage>? ]! ]#",
+	"background-position-y":      "[ center | [ [ top | bottom | y-start | y-end ]? <length-percentage>? ]! ]#",
+	"background-repeat":          "<repeat-style>#",
+	"background-size":            "<bg-size>#",
+	"baseline-shift":             "<length-percentage> | sub | super | top | center | bottom",
+	"block-size":                 "<'width'>",
+	"border":                     "<line-width> || <line-style> || <color>",
+	"border-block":               "<'border-block-start'>",
+	"border-block-color":         "<'border-top-color'>{1,2}",
+	"border-block-end":           "<'border-top'>",
+	"border-block-end-color":     "<'border-top-color'>",
+	"border-block-end-style":     "<'border-top-style'>",
+	"border-block-end-width":     "<'border-top-width'>",
+	"border-block-start":         "<'border-top'>",
+	"border-block-start-color":   "<'border-top-color'>",
+	"border-block-start-style":   "<'border-top-style'>",
+	"border-block-start-width":   "<'border-top-width'>",
+	"border-block-style":         "<'border-top-style'>{1,2}",
+	"border-block-width":         "<'border-top-width'>{1,2}",
+	"border-bottom":              "<line-width> || <line-style> || <color>",
+	"border-bottom-color":        "<color>",
+	"border-bottom-left-radius":  "<border-radius-corner>",
+	"border-bottom-right-radius": "<border-radius-corner>",
+	"border-bottom-style":        "<line-style>",
+	"border-bottom-width":        "<line-width>",
+	"border-collapse":            "separate | collapse",
+	"border-color":               "<color>{1,4}",
+	"border-end-end-radius":      "<border-radius-corner>",
+	"border-end-start-radius":    "<border-radius-corner>",
+	"border-image":               "<'border-image-source'> || <'border-image-slice'> [ / <'border-image-width'> | / <'border-image-width'>? / <'border-image-outset'> ]? || <'border-image-repeat'>",
+	"border-image-outset":        "[ <length [0,∞]> | <number [0,∞]> ]{1,4}",
+	"border-image-repeat":        "[ stretch | repeat | round | space ]{1,2}",
+	"border-image-slice":         "[ <number [0,∞]> | <percentage [0,∞]> ]{1,4} && fill?",
+	"border-image-source":        "none | <image>",
+	"border-image-width":         "[ <length-percentage [0,∞]> | <number [0,∞]> | auto ]{1,4}",
+	"border-inline":              "<'border-block-start'>",
+	"border-inline-color":        "<'border-top-color'>{1,2}",
+	"border-inline-end":          "<'border-top'>",
+	"border-inline-end-color":    "<'border-top-color'>",
+	"border-inline-end-style":    "<'border-top-style'>",
+	"border-inline-end-width":    "<'border-top-width'>",
+	"border-inline-start":        "<'border-top'>",
+	"border-inline-start-color":  "<'border-top-color'>",
+	"border-inline-start-style":  "<'border-top-style'>",
+	"border-inline-start-width":  "<'border-top-width'>",
+	"border-inline-style":        "<'border-top-style'>{1,2}",
+	"border-inline-width":        "<'border-top-width'>{1,2}",
+	"border-left":                "<line-width> || <line-style> || <color>",
+	"border-left-color":          "<color>",
+	"border-left-style":          "<line-style>",
+	"border-left-width":          "<line-width>",
+	"border-radius":              "<length-percentage [0,∞]>{1,4} [ / <length-percentage [0,∞]>{1,4} ]?",
+	"border-right":               "<line-width> || <line-style> || <color>",
+	"border-right-color":         "<color>",
+	"border-right-style":         "<line-style>",
+	"border-right-width":         "<line-width>",
+	"border-spacing":             "<length [0,∞]>{1,2}",
+	"border-start-end-radius":    "<border-radius-corner>",
+	"border-start-start-radius":  "<border-radius-corner>",
+	"border-style":               "<line-style>{1,4}",
+	"border-top":                 "<line-width> || <line-style> || <color>",
+	"border-top-color":           "<color>",
+	"border-top-left-radius":     "<border-radius-corner>",
+	"border-top-right-radius":    "<border-radius-corner>",
+	"border-top-style":           "<line-style>",
+	"border-top-width":           "<line-width>",
+	"border-width":               "<line-width>{1,4}",
+	"bottom":                     "auto | <length-percentage>",
+	"box-decoration-break":       "slice | clone",
+	"box-shadow":                 "none | <shadow>#",
+	"box-sizing":                 "content-box | border-box",
+	"break-after":                "auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region",
+	"break-before":               "auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region",
+	"break-inside":               "auto | avoid | avoid-page | avoid-column | avoid-region",
+
+	"caption-side":                  "top | bottom",
+	"caret-color":                   "auto | <color>",
+	"clear":                         "inline-start | inline-end | block-start | block-end | left | right | top | bottom | both-inline | both-block | both | none",
+	"clip":                          "rect( <length> | auto , <length> | auto , <length> | auto , <length> | auto ) | rect( [ <length> | auto ]{4} ) | auto",
+	"clip-path":                     "<url> | [ <basic-shape> || <geometry-box> ] | none",
+	"clip-rule":                     "nonzero | evenodd",
+	"color":                         "<color>",
+	"color-interpolation":           "auto | srgb | linearrgb",
+	"color-interpolation-filters":   "auto | srgb | linearrgb",
+	"color-scheme":                  "normal | [ light | dark | <custom-ident> ]+ && only?",
+	"column-count":                  "auto | <integer [1,∞]>",
+	"column-fill":                   "auto | balance | balance-all",
+	"column-gap":                    "normal | <length-percentage [0,∞]>",
+	"column-rule":                   "<'column-rule-width'> || <'column-rule-style'> || <'column-rule-color'>",
+	"column-rule-color":             "<color>",
+	"column-rule-style":             "<line-style>",
+	"column-rule-width":             "<line-width>",
+	"column-span":                   "none | all",
+	"column-width":                  "auto | <length [0,∞]>",
+	"columns":                       "<'column-width'> || <'column-count'>",
+	"contain":                       "none | strict | content | [ [ size | inline-size ] || layout || style || paint ]",
+	"contain-intrinsic-block-size":  "auto? [ none | <length [0,∞]> ]",
+	"contain-intrinsic-height":      "auto? [ none | <length [0,∞]> ]",
+	"contain-intrinsic-inline-size": "auto? [ none | <length [0,∞]> ]",
+	"contain-intrinsic-size":        "[ auto? [ none | <length [0,∞]> ] ]{1,2}",
+	"contain-intrinsic-width":       "auto? [ none | <length [0,∞]> ]",
+	"container":                     "<'container-name'> [ / <'container-type'> ]?",
+	"container-name":                "none | <custom-ident>+",
+	"container-type":                "normal | [ size | inline-size ] || scroll-state",
+	"content":                       "normal | none | [ <content-list> ] [ / [ <string> | <counter> ]+ ]?",
+	"content-visibility":            "visible | auto | hidden",
+	"counter-increment":             "[ <custom-ident> <integer>? ]+ | none",
+	"counter-reset":                 "[ <custom-ident> <integer>? ]+ | none",
+	"counter-set":                   "[ <custom-ident> <integer>? ]+ | none",
+	"cursor":                        "[ <url> [ <number> <number> ]? , ]* <cursor-predefined>",
+	"cx":                            "<length-percentage>",
+	"cy":                            "<length-percentage>",
+
+	"d":                 "none | path( <string> )",
+	"direction":         "ltr | rtl",
+	"display":           "[ <display-outside> || <display-inside> ] | <display-listitem> | <display-internal> | <display-box> | <display-legacy>",
+	"dominant-baseline": "auto | text-bottom | alphabetic | ideographic | middle | central | mathematical | hanging | text-top",
+	"empty-cells":       "show | hide",
+
+	"fill":                    "<paint>",
+	"fill-opacity":            "<alpha-value>",
+	"fill-rule":               "nonzero | evenodd",
+	"filter":                  "none | [ <filter-function> | <url> ]+",
+	"flex":                    "none | [ <'flex-grow'> <'flex-shrink'>? || <'flex-basis'> ]",
+	"flex-basis":              "content | <'width'>",
+	"flex-direction":          "row | row-reverse | column | column-reverse",
+	"flex-flow":               "<'flex-direction'> || <'flex-wrap'>",
+	"flex-grow":               "<number [0,∞]>",
+	"flex-shrink":             "<number [0,∞]>",
+	"flex-wrap":               "nowrap | wrap | wrap-reverse",
+	"float":                   "left | right | inline-start | inline-end | none",
+	"flood-color":             "<color>",
+	"flood-opacity":           "<alpha-value>",
+	"font":                    "[ [ <'font-style'> || normal || small-caps || <'font-weight'> || <font-width> ]? <'font-size'> [ / <'line-height'> ]? <'font-family'> ] | caption | icon | menu | message-box | small-caption | status-bar",
+	"font-family":             "[ <family-name> | <generic-family> ]#",
+	"font-feature-settings":   "normal | [ <string> [ <integer [0,∞]> | on | off ]? ]#",
+	"font-kerning":            "auto | normal | none",
+	"font-language-override":  "normal | <string>",
+	"font-optical-sizing":     "auto | none",
+	"font-palette":            "normal | light | dark | <dashed-ident>",
+	"font-size":               "<absolute-size> | <relative-size> | <length-percentage [0,∞]> | math",
+	"font-size-adjust":        "none | [ ex-height | cap-height | ch-width | ic-width | ic-height ]? [ from-font | <number [0,∞]> ]",
+	"font-stretch":            "<font-width> | <percentage [0,∞]>",
+	"font-style":              "normal | italic | oblique <angle [-90,90]>?",
+	"font-synthesis":          "none | [ weight || style || small-caps || position ]",
+	"font-variant":            "normal | none | [ common-ligatures | no-common-ligatures | discretionary-ligatures | no-discretionary-ligatures | historical-ligatures | no-historical-ligatures | contextual | no-contextual | small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps | lining-nums | oldstyle-nums | proportional-nums | tabular-nums | diagonal-fractions | stacked-fractions | ordinal | slashed-zero | jis78 | jis83 | jis90 | jis04 | simplified | traditional | full-width | proportional-width | ruby | sub | super | text | emoji | unicode ]+",
+	"font-variant-alternates": "normal | [ historical-forms | stylistic( <custom-ident> ) | styleset( <custom-ident># ) | character-variant( <custom-ident># ) | swash( <custom-ident> ) | ornaments( <custom-ident> ) | annotation( <custom-ident> ) ]+",
+	"font-variant-caps":       "normal | small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps",
+	"font-variant-east-asian": "normal | [ jis78 | jis83 | jis90 | jis04 | simplified | traditional ] || [ full-width | proportional-width ] || ruby",
+	"font-variant-emoji":      "normal | text | emoji | unicode",
+	"font-variant-ligatures":  "normal | none | [ common-ligatures | no-common-ligatures ] || [ discretionary-ligatures | no-discretionary-ligatures ] || [ historical-ligatures | no-historical-ligatures ] || [ contextual | no-contextual ]",
+	"font-variant-numeric":    "normal | [ lining-nums | oldstyle-nums ] || [ proportional-nums | tabular-nums ] || [ diagonal-fractions | stacked-fractions ] || ordinal || slashed-zero",
+	"font-variant-position":   "normal | sub | super",
+	"font-variation-settings": "normal | [ <string> <number> ]#",
+	"font-weight":             "<font-weight-absolute> | bolder | lighter",
+	"forced-color-adjust":     "auto | none | preserve-parent-color",
+
+	"gap":                   "<'row-gap'> <'column-gap'>?",
+	"grid":                  "<'grid-template'> | [ auto-flow && dense? ] <'grid-auto-rows'>? / <'grid-template-columns'> | <'grid-template-rows'> / [ auto-flow && dense? ] <'grid-auto-columns'>?",
+	"grid-area":             "<grid-line> [ / <grid-line> ]{0,3}",
+	"grid-auto-columns":     "<track-size>+",
+	"grid-auto-flow":        "[ row | column ] || dense",
+	"grid-auto-rows":        "<track-size>+",
+	"grid-column":           "<grid-line> [ / <grid-line> ]?",
+	"grid-column-end":       "<grid-line>",
+	"grid-column-start":     "<grid-line>",
+	"grid-row":              "<grid-line> [ / <grid-line> ]?",
+	"grid-row-end":          "<grid-line>",
+	"grid-row-start":        "<grid-line>",
+	"grid-template":         "none | [ <'grid-template-rows'> / <'grid-template-columns'> ] | [ <line-names>? <string> <track-size>? <line-names>? ]+ [ / <track-list> ]?",
+	"grid-template-areas":   "none | <string>+",
+	"grid-template-columns": "none | <track-list> | subgrid <line-names>* | masonry",
+	"grid-template-rows":    "none | <track-list> | subgrid <line-names>* | masonry",
+
+	"hanging-punctuation": "none | [ first || [ force-end | allow-end ] || last ]",
+	"height":              "<'width'>",
+	"hyphenate-character": "auto | <string>",
+	"hyphens":             "none | manual | auto",
+	"image-orientation":   "from-image | none | [ <angle> || flip ]",
+	"image-rendering":     "auto | smooth | high-quality | pixelated | crisp-edges",
+	"initial-letter":      "normal | <number [1,∞]> <integer [1,∞]> | <number [1,∞]> && [ drop | raise ]?",
+	"inline-size":         "<'width'>",
+	"inset":               "<'top'>{1,4}",
+	"inset-block":         "<'top'>{1,2}",
+	"inset-block-end":     "<'top'>",
+	"inset-block-start":   "<'top'>",
+	"inset-inline":        "<'top'>{1,2}",
+	"inset-inline-end":    "<'top'>",
+	"inset-inline-start":  "<'top'>",
+	"isolation":           "auto | isolate",
+	"justify-content":     "normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ]",
+	"justify-items":       "normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | legacy | legacy && [ left | right | center ]",
+	"justify-self":        "auto | normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ]",
+
+	"left":                "auto | <length-percentage>",
+	"letter-spacing":      "normal | <length-percentage>",
+	"lighting-color":      "<color>",
+	"line-break":          "auto | loose | normal | strict | anywhere",
+	"line-height":         "normal | <number [0,∞]> | <length-percentage [0,∞]>",
+	"list-style":          "<'list-style-position'> || <'list-style-image'> || <'list-style-type'>",
+	"list-style-image":    "<image> | none",
+	"list-style-position": "inside | outside",
+	"list-style-type":     "<counter-style> | <string> | none",
+
+	"margin":              "<'margin-top'>{1,4}",
+	"margin-block":        "<'margin-top'>{1,2}",
+	"margin-block-end":    "<'margin-top'>",
+	"margin-block-start":  "<'margin-top'>",
+	"margin-bottom":       "<'margin-top'>",
+	"margin-inline":       "<'margin-top'>{1,2}",
+	"margin-inline-end":   "<'margin-top'>",
+	"margin-inline-start": "<'margin-top'>",
+	"margin-left":         "<'margin-top'>",
+	"margin-right":        "<'margin-top'>",
+	"margin-top":          "<length-percentage> | auto",
+	"marker":              "none | <url>",
+	"marker-end":          "none | <url>",
+	"marker-mid":          "none | <url>",
+	"marker-start":        "none | <url>",
+	"mask":                "<mask-layer>#",
+	"mask-border":         "<'mask-border-source'> || <'mask-border-slice'> [ / <'mask-border-width'>? [ / <'mask-border-outset'> ]? ]? || <'mask-border-repeat'> || <'mask-border-mode'>",
+	"mask-border-mode":    "luminance | alpha",
+	"mask-border-outset":  "<'border-image-outset'>",
+	"mask-border-repeat":  "<'border-image-repeat'>",
+	"mask-border-slice":   "<'border-image-slice'>",
+	"mask-border-source":  "<'border-image-source'>",
+	"mask-border-width":   "<'border-image-width'>",
+	"mask-clip":           "[ <geometry-box> | no-clip ]#",
+	"mask-composite":      "<compositing-operator>#",
+	"mask-image":          "<mask-reference>#",
+	"mask-mode":           "<masking-mode>#",
+	"mask-origin":         "<geometry-box>#",
+	"mask-position":       "<position>#",
+	"mask-repeat":         "<repeat-style>#",
+	"mask-size":           "<bg-size>#",
+	"mask-type":           "luminance | alpha",
+	"math-depth":          "auto-add | add( <integer> ) | <integer>",
+	"math-style":          "normal | compact",
+	"max-block-size":      "<'max-width'>",
+	"max-height":          "<'max-width'>",
+	"max-inline-size":     "<'max-width'>",
+	"max-width":           "none | <length-percentage [0,∞]> | min-content | max-content | fit-content( <length-percentage [0,∞]> ) | stretch | fit-content",
+	"min-block-size":      "<'width'>",
+	"min-height":          "<'width'>",
+	"min-inline-size":     "<'width'>",
+	"min-width":           "<'width'>",
+	"mix-blend-mode":      "<blend-mode> | plus-darker | plus-lighter",
+
+	"object-fit":                 "fill | contain | cover | none | scale-down",
+	"object-position":            "<position>",
+	"offset":                     "[ <'offset-position'>? [ <'offset-path'> [ <'offset-distance'> || <'offset-rotate'> ]? ]? ]! [ / <'offset-anchor'> ]?",
+	"offset-anchor":              "auto | <position>",
+	"offset-distance":            "<length-percentage>",
+	"offset-path":                "none | ray( <angle> && [ closest-side | closest-corner | farthest-side | farthest-corner | sides ]? && contain? && [ at <position> ]? ) | <url> | [ <basic-shape> || <coord-box> ]",
+	"offset-position":            "normal | auto | <position>",
+	"offset-rotate":              "[ auto | reverse ] || <angle>",
+	"opacity":                    "<alpha-value>",
+	"order":                      "<integer>",
+	"orphans":                    "<integer [1,∞]>",
+	"outline":                    "<'outline-width'> || <'outline-style'> || <'outline-color'>",
+	"outline-color":              "auto | <color>",
+	"outline-offset":             "<length>",
+	"outline-style":              "auto | <line-style>",
+	"outline-width":              "<line-width>",
+	"overflow":                   "[ visible | hidden | clip | scroll | auto ]{1,2}",
+	"overflow-anchor":            "auto | none",
+	"overflow-block":             "visible | hidden | clip | scroll | auto",
+	"overflow-clip-margin":       "<visual-box> || <length [0,∞]>",
+	"overflow-inline":            "visible | hidden | clip | scroll | auto",
+	"overflow-wrap":              "normal | break-word | anywhere",
+	"overflow-x":                 "visible | hidden | clip | scroll | auto",
+	"overflow-y":                 "visible | hidden | clip | scroll | auto",
+	"overscroll-behavior":        "[ contain | none | auto ]{1,2}",
+	"overscroll-behavior-block":  "contain | none | auto",
+	"overscroll-behavior-inline": "contain | none | auto",
+	"overscroll-behavior-x":      "contain | none | auto",
+	"overscroll-behavior-y":      "contain | none | auto",
+
+	"padding":              "<'padding-top'>{1,4}",
+	"padding-block":        "<'padding-top'>{1,2}",
+	"padding-block-end":    "<'padding-top'>",
+	"padding-block-start":  "<'padding-top'>",
+	"padding-bottom":       "<'padding-top'>",
+	"padding-inline":       "<'padding-top'>{1,2}",
+	"padding-inline-end":   "<'padding-top'>",
+	"padding-inline-start": "<'padding-top'>",
+	"padding-left":         "<'padding-top'>",
+	"padding-right":        "<'padding-top'>",
+	"padding-top":          "<length-percentage [0,∞]>",
+	"page":                 "auto | <custom-ident>",
+	"page-break-after":     "auto | always | avoid | left | right",
+	"page-break-before":    "auto | always | avoid | left | right",
+	"page-break-inside":    "auto | avoid",
+	"paint-order":          "normal | [ fill || stroke || markers ]",
+	"perspective":          "none | <length [0,∞]>",
+	"perspective-origin":   "<position>",
+	"place-content":        "<'align-content'> <'justify-content'>?",
+	"place-items":          "<'align-items'> <'justify-items'>?",
+	"place-self":           "<'align-self'> <'justify-self'>?",
+	"pointer-events":       "auto | bounding-box | visiblepainted | visiblefill | visiblestroke | visible | painted | fill | stroke | all | none",
+	"position":             "static | relative | absolute | sticky | fixed",
+	"print-color-adjust":   "economy | exact",
+	"quotes":               "auto | none | [ <string> <string> ]+",
+
+	"r":             "<length-percentage>",
+	"resize":        "none | both | horizontal | vertical | block | inline",
+	"right":         "auto | <length-percentage>",
+	"rotate":        "none | <angle> | [ x | y | z | <number>{3} ] && <angle>",
+	"row-gap":       "normal | <length-percentage [0,∞]>",
+	"ruby-align":    "start | center | space-between | space-around",
+	"ruby-position": "[ alternate || [ over | under ] ] | inter-character",
+	"rx":            "<length-percentage> | auto",
+	"ry":            "<length-percentage> | auto",
+
+	"scale":                       "none | <number-percentage>{1,3}",
+	"scroll-behavior":             "auto | smooth",
+	"scroll-margin":               "<length>{1,4}",
+	"scroll-margin-block":         "<length>{1,2}",
+	"scroll-margin-block-end":     "<length>",
+	"scroll-margin-block-start":   "<length>",
+	"scroll-margin-bottom":        "<length>",
+	"scroll-margin-inline":        "<length>{1,2}",
+	"scroll-margin-inline-end":    "<length>",
+	"scroll-margin-inline-start":  "<length>",
+	"scroll-margin-left":          "<length>",
+	"scroll-margin-right":         "<length>",
+	"scroll-margin-top":           "<length>",
+	"scroll-padding":              "[ auto | <length-percentage [0,∞]> ]{1,4}",
+	"scroll-padding-block":        "[ auto | <length-percentage [0,∞]> ]{1,2}",
+	"scroll-padding-block-end":    "auto | <length-percentage [0,∞]>",
+	"scroll-padding-block-start":  "auto | <length-percentage [0,∞]>",
+	"scroll-padding-bottom":       "auto | <length-percentage [0,∞]>",
+	"scroll-padding-inline":       "[ auto | <length-percentage [0,∞]> ]{1,2}",
+	"scroll-padding-inline-end":   "auto | <length-percentage [0,∞]>",
+	"scroll-padding-inline-start": "auto | <length-percentage [0,∞]>",
+	"scroll-padding-left":         "auto | <length-percentage [0,∞]>",
+	"scroll-padding-right":        "auto | <length-percentage [0,∞]>",
+	"scroll-padding-top":          "auto | <length-percentage [0,∞]>",
+	"scroll-snap-align":           "[ none | start | end | center ]{1,2}",
+	"scroll-snap-stop":            "normal | always",
+	"scroll-snap-type":            "none | [ x | y | block | inline | both ] [ mandatory | proximity ]?",
+	"scroll-timeline":             "[ <'scroll-timeline-name'> <'scroll-timeline-axis'>? ]#",
+	"scroll-timeline-axis":        "[ block | inline | x | y ]#",
+	"scroll-timeline-name":        "[ none | <dashed-ident> ]#",
+	"scrollbar-color":             "auto | <color>{2}",
+	"scrollbar-gutter":            "auto | stable && both-edges?",
+	"scrollbar-width":             "auto | thin | none",
+	"shape-image-threshold":       "<alpha-value>",
+	"shape-margin":                "<length-percentage [0,∞]>",
+	"shape-outside":               "none | [ <basic-shape> || <visual-box> | margin-box ] | <image>",
+	"shape-rendering":             "auto | optimizespeed | crispedges | geometricprecision",
+	"stop-color":                  "<color>",
+	"stop-opacity":                "<alpha-value>",
+	"stroke":                      "<paint>",
+	"stroke-dasharray":            "none | [ <length-percentage [0,∞]> | <number [0,∞]> ]+#",
+	"stroke-dashoffset":           "<length-percentage> | <number>",
+	"stroke-linecap":              "butt | round | square",
+	"stroke-linejoin":             "miter | miter-clip | round | bevel | arcs",
+	"stroke-miterlimit":           "<number [1,∞]>",
+	"stroke-opacity":              "<alpha-value>",
+	"stroke-width":                "<length-percentage [0,∞]> | <number [0,∞]>",
+
+	"tab-size":                   "<number [0,∞]> | <length [0,∞]>",
+	"table-layout":               "auto | fixed",
+	"text-align":                 "start | end | left | right | center | justify | match-parent | justify-all",
+	"text-align-last":            "auto | start | end | left | right | center | justify | match-parent",
+	"text-anchor":                "start | middle | end",
+	"text-combine-upright":       "none | all | digits <integer [2,4]>?",
+	"text-decoration":            "<'text-decoration-line'> || <'text-decoration-thickness'> || <'text-decoration-style'> || <'text-decoration-color'>",
+	"text-decoration-color":      "<color>",
+	"text-decoration-line":       "none | [ underline || overline || line-through || blink ] | spelling-error | grammar-error",
+	"text-decoration-skip-ink":   "auto | none | all",
+	"text-decoration-style":      "solid | double | dotted | dashed | wavy",
+	"text-decoration-thickness":  "auto | from-font | <length-percentage>",
+	"text-emphasis":              "<'text-emphasis-style'> || <'text-emphasis-color'>",
+	"text-emphasis-color":        "<color>",
+	"text-emphasis-position":     "[ over | under ] && [ right | left ]?",
+	"text-emphasis-style":        "none | [ [ filled | open ] || [ dot | circle | double-circle | triangle | sesame ] ] | <string>",
+	"text-indent":                "<length-percentage> && hanging? && each-line?",
+	"text-justify":               "auto | none | inter-word | inter-character | distribute",
+	"text-orientation":           "mixed | upright | sideways",
+	"text-overflow":              "[ clip | ellipsis | <string> ]{1,2}",
+	"text-rendering":             "auto | optimizespeed | optimizelegibility | geometricprecision",
+	"text-shadow":                "none | <text-shadow>#",
+	"text-size-adjust":           "auto | none | <percentage [0,∞]>",
+	"text-transform":             "none | [ capitalize | uppercase | lowercase ] || full-width || full-size-kana | math-auto",
+	"text-underline-offset":      "auto | <length-percentage>",
+	"text-underline-position":    "auto | [ from-font | under ] || [ left | right ]",
+	"text-wrap":                  "wrap | nowrap | balance | stable | pretty",
+	"top":                        "auto | <length-percentage>",
+	"touch-action":               "auto | none | [ [ pan-x | pan-left | pan-right ] || [ pan-y | pan-up | pan-down ] || pinch-zoom ] | manipulation",
+	"transform":                  "none | <transform-function>+",
+	"transform-box":              "content-box | border-box | fill-box | stroke-box | view-box",
+	"transform-origin":           "[ left | center | right | top | bottom | <length-percentage> ] | [ left | center | right | <length-percentage> ] [ top | center | bottom | <length-percentage> ] <length>? | [ [ center | left | right ] && [ center | top | bottom ] ] <length>?",
+	"transform-style":            "flat | preserve-3d",
+	"transition":                 "<single-transition>#",
+	"transition-behavior":        "<transition-behavior-value>#",
+	"transition-delay":           "<time>#",
+	"transition-duration":        "<time [0,∞]>#",
+	"transition-property":        "none | <single-transition-property>#",
+	"transition-timing-function": "<easing-function>#",
+	"translate":                  "none | <length-percentage> [ <length-percentage> <length>? ]?",
+
+	"unicode-bidi":         "normal | embed | isolate | bidi-override | isolate-override | plaintext",
+	"user-select":          "auto | text | none | contain | all",
+	"vector-effect":        "none | non-scaling-stroke | non-scaling-size | non-rotation | fixed-position",
+	"vertical-align":       "[ first | last ] || [ baseline | sub | super | text-top | text-bottom | middle | top | center | bottom ] || <length-percentage>",
+	"view-timeline":        "[ <'view-timeline-name'> [ <'view-timeline-axis'> || <'view-timeline-inset'> ]? ]#",
+	"view-timeline-axis":   "[ block | inline | x | y ]#",
+	"view-timeline-inset":  "[ [ auto | <length-percentage> ]{1,2} ]#",
+	"view-timeline-name":   "[ none | <dashed-ident> ]#",
+	"view-transition-name": "none | <custom-ident>",
+	"visibility":           "visible | hidden | collapse",
+	"white-space":          "normal | pre | nowrap | pre-wrap | break-spaces | pre-line",
+	"white-space-collapse": "collapse | discard | preserve | preserve-breaks | preserve-spaces | break-spaces",
+	"widows":               "<integer [1,∞]>",
+	"width":                "auto | <length-percentage [0,∞]> | min-content | max-content | fit-content( <length-percentage [0,∞]> ) | stretch | fit-content",
+	"will-change":          "auto | [ scroll-position | contents | <custom-ident> ]#",
+	"word-break":           "normal | break-all | keep-all | manual | auto-phrase | break-word",
+	"word-spacing":         "normal | <length-percentage>",
+	"word-wrap":            "normal | break-word | anywhere",
+	"writing-mode":         "horizontal-tb | vertical-rl | vertical-lr | sideways-rl | sideways-lr",
+	"x":                    "<length-percentage>",
+	"y":                    "<length-percentage>",
+	"z-index":              "auto | <integer>",
+	"zoom":                 "normal | reset | <number [0,∞]> | <percentage [0,∞]>",
+}
@@ -0,0 +1,702 @@
+package css
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/tdewolff/parse/v2"
+)
+
+// SelectorList is a comma-separated list of complex selectors, see https://www.w3.org/TR/selectors-4/.
+type SelectorList []ComplexSelector
+
+// ComplexSelector is a sequence of compound selectors separated by combinators.
+type ComplexSelector []CompoundSelector
+
+// CompoundSelector is a sequence of simple selectors that all match the same element.
+type CompoundSelector struct {
+	Combinator Combinator // combinator with the preceding compound selector, or the leading combinator of a relative selector
+	Selectors  []SimpleSelector
+}
+
+// Combinator is a combinator between two compound selectors.
+type Combinator int
+
+// Combinator values.
+const (
+	NoCombinator                Combinator = iota
+	DescendantCombinator                   // whitespace
+	ChildCombinator                        // >
+	NextSiblingCombinator                  // +
+	SubsequentSiblingCombinator            // ~
+	ColumnCombinator                       // ||
+)
+
+// String returns the CSS representation of a combinator.
+func (c Combinator) String() string {
+	switch c {
+	case NoCombinator:
+		return ""
+	case DescendantCombinator:
+		return " "
+	case ChildCombinator:
+		return ">"
+	case NextSiblingCombinator:
+		return "+"
+	case SubsequentSiblingCombinator:
+		return "~"
+	case ColumnCombinator:
+		return "||"
+	}
+	return "Invalid(" + strconv.Itoa(int(c)) + ")"
+}
+
+// SimpleSelectorType determines the type of a simple selector.
+type SimpleSelectorType int
+
+// SimpleSelectorType values.
+const (
+	TypeSelector          SimpleSelectorType = iota // a
+	UniversalSelector                               // *
+	IDSelector                                      // #a
+	ClassSelector                                   // .a
+	AttributeSelector                               // [a=b]
+	PseudoClassSelector                             // :a
+	PseudoElementSelector                           // ::a
+	NestingSelector                                 // &
+)
+
+// String returns the string representation of a SimpleSelectorType.
+func (t SimpleSelectorType) String() string {
+	switch t {
+	case TypeSelector:
+		return "Type"
+	case UniversalSelector:
+		return "Universal"
+	case IDSelector:
+		return "ID"
+	case ClassSelector:
+		return "Class"
+	case AttributeSelector:
+		return "Attribute"
+	case PseudoClassSelector:
+		return "PseudoClass"
+	case PseudoElementSelector:
+		return "PseudoElement"
+	case NestingSelector:
+		return "Nesting"
+	}
+	return "Invalid(" + strconv.Itoa(int(t)) + ")"
+}
+
+// SimpleSelector is a single simple selector. Names and values are kept as in the source, including escapes and string quotes.
+type SimpleSelector struct {
+	Type         SimpleSelectorType
+	HasNamespace bool
+	Namespace    []byte // namespace prefix of type, universal, and attribute selectors, which is empty for no namespace and * for any namespace
+	Name         []byte // element name, ID, class, attribute name, or pseudo-class or pseudo-element name without colons, which is lowercase for pseudos
+
+	Matcher  TokenType // attribute matcher: DelimToken for =, IncludeMatchToken, DashMatchToken, PrefixMatchToken, SuffixMatchToken, or SubstringMatchToken, or ErrorToken for [a]
+	Value    Token     // attribute value, either an IdentToken or a StringToken
+	Modifier byte      // attribute modifier i or s, or zero
+
+	IsFunction bool         // functional pseudo-class or pseudo-element such as :not()
+	Args       SelectorList // selector arguments such as of :is(), :not(), :where(), :has(), and :nth-child(An+B of S)
+	Nth        *Nth         // An+B argument of :nth-child() and similar
+	Tokens     []Token      // other arguments, such as of :lang() or ::part()
+}
+
+// Nth is the An+B microsyntax of :nth-child() and similar pseudo-classes.
+type Nth struct {
+	A, B int
+}
+
+// String returns the An+B representation, which is normalized so that odd becomes 2n+1.
+func (n Nth) String() string {
+	s := ""
+	if n.A != 0 {
+		if n.A == -1 {
+			s = "-"
+		} else if n.A != 1 {
+			s = strconv.Itoa(n.A)
+		}
+		s += "n"
+		if n.B == 0 {
+			return s
+		} else if 0 < n.B {
+			s += "+"
+		}
+	}
+	return s + strconv.Itoa(n.B)
+}
+
+// Specificity is the specificity of a selector as the number of ID selectors, the number of class, attribute, and pseudo-class selectors, and the number of type and pseudo-element selectors.
+type Specificity [3]int
+
+// Compare returns -1, 0, or 1 when s is lower than, equal to, or higher than t respectively.
+func (s Specificity) Compare(t Specificity) int {
+	for i := 0; i < 3; i++ {
+		if s[i] < t[i] {
+			return -1
+		} else if t[i] < s[i] {
+			return 1
+		}
+	}
+	return 0
+}
+
+func (s Specificity) String() string {
+	return "(" + strconv.Itoa(s[0]) + "," + strconv.Itoa(s[1]) + "," + strconv.Itoa(s[2]) + ")"
+}
+
+func (s Specificity) add(t Specificity) Specificity {
+	return Specificity{s[0] + t[0], s[1] + t[1], s[2] + t[2]}
+}
+
+// Specificity returns the highest specificity of the selectors in the list, as used by :is() and :not().
+func (l SelectorList) Specificity() Specificity {
+	max := Specificity{}
+	for _, sel := range l {
+		if spec := sel.Specificity(); max.Compare(spec) < 0 {
+			max = spec
+		}
+	}
+	return max
+}
+
+// Specificity returns the specificity of a complex selector. The nesting selector & counts as zero since the parent selector is unknown.
+func (sel ComplexSelector) Specificity() Specificity {
+	spec := Specificity{}
+	for _, compound := range sel {
+		for _, simple := range compound.Selectors {
+			spec = spec.add(simple.Specificity())
+		}
+	}
+	return spec
+}
+
+// Specificity returns the specificity of a simple selector, see https://www.w3.org/TR/selectors-4/#specificity-rules.
+func (sel SimpleSelector) Specificity() Specificity {
+	switch sel.Type {
+	case IDSelector:
+		return Specificity{1, 0, 0}
+	case ClassSelector, AttributeSelector:
+		return Specificity{0, 1, 0}
+	case TypeSelector:
+		return Specificity{0, 0, 1}
+	case PseudoClassSelector:
+		if isLegacyPseudoElement(sel.Name) {
+			return Specificity{0, 0, 1}
+		} else if !sel.IsFunction {
+			return Specificity{0, 1, 0}
+		}
+		switch string(sel.Name) {
+		case "where":
+			return Specificity{}
+		case "is", "not", "has", "matches", "-webkit-any", "-moz-any":
+			return sel.Args.Specificity()
+		}
+		return Specificity{0, 1, 0}.add(sel.Args.Specificity())
+	case PseudoElementSelector:
+		return Specificity{0, 0, 1}.add(sel.Args.Specificity())
+	}
+	return Specificity{}
+}
+
+////////////////////////////////////////////////////////////////
+
+// ParseSelectorList parses the tokens of a selector list, such as the values of QualifiedRuleGrammar and BeginRulesetGrammar joined by commas. Whitespace tokens are only significant as descendant combinators, and comment tokens are not allowed.
+func ParseSelectorList(tokens []Token) (SelectorList, error) {
+	p := &tokenParser{tokens: tokens}
+	list := p.parseSelectorList(false)
+	if p.err == nil && p.i < len(p.tokens) {
+		p.fail("selector")
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return list, nil
+}
+
+// SelectorList parses the selectors of a qualified rule.
+func (r *QualifiedRule) SelectorList() (SelectorList, error) {
+	tokens := []Token{}
+	for i, selector := range r.Selectors {
+		if i != 0 {
+			tokens = append(tokens, Token{TokenType: CommaToken, Data: []byte(",")})
+		}
+		tokens = append(tokens, selector...)
+	}
+	return ParseSelectorList(tokens)
+}
+
+// tokenParser is a recursive descent parser over a list of tokens, which is used for selectors and media queries.
+type tokenParser struct {
+	tokens []Token
+	i      int
+	err    error
+}
+
+func (p *tokenParser) peek(i int) Token {
+	if p.i+i < len(p.tokens) {
+		return p.tokens[p.i+i]
+	}
+	return Token{TokenType: ErrorToken}
+}
+
+func (p *tokenParser) isDelim(t Token, c byte) bool {
+	return t.TokenType == DelimToken && len(t.Data) == 1 && t.Data[0] == c
+}
+
+func (p *tokenParser) skipWhitespace() bool {
+	ws := false
+	for p.i < len(p.tokens) && p.tokens[p.i].TokenType == WhitespaceToken {
+		p.i++
+		ws = true
+	}
+	return ws
+}
+
+func (p *tokenParser) fail(in string) {
+	if p.err == nil {
+		if t := p.peek(0); t.TokenType == ErrorToken {
+			p.err = fmt.Errorf("CSS parse error: unexpected ending in %s", in)
+		} else {
+			p.err = fmt.Errorf("CSS parse error: unexpected token '%s' in %s", string(t.Data), in)
+		}
+	}
+}
+
+func (p *tokenParser) parseSelectorList(relative bool) SelectorList {
+	list := SelectorList{}
+	for {
+		p.skipWhitespace()
+		sel := p.parseComplexSelector(relative)
+		if p.err != nil {
+			return nil
+		}
+		list = append(list, sel)
+		if p.peek(0).TokenType != CommaToken {
+			return list
+		}
+		p.i++
+	}
+}
+
+func (p *tokenParser) parseCombinator() Combinator {
+	t := p.peek(0)
+	if t.TokenType == ColumnToken {
+		return ColumnCombinator
+	} else if p.isDelim(t, '>') {
+		return ChildCombinator
+	} else if p.isDelim(t, '+') {
+		return NextSiblingCombinator
+	} else if p.isDelim(t, '~') {
+		return SubsequentSiblingCombinator
+	}
+	return NoCombinator
+}
+
+func (p *tokenParser) parseComplexSelector(relative bool) ComplexSelector {
+	combinator := NoCombinator
+	if relative {
+		if combinator = p.parseCombinator(); combinator != NoCombinator {
+			p.i++
+			p.skipWhitespace()
+		}
+	}
+
+	sel := ComplexSelector{}
+	for {
+		compound := p.parseCompoundSelector()
+		if p.err != nil {
+			return nil
+		}
+		compound.Combinator = combinator
+		sel = append(sel, compound)
+
+		ws := p.skipWhitespace()
+		if tt := p.peek(0).TokenType; tt == ErrorToken || tt == CommaToken || tt == RightParenthesisToken {
+			return sel
+		} else if combinator = p.parseCombinator(); combinator != NoCombinator {
+			p.i++
+			p.skipWhitespace()
+		} else if ws {
+			combinator = DescendantCombinator
+		} else {
+			p.fail("selector")
+			return nil
+		}
+	}
+}
+
+func (p *tokenParser) parseCompoundSelector() CompoundSelector {
+	compound := CompoundSelector{}
+	if simple, ok := p.parseTypeSelector(); ok {
+		compound.Selectors = append(compound.Selectors, simple)
+	}
+	for p.err == nil {
+		t := p.peek(0)
+		if t.TokenType == HashToken {
+			p.i++
+			compound.Selectors = append(compound.Selectors, SimpleSelector{Type: IDSelector, Name: t.Data[1:]})
+		} else if p.isDelim(t, '.') && p.peek(1).TokenType == IdentToken {
+			p.i += 2
+			compound.Selectors = append(compound.Selectors, SimpleSelector{Type: ClassSelector, Name: p.tokens[p.i-1].Data})
+		} else if p.isDelim(t, '&') {
+			p.i++
+			compound.Selectors = append(compound.Selectors, SimpleSelector{Type: NestingSelector})
+		} else if t.TokenType == LeftBracketToken {
+			p.i++
+			compound.Selectors = append(compound.Selectors, p.parseAttributeSelector())
+		} else if t.TokenType == ColonToken {
+			p.i++
+			compound.Selectors = append(compound.Selectors, p.parsePseudoSelector())
+		} else {
+			break
+		}
+	}
+	if p.err == nil && len(compound.Selectors) == 0 {
+		p.fail("selector")
+	}
+	return compound
+}
+
+// parseNamespace parses an optional namespace prefix ns|, *|, or |.
+func (p *tokenParser) parseNamespace() ([]byte, bool) {
+	if t := p.peek(0); (t.TokenType == IdentToken || p.isDelim(t, '*')) && p.isDelim(p.peek(1), '|') {
+		if next := p.peek(2); next.TokenType == IdentToken || p.isDelim(next, '*') {
+			p.i += 2
+			return t.Data, true
+		}
+	} else if p.isDelim(t, '|') {
+		if next := p.peek(1); next.TokenType == IdentToken || p.isDelim(next, '*') {
+			p.i++
+			return []byte{}, true
+		}
+	}
+	return nil, false
+}
+
+func (p *tokenParser) parseTypeSelector() (SimpleSelector, bool) {
+	namespace, hasNamespace := p.parseNamespace()
+	t := p.peek(0)
+	if t.TokenType == IdentToken {
+		p.i++
+		return SimpleSelector{Type: TypeSelector, HasNamespace: hasNamespace, Namespace: namespace, Name: t.Data}, true
+	} else if p.isDelim(t, '*') {
+		p.i++
+		return SimpleSelector{Type: UniversalSelector, HasNamespace: hasNamespace, Namespace: namespace}, true
+	}
+	return SimpleSelector{}, false
+}
+
+func (p *tokenParser) parseAttributeSelector() SimpleSelector {
+	p.skipWhitespace()
+	sel := SimpleSelector{Type: AttributeSelector, Matcher: ErrorToken}
+	sel.Namespace, sel.HasNamespace = p.parseNamespace()
+	if t := p.peek(0); t.TokenType != IdentToken {
+		p.fail("attribute selector")
+		return sel
+	} else {
+		sel.Name = t.Data
+		p.i++
+	}
+	p.skipWhitespace()
+
+	switch t := p.peek(0); t.TokenType {
+	case IncludeMatchToken, DashMatchToken, PrefixMatchToken, SuffixMatchToken, SubstringMatchToken:
+		sel.Matcher = t.TokenType
+	case DelimToken:
+		if p.isDelim(t, '=') {
+			sel.Matcher = DelimToken
+		}
+	}
+	if sel.Matcher != ErrorToken {
+		p.i++
+		p.skipWhitespace()
+		if t := p.peek(0); t.TokenType != IdentToken && t.TokenType != StringToken {
+			p.fail("attribute selector")
+			return sel
+		} else {
+			sel.Value = t
+			p.i++
+		}
+		p.skipWhitespace()
+		if t := p.peek(0); t.TokenType == IdentToken && len(t.Data) == 1 && (t.Data[0]|0x20 == 'i' || t.Data[0]|0x20 == 's') {
+			sel.Modifier = t.Data[0] | 0x20
+			p.i++
+			p.skipWhitespace()
+		}
+	}
+	if p.peek(0).TokenType != RightBracketToken {
+		p.fail("attribute selector")
+		return sel
+	}
+	p.i++
+	return sel
+}
+
+func (p *tokenParser) parsePseudoSelector() SimpleSelector {
+	sel := SimpleSelector{Type: PseudoClassSelector}
+	if p.peek(0).TokenType == ColonToken {
+		sel.Type = PseudoElementSelector
+		p.i++
+	}
+
+	t := p.peek(0)
+	if t.TokenType == IdentToken {
+		p.i++
+		sel.Name = parse.ToLower(parse.Copy(t.Data))
+		return sel
+	} else if t.TokenType != FunctionToken {
+		p.fail("pseudo selector")
+		return sel
+	}
+	p.i++
+	sel.Name = parse.ToLower(parse.Copy(t.Data[:len(t.Data)-1]))
+	sel.IsFunction = true
+
+	// find the closing parenthesis
+	start := p.i
+	level := 0
+	for ; ; p.i++ {
+		if tt := p.peek(0).TokenType; tt == ErrorToken {
+			p.fail("pseudo selector")
+			return sel
+		} else if tt == FunctionToken || tt == LeftParenthesisToken {
+			level++
+		} else if tt == RightParenthesisToken {
+			if level == 0 {
+				break
+			}
+			level--
+		}
+	}
+	args := p.tokens[start:p.i]
+	p.i++
+
+	var err error
+	if sel.Type == PseudoClassSelector {
+		switch string(sel.Name) {
+		case "is", "where", "not", "matches", "-webkit-any", "-moz-any", "host", "host-context":
+			sel.Args, err = ParseSelectorList(args)
+		case "has":
+			sel.Args, err = parseRelativeSelectorList(args)
+		case "nth-child", "nth-last-child", "nth-of-type", "nth-last-of-type", "nth-col", "nth-last-col":
+			sel.Nth, sel.Args, err = parseNthArgs(args, bytes.HasSuffix(sel.Name, []byte("child")))
+		default:
+			sel.Tokens = args
+		}
+	} else if bytes.Equal(sel.Name, []byte("slotted")) {
+		sel.Args, err = ParseSelectorList(args)
+	} else {
+		sel.Tokens = args
+	}
+	if err != nil && p.err == nil {
+		p.err = err
+	}
+	return sel
+}
+
+func parseRelativeSelectorList(tokens []Token) (SelectorList, error) {
+	p := &tokenParser{tokens: tokens}
+	list := p.parseSelectorList(true)
+	if p.err == nil && p.i < len(p.tokens) {
+		p.fail("selector")
+	}
+	return list, p.err
+}
+
+// parseNthArgs parses An+B with an optional selector list when allowOf is set, as in :nth-child(2n+1 of .a).
+func parseNthArgs(tokens []Token, allowOf bool) (*Nth, SelectorList, error) {
+	var args SelectorList
+	b := []byte{}
+	for i, t := range tokens {
+		if t.TokenType == IdentToken && parse.EqualFold(t.Data, []byte("of")) && allowOf && 0 < len(b) {
+			var err error
+			if args, err = ParseSelectorList(tokens[i+1:]); err != nil {
+				return nil, nil, err
+			}
+			break
+		} else if t.TokenType != WhitespaceToken {
+			b = append(b, t.Data...)
+		}
+	}
+	nth, ok := parseNth(parse.ToLower(b))
+	if !ok {
+		return nil, nil, fmt.Errorf("CSS parse error: bad An+B expression '%s'", string(b))
+	}
+	return nth, args, nil
+}
+
+// parseNth parses the An+B microsyntax without whitespace.
+func parseNth(b []byte) (*Nth, bool) {
+	if string(b) == "odd" {
+		return &Nth{2, 1}, true
+	} else if string(b) == "even" {
+		return &Nth{2, 0}, true
+	}
+
+	i := 0
+	nth := &Nth{}
+	n := bytes.IndexByte(b, 'n')
+	if n != -1 {
+		switch a := string(b[:n]); a {
+		case "", "+":
+			nth.A = 1
+		case "-":
+			nth.A = -1
+		default:
+			var ok bool
+			if nth.A, ok = parseInteger([]byte(a)); !ok {
+				return nil, false
+			}
+		}
+		i = n + 1
+		if i == len(b) {
+			return nth, true
+		} else if b[i] != '+' && b[i] != '-' {
+			return nil, false
+		}
+	}
+	var ok bool
+	if nth.B, ok = parseInteger(b[i:]); !ok {
+		return nil, false
+	}
+	return nth, true
+}
+
+func parseInteger(b []byte) (int, bool) {
+	if len(b) == 0 {
+		return 0, false
+	}
+	i := 0
+	if b[0] == '+' || b[0] == '-' {
+		i++
+	}
+	if i == len(b) {
+		return 0, false
+	}
+	for _, c := range b[i:] {
+		if c < '0' || '9' < c {
+			return 0, false
+		}
+	}
+	v, err := strconv.Atoi(string(b))
+	return v, err == nil
+}
+
+////////////////////////////////////////////////////////////////
+
+// String returns the CSS representation of the selector list.
+func (l SelectorList) String() string {
+	return string(l.appendCSS(nil))
+}
+
+// String returns the CSS representation of the complex selector.
+func (sel ComplexSelector) String() string {
+	return string(sel.appendCSS(nil))
+}
+
+// String returns the CSS representation of the simple selector.
+func (sel SimpleSelector) String() string {
+	return string(sel.appendCSS(nil))
+}
+
+func (l SelectorList) appendCSS(b []byte) []byte {
+	for i, sel := range l {
+		if i != 0 {
+			b = append(b, ',')
+		}
+		b = sel.appendCSS(b)
+	}
+	return b
+}
+
+func (sel ComplexSelector) appendCSS(b []byte) []byte {
+	for _, compound := range sel {
+		b = append(b, compound.Combinator.String()...)
+		for _, simple := range compound.Selectors {
+			b = simple.appendCSS(b)
+		}
+	}
+	return b
+}
+
+func (sel SimpleSelector) appendCSS(b []byte) []byte {
+	switch sel.Type {
+	case TypeSelector, UniversalSelector:
+		if sel.HasNamespace {
+			b = append(b, sel.Namespace...)
+			b = append(b, '|')
+		}
+		if sel.Type == UniversalSelector {
+			return append(b, '*')
+		}
+		return append(b, sel.Name...)
+	case IDSelector:
+		b = append(b, '#')
+		return append(b, sel.Name...)
+	case ClassSelector:
+		b = append(b, '.')
+		return append(b, sel.Name...)
+	case NestingSelector:
+		return append(b, '&')
+	case AttributeSelector:
+		b = append(b, '[')
+		if sel.HasNamespace {
+			b = append(b, sel.Namespace...)
+			b = append(b, '|')
+		}
+		b = append(b, sel.Name...)
+		if sel.Matcher != ErrorToken {
+			switch sel.Matcher {
+			case IncludeMatchToken:
+				b = append(b, '~')
+			case DashMatchToken:
+				b = append(b, '|')
+			case PrefixMatchToken:
+				b = append(b, '^')
+			case SuffixMatchToken:
+				b = append(b, '$')
+			case SubstringMatchToken:
+				b = append(b, '*')
+			}
+			b = append(b, '=')
+			b = append(b, sel.Value.Data...)
+			if sel.Modifier != 0 {
+				b = append(b, ' ', sel.Modifier)
+			}
+		}
+		return append(b, ']')
+	}
+
+	b = append(b, ':')
+	if sel.Type == PseudoElementSelector {
+		b = append(b, ':')
+	}
+	b = append(b, sel.Name...)
+	if sel.IsFunction {
+		b = append(b, '(')
+		if sel.Nth != nil {
+			b = append(b, sel.Nth.String()...)
+			if sel.Args != nil {
+				b = append(b, " of "...)
+			}
+		}
+		b = sel.Args.appendCSS(b)
+		b = appendTokens(b, sel.Tokens)
+		b = append(b, ')')
+	}
+	return b
+}
+
+func isLegacyPseudoElement(name []byte) bool {
+	switch string(name) {
+	case "before", "after", "first-line", "first-letter":
+		return true
+	}
+	return false
+}
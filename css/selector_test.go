@@ -0,0 +1,141 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func lexSelector(s string) []Token {
+	tokens := []Token{}
+	l := NewLexer(parse.NewInputString(s))
+	for {
+		tt, data := l.Next()
+		if tt == ErrorToken {
+			return tokens
+		}
+		tokens = append(tokens, Token{tt, data, l.r.Offset() - len(data)})
+	}
+}
+
+func TestParseSelectorList(t *testing.T) {
+	var tests = []struct {
+		sel         string
+		expected    string
+		specificity string
+	}{
+		{"a", "a", "(0,0,1)"},
+		{"*", "*", "(0,0,0)"},
+		{"#id", "#id", "(1,0,0)"},
+		{".a.b", ".a.b", "(0,2,0)"},
+		{"a#b.c[d]:hover::before", "a#b.c[d]:hover::before", "(1,3,2)"},
+		{"a:before", "a:before", "(0,0,2)"},
+		{" a  b\n>c + d ~ e || f ", "a b>c+d~e||f", "(0,0,6)"},
+		{"a , b,c", "a,b,c", "(0,0,1)"},
+		{"ns|a, *|*, |a, *|a", "ns|a,*|*,|a,*|a", "(0,0,1)"},
+		{"[a]", "[a]", "(0,1,0)"},
+		{"[ a = b ]", "[a=b]", "(0,1,0)"},
+		{"[a='b' i][c=\"d\" S]", "[a='b' i][c=\"d\" s]", "(0,2,0)"},
+		{"[a~=b][a|=b][a^=b][a$=b][a*=b]", "[a~=b][a|=b][a^=b][a$=b][a*=b]", "(0,5,0)"},
+		{"[ns|a][*|a][|a][ns|=b]", "[ns|a][*|a][|a][ns|=b]", "(0,4,0)"},
+		{":is(#a, .b)", ":is(#a,.b)", "(1,0,0)"},
+		{":not(a, .b)", ":not(a,.b)", "(0,1,0)"},
+		{":where(#a)", ":where(#a)", "(0,0,0)"},
+		{"a:has(> img, + .b)", "a:has(>img,+.b)", "(0,1,1)"},
+		{"li:nth-child( 2n + 1 )", "li:nth-child(2n+1)", "(0,1,1)"},
+		{"li:nth-child(odd of .a, #b)", "li:nth-child(2n+1 of .a,#b)", "(1,1,1)"},
+		{":nth-child(even):nth-last-child(-n+3):nth-of-type(n-1):nth-last-of-type(+5)", ":nth-child(2n):nth-last-child(-n+3):nth-of-type(n-1):nth-last-of-type(5)", "(0,4,0)"},
+		{":nth-child(-2N- 1):nth-child(0n+0):nth-child(-7)", ":nth-child(-2n-1):nth-child(0):nth-child(-7)", "(0,3,0)"},
+		{":lang(en):dir( rtl )", ":lang(en):dir( rtl )", "(0,2,0)"},
+		{"::part(a b)::slotted(span.c)", "::part(a b)::slotted(span.c)", "(0,1,3)"},
+		{":host(.a) :HOVER", ":host(.a) :hover", "(0,3,0)"},
+		{"& > a, &.b", "&>a,&.b", "(0,1,0)"},
+		{"a\\:b.c\\.d", "a\\:b.c\\.d", "(0,1,1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sel, func(t *testing.T) {
+			list, err := ParseSelectorList(lexSelector(tt.sel))
+			test.Error(t, err)
+			test.String(t, list.String(), tt.expected)
+			test.String(t, list.Specificity().String(), tt.specificity)
+
+			// serialization must be stable
+			list2, err := ParseSelectorList(lexSelector(tt.expected))
+			test.Error(t, err)
+			test.String(t, list2.String(), tt.expected)
+		})
+	}
+}
+
+func TestParseSelectorListError(t *testing.T) {
+	var tests = []struct {
+		sel string
+		err string
+	}{
+		{"", "CSS parse error: unexpected ending in selector"},
+		{"a,", "CSS parse error: unexpected ending in selector"},
+		{"a >", "CSS parse error: unexpected ending in selector"},
+		{"a > > b", "CSS parse error: unexpected token '>' in selector"},
+		{"a)", "CSS parse error: unexpected token ')' in selector"},
+		{"a{", "CSS parse error: unexpected token '{' in selector"},
+		{"[a", "CSS parse error: unexpected ending in attribute selector"},
+		{"[a=]", "CSS parse error: unexpected token ']' in attribute selector"},
+		{"[1]", "CSS parse error: unexpected token '1' in attribute selector"},
+		{"[a=b c]", "CSS parse error: unexpected token 'c' in attribute selector"},
+		{":1", "CSS parse error: unexpected token '1' in pseudo selector"},
+		{":is(a", "CSS parse error: unexpected ending in pseudo selector"},
+		{":is()", "CSS parse error: unexpected ending in selector"},
+		{":is(> a)", "CSS parse error: unexpected token '>' in selector"},
+		{":nth-child(2n+)", "CSS parse error: bad An+B expression '2n+'"},
+		{":nth-child(n of)", "CSS parse error: unexpected ending in selector"},
+		{":nth-of-type(n of a)", "CSS parse error: bad An+B expression 'nofa'"},
+		{":nth-child(a)", "CSS parse error: bad An+B expression 'a'"},
+		{":nth-child(2n++1)", "CSS parse error: bad An+B expression '2n++1'"},
+		{".1", "CSS parse error: unexpected token '.1' in selector"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sel, func(t *testing.T) {
+			_, err := ParseSelectorList(lexSelector(tt.sel))
+			test.That(t, err != nil, "must fail")
+			test.String(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestSelectorStructure(t *testing.T) {
+	list, err := ParseSelectorList(lexSelector("ul > li.a:not([b])"))
+	test.Error(t, err)
+	test.T(t, len(list), 1)
+	sel := list[0]
+	test.T(t, len(sel), 2)
+	test.T(t, sel[0].Combinator, NoCombinator)
+	test.T(t, sel[1].Combinator, ChildCombinator)
+	test.T(t, sel[1].Selectors[0].Type, TypeSelector)
+	test.T(t, sel[1].Selectors[1].Type, ClassSelector)
+	test.String(t, string(sel[1].Selectors[1].Name), "a")
+	not := sel[1].Selectors[2]
+	test.T(t, not.Type, PseudoClassSelector)
+	test.That(t, not.IsFunction)
+	test.T(t, not.Args[0][0].Selectors[0].Type, AttributeSelector)
+	test.T(t, not.Args[0][0].Selectors[0].Matcher, ErrorToken)
+
+	list, err = ParseSelectorList(lexSelector(":nth-child(3n-2)"))
+	test.Error(t, err)
+	test.T(t, *list[0][0].Selectors[0].Nth, Nth{3, -2})
+
+	test.T(t, Specificity{0, 1, 0}.Compare(Specificity{0, 0, 5}), 1)
+	test.T(t, Specificity{0, 1, 0}.Compare(Specificity{1, 0, 0}), -1)
+	test.T(t, Specificity{0, 1, 0}.Compare(Specificity{0, 1, 0}), 0)
+}
+
+func TestQualifiedRuleSelectorList(t *testing.T) {
+	s, err := ParseStylesheet(parse.NewInputString(":is(a, b) > c , d[e=','] { x: y }"), false)
+	test.Error(t, err)
+	rule := s.Rules[0].(*QualifiedRule)
+	test.T(t, len(rule.Selectors), 2)
+
+	list, err := rule.SelectorList()
+	test.Error(t, err)
+	test.String(t, list.String(), ":is(a,b)>c,d[e=',']")
+}
@@ -0,0 +1,345 @@
+package css
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/tdewolff/parse/v2"
+)
+
+// Node is a node of a stylesheet tree: *Stylesheet, *AtRule, *QualifiedRule, *Declaration, or *Comment. String returns its serialization as CSS.
+type Node interface {
+	String() string
+	appendCSS([]byte) []byte
+}
+
+// Block is a list of rules, declarations, and comments.
+type Block []Node
+
+// Stylesheet is the root of a stylesheet tree. For inline style attributes it contains only declarations.
+type Stylesheet struct {
+	Rules Block
+}
+
+// AtRule is an at-rule such as @import or @media, with an optional block.
+type AtRule struct {
+	Name     []byte  // at-keyword including the @
+	Prelude  []Token // components between the name and the block or semicolon
+	HasBlock bool
+	Block    Block   // rules of a rule list or declarations of a declaration list, such as @media or @font-face
+	Tokens   []Token // block contents of at-rules with an unknown grammar, including whitespace
+}
+
+// QualifiedRule is a style rule with a comma-separated list of selectors.
+type QualifiedRule struct {
+	Selectors [][]Token
+	Block     Block
+}
+
+// Declaration is a property declaration. For custom properties, Values is a single CustomPropertyValueToken containing the raw value.
+type Declaration struct {
+	Property  []byte
+	Values    []Token // value without the !important annotation
+	Important bool
+}
+
+// Comment is a comment, including its delimiters.
+type Comment struct {
+	Data []byte
+}
+
+// ParseStylesheet parses a stylesheet or the contents of an inline style attribute into a tree. Erroneous declarations and rules are skipped and the first parse error is returned together with the remaining tree. Tokens reference the input buffer and are shared with the returned tree.
+func ParseStylesheet(r *parse.Input, isInline bool) (*Stylesheet, error) {
+	var err error
+	s := &Stylesheet{}
+	blocks := []*Block{&s.Rules}
+	atRules := []*AtRule{nil}
+	p := NewParser(r, isInline)
+	for {
+		gt, tt, data := p.Next()
+		block := blocks[len(blocks)-1]
+		switch gt {
+		case ErrorGrammar:
+			if 0 < len(*block) {
+				if rule, ok := (*block)[len(*block)-1].(*QualifiedRule); ok && rule.Block == nil {
+					*block = (*block)[:len(*block)-1] // selectors without a block
+				}
+			}
+			if p.HasParseError() {
+				if err == nil {
+					err = p.Err()
+				}
+				continue
+			} else if p.Err() != io.EOF {
+				return s, p.Err()
+			}
+			return s, err
+		case CommentGrammar:
+			*block = append(*block, &Comment{data})
+		case AtRuleGrammar, BeginAtRuleGrammar:
+			atRule := &AtRule{
+				Name:     data,
+				Prelude:  copyTokens(p.Values()),
+				HasBlock: gt == BeginAtRuleGrammar,
+			}
+			*block = append(*block, atRule)
+			if gt == BeginAtRuleGrammar {
+				blocks = append(blocks, &atRule.Block)
+				atRules = append(atRules, atRule)
+			}
+		case QualifiedRuleGrammar, BeginRulesetGrammar:
+			var rule *QualifiedRule
+			if 0 < len(*block) {
+				rule, _ = (*block)[len(*block)-1].(*QualifiedRule)
+			}
+			if rule == nil || rule.Block != nil {
+				rule = &QualifiedRule{}
+				*block = append(*block, rule)
+			}
+			rule.Selectors = append(rule.Selectors, copyTokens(p.Values()))
+			if gt == BeginRulesetGrammar {
+				rule.Block = Block{}
+				blocks = append(blocks, &rule.Block)
+				atRules = append(atRules, nil)
+			}
+		case EndAtRuleGrammar, EndRulesetGrammar:
+			if 1 < len(blocks) {
+				blocks = blocks[:len(blocks)-1]
+				atRules = atRules[:len(atRules)-1]
+			}
+		case DeclarationGrammar, CustomPropertyGrammar:
+			values := copyTokens(p.Values())
+			important := false
+			if gt == DeclarationGrammar {
+				values, important = cutImportant(values)
+			}
+			*block = append(*block, &Declaration{data, values, important})
+		case TokenGrammar:
+			if atRule := atRules[len(atRules)-1]; atRule != nil {
+				atRule.Tokens = append(atRule.Tokens, Token{tt, data, p.Offset()})
+			}
+			// CDO and CDC tokens in a stylesheet are dropped
+		}
+	}
+}
+
+func copyTokens(values []Token) []Token {
+	return append([]Token{}, values...)
+}
+
+// cutImportant removes a trailing !important annotation from a declaration value.
+func cutImportant(values []Token) ([]Token, bool) {
+	i := len(values) - 1
+	for 0 <= i && values[i].TokenType == WhitespaceToken {
+		i--
+	}
+	if i < 0 || values[i].TokenType != IdentToken || !parse.EqualFold(values[i].Data, []byte("important")) {
+		return values, false
+	}
+	i--
+	for 0 <= i && values[i].TokenType == WhitespaceToken {
+		i--
+	}
+	if i < 0 || values[i].TokenType != DelimToken || values[i].Data[0] != '!' {
+		return values, false
+	}
+	for 0 < i && values[i-1].TokenType == WhitespaceToken {
+		i--
+	}
+	return values[:i], true
+}
+
+////////////////////////////////////////////////////////////////
+
+// Declaration returns the last declaration of a property, which is the one that takes effect, or nil if there is none. Property names are matched case-insensitively.
+func (b Block) Declaration(property string) *Declaration {
+	for i := len(b) - 1; 0 <= i; i-- {
+		if decl, ok := b[i].(*Declaration); ok && bytes.EqualFold(decl.Property, []byte(property)) {
+			return decl
+		}
+	}
+	return nil
+}
+
+// SetDeclaration replaces the declarations of the same property by decl at the position of the last one, or appends decl if there is none.
+func (b *Block) SetDeclaration(decl *Declaration) {
+	last := -1
+	for i, n := range *b {
+		if d, ok := n.(*Declaration); ok && bytes.EqualFold(d.Property, decl.Property) {
+			last = i
+		}
+	}
+	if last == -1 {
+		*b = append(*b, decl)
+		return
+	}
+	(*b)[last] = decl
+	b.filter(func(i int, n Node) bool {
+		d, ok := n.(*Declaration)
+		return !ok || i == last || !bytes.EqualFold(d.Property, decl.Property)
+	})
+}
+
+// RemoveDeclaration removes all declarations of a property and returns the number of removed declarations.
+func (b *Block) RemoveDeclaration(property string) int {
+	n := len(*b)
+	b.filter(func(_ int, n Node) bool {
+		d, ok := n.(*Declaration)
+		return !ok || !bytes.EqualFold(d.Property, []byte(property))
+	})
+	return n - len(*b)
+}
+
+// filter keeps the nodes for which keep returns true, where i is the original index.
+func (b *Block) filter(keep func(i int, n Node) bool) {
+	j := 0
+	for i, n := range *b {
+		if keep(i, n) {
+			(*b)[j] = n
+			j++
+		}
+	}
+	for i := j; i < len(*b); i++ {
+		(*b)[i] = nil
+	}
+	*b = (*b)[:j]
+}
+
+////////////////////////////////////////////////////////////////
+
+// Visitor is called by Walk for each node. Children are skipped when Enter returns nil, and Exit is called after the children of a node have been visited.
+type Visitor interface {
+	Enter(n Node) Visitor
+	Exit(n Node)
+}
+
+// Walk traverses a stylesheet tree in depth-first order.
+func Walk(v Visitor, n Node) {
+	if n == nil {
+		return
+	} else if v = v.Enter(n); v == nil {
+		return
+	}
+	defer v.Exit(n)
+
+	if block := blockOf(n); block != nil {
+		for _, child := range *block {
+			Walk(v, child)
+		}
+	}
+}
+
+// Rewrite replaces every node below n in depth-first order by the nodes returned by f, where the children of a node are rewritten before the node itself. Returning nil removes the node and returning []Node{node} keeps it.
+func Rewrite(n Node, f func(Node) []Node) {
+	block := blockOf(n)
+	if block == nil {
+		return
+	}
+	rewritten := Block{}
+	for _, child := range *block {
+		Rewrite(child, f)
+		rewritten = append(rewritten, f(child)...)
+	}
+	*block = rewritten
+}
+
+func blockOf(n Node) *Block {
+	switch n := n.(type) {
+	case *Stylesheet:
+		return &n.Rules
+	case *AtRule:
+		return &n.Block
+	case *QualifiedRule:
+		return &n.Block
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////
+
+// WriteTo writes the stylesheet as CSS to w.
+func (s *Stylesheet) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(s.appendCSS(nil))
+	return int64(n), err
+}
+
+func (s *Stylesheet) String() string {
+	return string(s.appendCSS(nil))
+}
+
+func (r *AtRule) String() string {
+	return string(r.appendCSS(nil))
+}
+
+func (r *QualifiedRule) String() string {
+	return string(r.appendCSS(nil))
+}
+
+func (d *Declaration) String() string {
+	return string(d.appendCSS(nil))
+}
+
+func (c *Comment) String() string {
+	return string(c.Data)
+}
+
+func (s *Stylesheet) appendCSS(b []byte) []byte {
+	return s.Rules.appendCSS(b)
+}
+
+func (r *AtRule) appendCSS(b []byte) []byte {
+	b = append(b, r.Name...)
+	b = appendTokens(b, r.Prelude)
+	if !r.HasBlock {
+		return append(b, ';')
+	}
+	b = append(b, '{')
+	b = r.Block.appendCSS(b)
+	b = appendTokens(b, r.Tokens)
+	return append(b, '}')
+}
+
+func (r *QualifiedRule) appendCSS(b []byte) []byte {
+	for i, selector := range r.Selectors {
+		if i != 0 {
+			b = append(b, ',')
+		}
+		b = appendTokens(b, selector)
+	}
+	b = append(b, '{')
+	b = r.Block.appendCSS(b)
+	return append(b, '}')
+}
+
+func (d *Declaration) appendCSS(b []byte) []byte {
+	b = append(b, d.Property...)
+	b = append(b, ':')
+	b = appendTokens(b, d.Values)
+	if d.Important {
+		b = append(b, "!important"...)
+	}
+	return append(b, ';')
+}
+
+func (c *Comment) appendCSS(b []byte) []byte {
+	return append(b, c.Data...)
+}
+
+func (b Block) appendCSS(dst []byte) []byte {
+	for _, n := range b {
+		dst = n.appendCSS(dst)
+	}
+	return dst
+}
+
+func appendTokens(b []byte, tokens []Token) []byte {
+	for _, t := range tokens {
+		b = append(b, t.Data...)
+	}
+	return b
+}
+
+// TokensString returns the concatenated data of tokens, such as a selector or a declaration value.
+func TokensString(tokens []Token) string {
+	return string(appendTokens(nil, tokens))
+}
@@ -0,0 +1,206 @@
+package css
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestParseStylesheet(t *testing.T) {
+	var tests = []struct {
+		inline   bool
+		css      string
+		expected string
+	}{
+		{true, " x : y ; ", "x:y;"},
+		{true, "color: red; border: 0;", "color:red;border:0;"},
+		{true, "color: red !important;", "color:red!important;"},
+		{true, "color: red ! IMPORTANT ;", "color:red!important;"},
+		{true, "x: 1em/1.5em \"Times New Roman\", Times, serif;", "x:1em/1.5em \"Times New Roman\",Times,serif;"},
+		{true, "--custom-variable:  (0;)  ;", "--custom-variable:  (0;)  ;"},
+		{true, "*color: red; _color: red;", "*color:red;_color:red;"},
+		{false, "<!-- @charset; -->", "@charset;"},
+		{false, "@import url(a.css) screen;", "@import url(a.css) screen;"},
+		{false, "@media print, screen { }", "@media print,screen{}"},
+		{false, "@media { @viewport ; }", "@media{@viewport;}"},
+		{false, "@keyframes 'diagonal-slide' {  from { left: 0; top: 0; } to { left: 100px; top: 100px; } }", "@keyframes 'diagonal-slide'{from{left:0;top:0;}to{left:100px;top:100px;}}"},
+		{false, "@font-face { ; font:x; }", "@font-face{font:x;}"},
+		{false, "@unknown abc { {} lala }", "@unknown abc{{} lala }"},
+		{false, "a { color: red; border: 0; } b { padding: 0; }", "a{color:red;border:0;}b{padding:0;}"},
+		{false, ".a .b#c, .d<.e { x:y; }", ".a .b#c,.d<.e{x:y;}"},
+		{false, "a, b ,c{}", "a,b,c{}"},
+		{false, "/* comment */ a{}", "/* comment */a{}"},
+		{false, "table { @unknown }", "table{@unknown;}"},
+		{false, "@media print {.class{width:5px;}}", "@media print{.class{width:5px;}}"},
+		{false, "selector{", "selector{}"},
+		{false, "@media{selector{", "@media{selector{}}"},
+		{false, ".a { color: red; &:hover { color: blue; } }", ".a{color:red;&:hover{color:blue;}}"},
+		{false, ".a { @media print { x:y; .b & { x:y } } }", ".a{@media print{x:y;.b &{x:y;}}}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.css, func(t *testing.T) {
+			s, err := ParseStylesheet(parse.NewInputString(tt.css), tt.inline)
+			test.Error(t, err)
+			test.String(t, s.String(), tt.expected)
+
+			// serialization must be stable
+			s2, err := ParseStylesheet(parse.NewInputString(tt.expected), tt.inline)
+			test.Error(t, err)
+			test.String(t, s2.String(), tt.expected)
+		})
+	}
+}
+
+func TestParseStylesheetError(t *testing.T) {
+	var tests = []struct {
+		inline   bool
+		css      string
+		expected string
+		col      int
+	}{
+		{false, "}", "", 2},
+		{true, "~color:red; x:y", "x:y;", 2},
+		{false, ".foo { baddecl } .bar { color:red; }", ".foo{}.bar{color:red;}", 16},
+		{false, ".foo { baddecl baddecl; height:100px; x }", ".foo{height:100px;}", 16},
+		{false, "a{} b, c", "a{}", 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.css, func(t *testing.T) {
+			s, err := ParseStylesheet(parse.NewInputString(tt.css), tt.inline)
+			test.String(t, s.String(), tt.expected)
+			perr, ok := err.(*parse.Error)
+			test.That(t, ok, "must be *parse.Error")
+			_, col, _ := perr.Position()
+			test.T(t, col, tt.col)
+		})
+	}
+}
+
+func TestStylesheetTree(t *testing.T) {
+	s, err := ParseStylesheet(parse.NewInputString("@media screen { a, .b { color: red !important; --x: 1 } } /*c*/ @import 'x';"), false)
+	test.Error(t, err)
+	test.T(t, len(s.Rules), 3)
+
+	media := s.Rules[0].(*AtRule)
+	test.String(t, string(media.Name), "@media")
+	test.String(t, TokensString(media.Prelude), " screen")
+	test.That(t, media.HasBlock)
+	test.T(t, len(media.Block), 1)
+
+	rule := media.Block[0].(*QualifiedRule)
+	test.T(t, len(rule.Selectors), 2)
+	test.String(t, TokensString(rule.Selectors[0]), "a")
+	test.String(t, TokensString(rule.Selectors[1]), ".b")
+
+	decl := rule.Block[0].(*Declaration)
+	test.String(t, string(decl.Property), "color")
+	test.String(t, TokensString(decl.Values), "red")
+	test.That(t, decl.Important)
+	custom := rule.Block[1].(*Declaration)
+	test.T(t, custom.Values[0].TokenType, CustomPropertyValueToken)
+	test.String(t, TokensString(custom.Values), " 1 ")
+
+	test.String(t, s.Rules[1].(*Comment).String(), "/*c*/")
+	test.That(t, !s.Rules[2].(*AtRule).HasBlock)
+
+	buf := &bytes.Buffer{}
+	n, err := s.WriteTo(buf)
+	test.Error(t, err)
+	test.T(t, int(n), buf.Len())
+	test.String(t, buf.String(), "@media screen{a,.b{color:red!important;--x: 1 ;}}/*c*/@import 'x';")
+}
+
+func TestBlockDeclarations(t *testing.T) {
+	s, err := ParseStylesheet(parse.NewInputString("color: red; margin: 0; COLOR: blue; padding: 0; color: green"), true)
+	test.Error(t, err)
+
+	test.String(t, s.Rules.Declaration("Color").String(), "color:green;")
+	test.T(t, s.Rules.Declaration("border"), (*Declaration)(nil))
+
+	s.Rules.SetDeclaration(&Declaration{Property: []byte("color"), Values: []Token{{TokenType: IdentToken, Data: []byte("black")}}, Important: true})
+	test.String(t, s.String(), "margin:0;padding:0;color:black!important;")
+
+	s.Rules.SetDeclaration(&Declaration{Property: []byte("border"), Values: []Token{{TokenType: NumberToken, Data: []byte("0")}}})
+	test.String(t, s.String(), "margin:0;padding:0;color:black!important;border:0;")
+
+	test.T(t, s.Rules.RemoveDeclaration("PADDING"), 1)
+	test.T(t, s.Rules.RemoveDeclaration("padding"), 0)
+	test.String(t, s.String(), "margin:0;color:black!important;border:0;")
+}
+
+type visitor struct {
+	out []string
+}
+
+func (v *visitor) Enter(n Node) Visitor {
+	switch n := n.(type) {
+	case *Stylesheet:
+		v.out = append(v.out, "Stylesheet")
+	case *AtRule:
+		v.out = append(v.out, string(n.Name))
+	case *QualifiedRule:
+		v.out = append(v.out, TokensString(n.Selectors[0]))
+		if TokensString(n.Selectors[0]) == "skip" {
+			return nil
+		}
+	case *Declaration:
+		v.out = append(v.out, string(n.Property))
+	case *Comment:
+		v.out = append(v.out, "comment")
+	}
+	return v
+}
+
+func (v *visitor) Exit(n Node) {
+	if _, ok := n.(*Declaration); !ok {
+		v.out = append(v.out, "/")
+	}
+}
+
+func TestWalk(t *testing.T) {
+	s, err := ParseStylesheet(parse.NewInputString("/**/ @media print { a { x: y; } skip { z: w } } b { v: u }"), false)
+	test.Error(t, err)
+
+	v := &visitor{}
+	Walk(v, s)
+	test.String(t, strings.Join(v.out, " "), "Stylesheet comment / @media a x / skip / b v / /")
+}
+
+func TestRewrite(t *testing.T) {
+	s, err := ParseStylesheet(parse.NewInputString("/**/ @media print { a { x: y; z: w } b { x: y } } c { z: w }"), false)
+	test.Error(t, err)
+
+	Rewrite(s, func(n Node) []Node {
+		switch n := n.(type) {
+		case *Comment:
+			return nil
+		case *Declaration:
+			if string(n.Property) == "x" {
+				return nil
+			}
+			return []Node{n, &Declaration{Property: append([]byte("-webkit-"), n.Property...), Values: n.Values}}
+		case *QualifiedRule:
+			if len(n.Block) == 0 {
+				return nil
+			}
+		}
+		return []Node{n}
+	})
+	test.String(t, s.String(), "@media print{a{z:w;-webkit-z:w;}}c{z:w;-webkit-z:w;}")
+}
+
+func ExampleParseStylesheet() {
+	s, err := ParseStylesheet(parse.NewInputString("a { color: red !important; margin: 0 }"), false)
+	if err != nil && err != io.EOF {
+		panic(err)
+	}
+	rule := s.Rules[0].(*QualifiedRule)
+	rule.Block.RemoveDeclaration("margin")
+	fmt.Println(s)
+	// Output: a{color:red!important;}
+}
@@ -1,6 +1,11 @@
 package css
 
-import "github.com/tdewolff/parse/v2"
+import (
+	"math"
+	"strconv"
+
+	"github.com/tdewolff/parse/v2"
+)
 
 // IsIdent returns true if the bytes are a valid identifier.
 func IsIdent(b []byte) bool {
@@ -45,3 +50,19 @@ func hue2rgb(m1, m2, h float64) float64 {
 	}
 	return m1
 }
+
+// appendNumber appends a number rounded to prec decimals, without a leading zero or trailing zeros.
+func appendNumber(b []byte, f float64, prec int) []byte {
+	pow := math.Pow10(prec)
+	if f = math.Round(f*pow) / pow; f == 0.0 {
+		return append(b, '0') // also for negative zero
+	}
+	num := strconv.AppendFloat(nil, f, 'f', -1, 64)
+	if 1 < len(num) && num[0] == '0' && num[1] == '.' {
+		num = num[1:]
+	} else if 2 < len(num) && num[0] == '-' && num[1] == '0' && num[2] == '.' {
+		num[1] = '-'
+		num = num[1:]
+	}
+	return append(b, num...)
+}
@@ -1,6 +1,7 @@
 package css
 
 import (
+	"math"
 	"testing"
 
 	"github.com/tdewolff/test"
@@ -32,3 +33,23 @@ func TestHsl2Rgb(t *testing.T) {
 	test.T(t, g, 1.0)
 	test.T(t, b, 1.0)
 }
+
+func TestAppendNumber(t *testing.T) {
+	var tests = []struct {
+		f        float64
+		prec     int
+		expected string
+	}{
+		{0.0, 2, "0"},
+		{math.Copysign(0.0, -1.0), 2, "0"},
+		{-0.001, 2, "0"},
+		{0.5, 2, ".5"},
+		{-0.5, 2, "-.5"},
+		{12.3456, 2, "12.35"},
+		{100.0, 2, "100"},
+		{1e-5, 4, "0"},
+	}
+	for _, tt := range tests {
+		test.String(t, string(appendNumber(nil, tt.f, tt.prec)), tt.expected)
+	}
+}
@@ -0,0 +1,803 @@
+package css
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tdewolff/parse/v2"
+)
+
+// ValidationError is an error of a declaration that does not match the syntax of its property. Offset is the byte offset of the offending token in the input, or -1 when the property itself is unknown, in which case the offset of the declaration is given by Parser.Offset.
+type ValidationError struct {
+	Message string
+	Offset  int
+}
+
+func (err *ValidationError) Error() string {
+	return err.Message
+}
+
+// Validator validates declaration values against the value definition syntax of their properties, see https://www.w3.org/TR/css-values-4/#value-defs.
+type Validator struct {
+	properties map[string]*valueNode
+	types      map[string]*valueNode
+}
+
+// NewValidator returns a new Validator for the standard properties. More properties and data types can be added with AddProperty and AddType.
+func NewValidator() *Validator {
+	v := &Validator{
+		properties: map[string]*valueNode{},
+		types:      map[string]*valueNode{},
+	}
+	for name, syntax := range typeSyntax {
+		if err := v.AddType(name, syntax); err != nil {
+			panic(err)
+		}
+	}
+	for name, syntax := range propertySyntax {
+		if err := v.AddProperty(name, syntax); err != nil {
+			panic(err)
+		}
+	}
+	return v
+}
+
+// AddProperty adds or replaces a property with its value definition syntax, such as `[ <length-percentage> | auto ]{1,4}` for margin. The syntax may refer to other properties by <'name'> and to data types by <name>, which are either built-in or added by AddType, and which are resolved when validating.
+func (v *Validator) AddProperty(name, syntax string) error {
+	n, err := parseValueSyntax(syntax)
+	if err != nil {
+		return err
+	}
+	v.properties[name] = n
+	return nil
+}
+
+// AddType adds or replaces a data type with its value definition syntax, so that properties can refer to it by <name>. Functional notations are named after their function, such as <steps()>.
+func (v *Validator) AddType(name, syntax string) error {
+	n, err := parseValueSyntax(syntax)
+	if err != nil {
+		return err
+	}
+	v.types[name] = n
+	return nil
+}
+
+// Validate returns a ValidationError when the property is unknown, when !important is not at the end, or when the values do not match the syntax of the property. The values are as returned by Parser.Values for a DeclarationGrammar. CSS-wide keywords are valid for all properties, and custom properties, vendor-prefixed properties, and values with var(), env(), or attr() are not validated as their values are only known once substituted.
+func (v *Validator) Validate(property []byte, values []Token) error {
+	name := string(parse.ToLower(parse.Copy(property)))
+	if 0 < len(name) && name[0] == '-' {
+		return nil
+	}
+	n, ok := v.properties[name]
+	if !ok {
+		return &ValidationError{fmt.Sprintf("CSS validation error: unknown property '%s'", name), -1}
+	}
+
+	comps := splitComponents(values)
+	for i, c := range comps {
+		if c.TokenType == DelimToken && c.Data[0] == '!' && i+1 < len(comps) && comps[i+1].TokenType == IdentToken && parse.EqualFold(comps[i+1].Data, []byte("important")) {
+			if i+2 != len(comps) {
+				return &ValidationError{"CSS validation error: misplaced !important", c.Offset}
+			}
+			comps = comps[:i]
+			break
+		}
+	}
+	if len(comps) == 0 {
+		offset := -1
+		if 0 < len(values) {
+			offset = values[0].Offset
+		}
+		return &ValidationError{fmt.Sprintf("CSS validation error: missing value for property '%s'", name), offset}
+	} else if len(comps) == 1 && comps[0].TokenType == IdentToken && isCSSWideKeyword(comps[0].Data) {
+		return nil
+	}
+	for _, t := range values {
+		if t.TokenType == FunctionToken && (parse.EqualFold(t.Data, []byte("var(")) || parse.EqualFold(t.Data, []byte("env(")) || parse.EqualFold(t.Data, []byte("attr("))) {
+			return nil
+		}
+	}
+
+	m := newValueMatcher(v, comps)
+	if m.matches(n) {
+		return nil
+	} else if m.furthest < len(comps) {
+		c := comps[m.furthest]
+		return &ValidationError{fmt.Sprintf("CSS validation error: invalid value '%s' for property '%s'", TokensString(c.tokens), name), c.Offset}
+	}
+	last := comps[len(comps)-1].tokens
+	return &ValidationError{fmt.Sprintf("CSS validation error: incomplete value for property '%s'", name), last[len(last)-1].Offset + len(last[len(last)-1].Data)}
+}
+
+func isCSSWideKeyword(b []byte) bool {
+	switch string(parse.ToLower(parse.Copy(b))) {
+	case "inherit", "initial", "unset", "revert", "revert-layer":
+		return true
+	}
+	return false
+}
+
+////////////////////////////////////////////////////////////////
+
+type valueNodeType int
+
+// valueNodeType values.
+const (
+	valueKeyword  valueNodeType = iota // auto
+	valueLiteral                       // , or /
+	valueType                          // <length>
+	valueProperty                      // <'margin-top'>
+	valueFunction                      // fit-content( ... )
+	valueSequence                      // a b
+	valueAll                           // a && b
+	valueAny                           // a || b
+	valueOne                           // a | b
+)
+
+// valueNode is a component of the value definition syntax. Its multiplier matches it min to max times, where max is -1 when unbounded, and separated by commas when comma is set.
+type valueNode struct {
+	typ      valueNodeType
+	name     string
+	children []*valueNode
+
+	min, max           int
+	comma              bool
+	rangeMin, rangeMax float64 // range of numeric types
+}
+
+func (n *valueNode) hasMultiplier() bool {
+	return n.min != 1 || n.max != 1 || n.comma
+}
+
+type syntaxParser struct {
+	s   string
+	i   int
+	err error
+}
+
+// parseValueSyntax parses a value definition syntax, see https://www.w3.org/TR/css-values-4/#value-defs.
+func parseValueSyntax(s string) (*valueNode, error) {
+	p := &syntaxParser{s: s}
+	n := p.parseOne()
+	if p.err == nil && p.skipSpace() < len(p.s) {
+		p.fail()
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return n, nil
+}
+
+func (p *syntaxParser) fail() {
+	if p.err == nil {
+		if p.i < len(p.s) {
+			p.err = fmt.Errorf("CSS syntax error: unexpected '%c' at position %d in '%s'", p.s[p.i], p.i, p.s)
+		} else {
+			p.err = fmt.Errorf("CSS syntax error: unexpected ending in '%s'", p.s)
+		}
+	}
+}
+
+func (p *syntaxParser) skipSpace() int {
+	for p.i < len(p.s) && p.s[p.i] == ' ' {
+		p.i++
+	}
+	return p.i
+}
+
+// consume consumes the combinator op, where | is not the start of ||.
+func (p *syntaxParser) consume(op string) bool {
+	p.skipSpace()
+	if !strings.HasPrefix(p.s[p.i:], op) || op == "|" && strings.HasPrefix(p.s[p.i:], "||") {
+		return false
+	}
+	p.i += len(op)
+	return true
+}
+
+func (p *syntaxParser) parseCombinator(typ valueNodeType, op string, next func() *valueNode) *valueNode {
+	n := next()
+	if p.err != nil {
+		return nil
+	} else if !p.consume(op) {
+		return n
+	}
+	n = &valueNode{typ: typ, children: []*valueNode{n}, min: 1, max: 1}
+	for {
+		child := next()
+		if p.err != nil {
+			return nil
+		}
+		n.children = append(n.children, child)
+		if !p.consume(op) {
+			return n
+		}
+	}
+}
+
+// parseOne parses combinators in order of precedence, which is juxtaposition, &&, ||, and |.
+func (p *syntaxParser) parseOne() *valueNode {
+	return p.parseCombinator(valueOne, "|", func() *valueNode {
+		return p.parseCombinator(valueAny, "||", func() *valueNode {
+			return p.parseCombinator(valueAll, "&&", p.parseSequence)
+		})
+	})
+}
+
+func (p *syntaxParser) parseSequence() *valueNode {
+	n := &valueNode{typ: valueSequence, min: 1, max: 1}
+	for {
+		p.skipSpace()
+		if p.i == len(p.s) || p.s[p.i] == ']' || p.s[p.i] == ')' || p.s[p.i] == '|' || strings.HasPrefix(p.s[p.i:], "&&") {
+			break
+		}
+		child := p.parseTerm()
+		if p.err != nil {
+			return nil
+		}
+		n.children = append(n.children, child)
+	}
+	if len(n.children) == 0 {
+		p.fail()
+		return nil
+	} else if len(n.children) == 1 {
+		return n.children[0]
+	}
+	return n
+}
+
+func (p *syntaxParser) parseTerm() *valueNode {
+	n := p.parseComponent()
+	if n == nil {
+		return nil
+	}
+	for p.i < len(p.s) {
+		c := p.s[p.i]
+		if c != '*' && c != '+' && c != '?' && c != '{' && c != '#' && c != '!' {
+			break
+		} else if n.hasMultiplier() {
+			n = &valueNode{typ: valueSequence, children: []*valueNode{n}, min: 1, max: 1}
+		}
+		p.i++
+		switch c {
+		case '*':
+			n.min, n.max = 0, -1
+		case '+':
+			n.min, n.max = 1, -1
+		case '?':
+			n.min, n.max = 0, 1
+		case '#':
+			n.min, n.max, n.comma = 1, -1, true
+			if p.i < len(p.s) && p.s[p.i] == '{' {
+				p.i++
+				n.min, n.max = p.parseRepeat()
+			}
+		case '{':
+			n.min, n.max = p.parseRepeat()
+		}
+		if p.err != nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// parseRepeat parses the {A}, {A,}, and {A,B} multipliers after the opening brace.
+func (p *syntaxParser) parseRepeat() (int, int) {
+	end := strings.IndexByte(p.s[p.i:], '}')
+	if end == -1 {
+		p.i = len(p.s)
+		p.fail()
+		return 1, 1
+	}
+	nums := strings.Split(p.s[p.i:p.i+end], ",")
+	min, err := strconv.Atoi(nums[0])
+	max := min
+	if err == nil && len(nums) == 2 {
+		if nums[1] == "" {
+			max = -1
+		} else {
+			max, err = strconv.Atoi(nums[1])
+		}
+	}
+	if err != nil || 2 < len(nums) || max != -1 && max < min {
+		p.fail()
+		return 1, 1
+	}
+	p.i += end + 1
+	return min, max
+}
+
+func (p *syntaxParser) parseComponent() *valueNode {
+	n := &valueNode{min: 1, max: 1, rangeMin: math.Inf(-1), rangeMax: math.Inf(1)}
+	switch c := p.s[p.i]; {
+	case c == '[':
+		p.i++
+		child := p.parseOne()
+		if p.err != nil {
+			return nil
+		} else if p.skipSpace() == len(p.s) || p.s[p.i] != ']' {
+			p.fail()
+			return nil
+		}
+		p.i++
+		n.typ = valueSequence
+		n.children = []*valueNode{child}
+	case c == '<':
+		end := strings.IndexByte(p.s[p.i:], '>')
+		if end == -1 {
+			p.fail()
+			return nil
+		}
+		name := p.s[p.i+1 : p.i+end]
+		if 2 < len(name) && name[0] == '\'' && name[len(name)-1] == '\'' {
+			n.typ = valueProperty
+			n.name = name[1 : len(name)-1]
+		} else {
+			n.typ = valueType
+			n.name = name
+			if i := strings.IndexByte(name, ' '); i != -1 {
+				n.name = name[:i]
+				if !parseSyntaxRange(name[i+1:], &n.rangeMin, &n.rangeMax) {
+					p.i += i + 1
+					p.fail()
+					return nil
+				}
+			}
+		}
+		p.i += end + 1
+	case c == ',' || c == '/' || c == ':' || c == '=':
+		p.i++
+		n.typ = valueLiteral
+		n.name = string(c)
+	case 'a' <= c && c <= 'z' || c == '-':
+		start := p.i
+		for p.i < len(p.s) && ('a' <= p.s[p.i] && p.s[p.i] <= 'z' || '0' <= p.s[p.i] && p.s[p.i] <= '9' || p.s[p.i] == '-') {
+			p.i++
+		}
+		n.name = p.s[start:p.i]
+		if p.i < len(p.s) && p.s[p.i] == '(' {
+			p.i++
+			n.typ = valueFunction
+			if p.skipSpace() < len(p.s) && p.s[p.i] != ')' {
+				child := p.parseOne()
+				if p.err != nil {
+					return nil
+				}
+				n.children = []*valueNode{child}
+			}
+			if p.skipSpace() == len(p.s) || p.s[p.i] != ')' {
+				p.fail()
+				return nil
+			}
+			p.i++
+		} else {
+			n.typ = valueKeyword
+		}
+	default:
+		p.fail()
+		return nil
+	}
+	return n
+}
+
+// parseSyntaxRange parses a numeric range such as [0,∞] of a data type.
+func parseSyntaxRange(s string, min, max *float64) bool {
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return false
+	}
+	nums := strings.Split(s[1:len(s)-1], ",")
+	if len(nums) != 2 {
+		return false
+	}
+	for i, num := range nums {
+		f, err := strconv.ParseFloat(strings.TrimSpace(strings.Replace(num, "∞", "inf", 1)), 64)
+		if err != nil {
+			return false
+		} else if i == 0 {
+			*min = f
+		} else {
+			*max = f
+		}
+	}
+	return *min <= *max
+}
+
+////////////////////////////////////////////////////////////////
+
+// valueComponent is a component value, which is a single token or a function or block including its contents.
+type valueComponent struct {
+	Token
+	tokens []Token
+}
+
+// args returns the contents of a function or block.
+func (c valueComponent) args() []Token {
+	args := c.tokens[1:]
+	if 0 < len(args) {
+		if tt := args[len(args)-1].TokenType; tt == RightParenthesisToken || tt == RightBracketToken || tt == RightBraceToken { // closing token may be missing at EOF
+			args = args[:len(args)-1]
+		}
+	}
+	return args
+}
+
+// splitComponents returns the component values of tokens without whitespace.
+func splitComponents(tokens []Token) []valueComponent {
+	comps := []valueComponent{}
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		if t.TokenType == WhitespaceToken {
+			continue
+		}
+		start := i
+		if t.TokenType == FunctionToken || t.TokenType == LeftParenthesisToken || t.TokenType == LeftBracketToken || t.TokenType == LeftBraceToken {
+			level := 0
+			for ; i < len(tokens); i++ {
+				tt := tokens[i].TokenType
+				if tt == FunctionToken || tt == LeftParenthesisToken || tt == LeftBracketToken || tt == LeftBraceToken {
+					level++
+				} else if tt == RightParenthesisToken || tt == RightBracketToken || tt == RightBraceToken {
+					if level--; level == 0 {
+						break
+					}
+				}
+			}
+			if i == len(tokens) {
+				i--
+			}
+		}
+		comps = append(comps, valueComponent{t, tokens[start : i+1]})
+	}
+	return comps
+}
+
+// valueMatcher matches component values against the value definition syntax. Each match returns the set of indices after the matched components for all possible ways to match, which are memoized per syntax node and index so that ambiguous syntaxes do not take exponential time.
+type valueMatcher struct {
+	v        *Validator
+	comps    []valueComponent
+	memo     map[valueMatch][]int
+	furthest int // index of the furthest component that was attempted to match
+}
+
+type valueMatch struct {
+	n *valueNode
+	i int
+}
+
+func newValueMatcher(v *Validator, comps []valueComponent) *valueMatcher {
+	return &valueMatcher{
+		v:     v,
+		comps: comps,
+		memo:  map[valueMatch][]int{},
+	}
+}
+
+// matches returns true if the syntax matches all components.
+func (m *valueMatcher) matches(n *valueNode) bool {
+	for _, end := range m.match(n, 0) {
+		m.reach(end)
+		if end == len(m.comps) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *valueMatcher) reach(i int) {
+	if m.furthest < i {
+		m.furthest = i
+	}
+}
+
+func (m *valueMatcher) at(i int, tt TokenType) bool {
+	m.reach(i)
+	return i < len(m.comps) && m.comps[i].TokenType == tt
+}
+
+// addEnd adds an index to a set of indices.
+func addEnd(ends []int, end int) []int {
+	for _, e := range ends {
+		if e == end {
+			return ends
+		}
+	}
+	return append(ends, end)
+}
+
+func (m *valueMatcher) match(n *valueNode, i int) []int {
+	key := valueMatch{n, i}
+	if ends, ok := m.memo[key]; ok {
+		return ends
+	}
+
+	var ends []int
+	if !n.hasMultiplier() {
+		ends = m.matchOnce(n, i)
+	} else {
+		// match repetitions breadth-first, where once the minimum is reached the number of repetitions no longer matters
+		visited := map[int]bool{}
+		cur := []int{i}
+		for count := 0; 0 < len(cur); count++ {
+			if n.min <= count {
+				for _, j := range cur {
+					ends = addEnd(ends, j)
+				}
+			}
+			if n.max != -1 && n.max <= count {
+				break
+			}
+
+			var next []int
+			for _, j := range cur {
+				start := j
+				if n.comma && 0 < count {
+					if !m.at(j, CommaToken) {
+						continue
+					}
+					start++
+				}
+				for _, end := range m.matchOnce(n, start) {
+					if end != start && (count+1 < n.min || !visited[end]) { // prevent infinite repetitions of empty matches
+						if n.min <= count+1 {
+							visited[end] = true
+						}
+						next = addEnd(next, end)
+					}
+				}
+			}
+			cur = next
+		}
+	}
+	m.memo[key] = ends
+	return ends
+}
+
+func (m *valueMatcher) matchOnce(n *valueNode, i int) []int {
+	switch n.typ {
+	case valueKeyword:
+		if m.at(i, IdentToken) && parse.EqualFold(m.comps[i].Data, []byte(n.name)) {
+			return []int{i + 1}
+		}
+	case valueLiteral:
+		if n.name == "," && m.at(i, CommaToken) || n.name == ":" && m.at(i, ColonToken) || n.name != "," && n.name != ":" && m.at(i, DelimToken) && m.comps[i].Data[0] == n.name[0] {
+			return []int{i + 1}
+		}
+	case valueType:
+		if def, ok := m.v.types[n.name]; ok {
+			return m.match(def, i)
+		} else if n.name == "declaration-value" {
+			var ends []int
+			for end := i + 1; end <= len(m.comps); end++ {
+				ends = append(ends, end)
+			}
+			return ends
+		}
+		m.reach(i)
+		if i < len(m.comps) && matchBuiltinType(n, m.comps[i]) {
+			return []int{i + 1}
+		}
+	case valueProperty:
+		if def, ok := m.v.properties[n.name]; ok {
+			return m.match(def, i)
+		}
+	case valueFunction:
+		if !m.at(i, FunctionToken) || !parse.EqualFold(m.comps[i].Data[:len(m.comps[i].Data)-1], []byte(n.name)) {
+			return nil
+		}
+		args := newValueMatcher(m.v, splitComponents(m.comps[i].args()))
+		if len(n.children) == 0 && len(args.comps) == 0 || len(n.children) != 0 && args.matches(n.children[0]) {
+			return []int{i + 1}
+		}
+	case valueSequence:
+		cur := []int{i}
+		for _, child := range n.children {
+			var next []int
+			for _, j := range cur {
+				for _, end := range m.match(child, j) {
+					next = addEnd(next, end)
+				}
+			}
+			cur = next
+		}
+		return cur
+	case valueOne:
+		var ends []int
+		for _, child := range n.children {
+			for _, end := range m.match(child, i) {
+				ends = addEnd(ends, end)
+			}
+		}
+		return ends
+	case valueAll:
+		return m.matchAll(n.children, 0, i)
+	case valueAny:
+		return m.matchAll(n.children, 1, i)
+	}
+	return nil
+}
+
+// matchAll matches the children in any order, where each child matches at most once. All children must match for &&, or at least min children for ||.
+func (m *valueMatcher) matchAll(children []*valueNode, min, i int) []int {
+	type state struct {
+		used uint64 // set of matched children
+		i    int
+	}
+	visited := map[state]bool{}
+
+	var ends []int
+	var matchAll func(uint64, int, int)
+	matchAll = func(used uint64, count, i int) {
+		if visited[state{used, i}] {
+			return
+		}
+		visited[state{used, i}] = true
+		if min != 0 && min <= count || count == len(children) {
+			ends = addEnd(ends, i)
+		}
+		for j, child := range children {
+			if used&(1<<uint(j)) == 0 {
+				for _, end := range m.match(child, i) {
+					if end != i || min == 0 { // optional children of && may match nothing
+						matchAll(used|1<<uint(j), count+1, end)
+					}
+				}
+			}
+		}
+	}
+	matchAll(0, 0, i)
+	return ends
+}
+
+////////////////////////////////////////////////////////////////
+
+// matchBuiltinType returns true if the component is of a built-in data type and within its numeric range.
+func matchBuiltinType(n *valueNode, c valueComponent) bool {
+	switch n.name {
+	case "number", "integer", "length", "percentage", "length-percentage", "angle", "time", "frequency", "resolution", "flex":
+		if c.TokenType == FunctionToken {
+			return isMathFunction(c.Data) && isCalcType(n.name, c.tokens)
+		}
+		num, unit, ok := splitDimension(c.Token)
+		if !ok || num < n.rangeMin || n.rangeMax < num {
+			return false
+		}
+		switch n.name {
+		case "number":
+			return c.TokenType == NumberToken
+		case "integer":
+			return c.TokenType == NumberToken && num == math.Trunc(num) && !strings.ContainsAny(string(c.Data), ".eE")
+		case "percentage":
+			return c.TokenType == PercentageToken
+		case "length":
+			return isUnitType(unit, CalcLength) || c.TokenType == NumberToken && num == 0.0
+		case "length-percentage":
+			return isUnitType(unit, CalcLength) || c.TokenType == NumberToken && num == 0.0 || c.TokenType == PercentageToken
+		case "angle":
+			return isUnitType(unit, CalcAngle)
+		case "time":
+			return isUnitType(unit, CalcTime)
+		case "frequency":
+			return isUnitType(unit, CalcFrequency)
+		case "resolution":
+			return isUnitType(unit, CalcResolution)
+		case "flex":
+			return isUnitType(unit, CalcFlex)
+		}
+	case "zero":
+		num, _, ok := splitDimension(c.Token)
+		return ok && c.TokenType == NumberToken && num == 0.0
+	case "ident":
+		return c.TokenType == IdentToken
+	case "custom-ident":
+		return c.TokenType == IdentToken && !isCSSWideKeyword(c.Data) && !parse.EqualFold(c.Data, []byte("default"))
+	case "dashed-ident":
+		return c.TokenType == CustomPropertyNameToken
+	case "string":
+		return c.TokenType == StringToken
+	case "url":
+		return c.TokenType == URLToken || c.TokenType == FunctionToken && (parse.EqualFold(c.Data, []byte("url(")) || parse.EqualFold(c.Data, []byte("src(")))
+	case "color":
+		if c.TokenType == IdentToken && (parse.EqualFold(c.Data, []byte("currentcolor")) || systemColors[string(parse.ToLower(parse.Copy(c.Data)))]) {
+			return true
+		} else if c.TokenType == FunctionToken && (parse.EqualFold(c.Data, []byte("color-mix(")) || parse.EqualFold(c.Data, []byte("light-dark("))) {
+			return true
+		}
+		_, err := ParseColor(c.tokens)
+		return err == nil
+	case "hex-color":
+		_, err := ParseColor(c.tokens)
+		return c.TokenType == HashToken && err == nil
+	case "image":
+		if c.TokenType == URLToken {
+			return true
+		} else if c.TokenType == FunctionToken {
+			switch string(parse.ToLower(parse.Copy(c.Data))) {
+			case "url(", "src(", "image(", "image-set(", "cross-fade(", "element(", "paint(", "linear-gradient(", "radial-gradient(", "conic-gradient(", "repeating-linear-gradient(", "repeating-radial-gradient(", "repeating-conic-gradient(":
+				return true
+			}
+		}
+	case "line-names":
+		if c.TokenType != LeftBracketToken {
+			return false
+		}
+		for _, name := range splitComponents(c.args()) {
+			if name.TokenType != IdentToken {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// splitDimension returns the number and unit of a number, percentage, or dimension token.
+func splitDimension(t Token) (float64, []byte, bool) {
+	if t.TokenType != NumberToken && t.TokenType != PercentageToken && t.TokenType != DimensionToken {
+		return 0.0, nil, false
+	}
+	n, _ := parse.Dimension(t.Data)
+	num, err := strconv.ParseFloat(string(t.Data[:n]), 64)
+	if err != nil {
+		return 0.0, nil, false
+	}
+	return num, t.Data[n:], true
+}
+
+func isUnitType(unit []byte, base CalcBaseType) bool {
+	u, ok := calcUnits[string(parse.ToLower(parse.Copy(unit)))]
+	return ok && u.base == base
+}
+
+// isCalcType returns true if the math function is of the given data type.
+func isCalcType(name string, tokens []Token) bool {
+	n, err := ParseCalc(tokens)
+	if err != nil {
+		return false
+	}
+	t, _ := n.ValueType()
+	base, ok := t.Base()
+	switch name {
+	case "number", "integer":
+		return t == CalcType{}
+	case "percentage":
+		return ok && base == CalcPercent
+	case "length-percentage":
+		return ok && (base == CalcLength || base == CalcPercent)
+	}
+	return ok && base == calcBaseTypes[name]
+}
+
+var calcBaseTypes = map[string]CalcBaseType{
+	"length":     CalcLength,
+	"angle":      CalcAngle,
+	"time":       CalcTime,
+	"frequency":  CalcFrequency,
+	"resolution": CalcResolution,
+	"flex":       CalcFlex,
+}
+
+// systemColors are the system color keywords, see https://www.w3.org/TR/css-color-4/#css-system-colors.
+var systemColors = map[string]bool{
+	"accentcolor":      true,
+	"accentcolortext":  true,
+	"activetext":       true,
+	"buttonborder":     true,
+	"buttonface":       true,
+	"buttontext":       true,
+	"canvas":           true,
+	"canvastext":       true,
+	"field":            true,
+	"fieldtext":        true,
+	"graytext":         true,
+	"highlight":        true,
+	"highlighttext":    true,
+	"linktext":         true,
+	"mark":             true,
+	"marktext":         true,
+	"selecteditem":     true,
+	"selecteditemtext": true,
+	"visitedtext":      true,
+}
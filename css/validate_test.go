@@ -0,0 +1,187 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func validateDeclaration(v *Validator, decl string) error {
+	p := NewParser(parse.NewInputString(decl), true)
+	gt, _, data := p.Next()
+	if gt != DeclarationGrammar && gt != CustomPropertyGrammar {
+		return p.Err()
+	}
+	return v.Validate(data, p.Values())
+}
+
+func TestValidate(t *testing.T) {
+	var tests = []string{
+		"color: red",
+		"color: #ff000080",
+		"color: rgb(255 0 0 / 50%)",
+		"color: currentColor",
+		"COLOR: Red",
+		"margin: 0",
+		"margin: 1px 2px",
+		"margin: 1px auto 5% 0",
+		"margin: calc(100% - 10px)",
+		"margin: -1px",
+		"padding: 0 1em",
+		"width: fit-content(50%)",
+		"width: max-content",
+		"opacity: .5",
+		"opacity: 50%",
+		"z-index: -1",
+		"order: 2",
+		"display: inline flex",
+		"display: flex",
+		"display: list-item block",
+		"position: sticky",
+		"border: 1px solid",
+		"border: solid red thin",
+		"border-radius: 10px 5% / 20px",
+		"font: italic bold 12px/30px Georgia, serif",
+		"font: 12px sans-serif",
+		"font-family: Times New Roman, \"Helvetica Neue\", serif",
+		"font-weight: 450",
+		"font-family: a b c d e f g h i j k l m n o p q r s t u v w x y z, serif",
+		"background: url(a.png) no-repeat center / cover, #fff",
+		"background: linear-gradient(red, blue)",
+		"background-position: left 10px top",
+		"box-shadow: 0 0 3px rgba(0,0,0,.5), inset 1px 1px red",
+		"box-shadow: none",
+		"text-shadow: 1px 1px red, 2px 2px blue",
+		"transition: opacity .3s ease-in-out, transform 1s",
+		"transition-timing-function: cubic-bezier(.1, .7, 1, .1)",
+		"animation: spin 1s linear infinite",
+		"animation: 2s steps(4, jump-end) 1s both",
+		"transform: translate(10px, 20px) rotate(45deg)",
+		"transform: none",
+		"grid-template-columns: repeat(3, 1fr) [a] minmax(100px, 1fr)",
+		"grid-template-columns: repeat(auto-fill, minmax(10em, 1fr))",
+		"grid-area: 1 / 2 / span 3 / main",
+		"flex: 1 1 0%",
+		"flex: none",
+		"aspect-ratio: 16 / 9",
+		"content: \"a\" counter(item) \".\"",
+		"cursor: url(a.svg) 4 4, pointer",
+		"clip-path: circle(50% at center)",
+		"filter: blur(2px) drop-shadow(1px 1px red)",
+		"color: red !important",
+		"color: red!IMPORTANT",
+		"margin: inherit",
+		"margin: revert-layer",
+		"margin: var(--x) auto",
+		"width: calc(100% - env(safe-area-inset-left))",
+		"--custom: anything { goes }",
+		"-webkit-appearance: none",
+	}
+	v := NewValidator()
+	for _, decl := range tests {
+		t.Run(decl, func(t *testing.T) {
+			test.Error(t, validateDeclaration(v, decl))
+		})
+	}
+}
+
+func TestValidateError(t *testing.T) {
+	var tests = []struct {
+		decl   string
+		err    string
+		offset int
+	}{
+		{"colr: red", "CSS validation error: unknown property 'colr'", -1},
+		{"color:", "CSS validation error: missing value for property 'color'", -1},
+		{"color: !important", "CSS validation error: missing value for property 'color'", 7},
+		{"color: red !important blue", "CSS validation error: misplaced !important", 11},
+		{"color: redd", "CSS validation error: invalid value 'redd' for property 'color'", 7},
+		{"margin: 1px 2px 3px 4px 5px", "CSS validation error: invalid value '5px' for property 'margin'", 24},
+		{"margin: 1s", "CSS validation error: invalid value '1s' for property 'margin'", 8},
+		{"margin: 5", "CSS validation error: invalid value '5' for property 'margin'", 8},
+		{"padding: -1px", "CSS validation error: invalid value '-1px' for property 'padding'", 9},
+		{"width: calc(1s)", "CSS validation error: invalid value 'calc(1s)' for property 'width'", 7},
+		{"width: fit-content(auto)", "CSS validation error: invalid value 'fit-content(auto)' for property 'width'", 7},
+		{"z-index: 1.5", "CSS validation error: invalid value '1.5' for property 'z-index'", 9},
+		{"opacity: 1px", "CSS validation error: invalid value '1px' for property 'opacity'", 9},
+		{"display: block block", "CSS validation error: invalid value 'block' for property 'display'", 15},
+		{"border: 1px 2px", "CSS validation error: invalid value '2px' for property 'border'", 12},
+		{"margin: inherit 1px", "CSS validation error: invalid value 'inherit' for property 'margin'", 8},
+		{"box-shadow: 1px", "CSS validation error: incomplete value for property 'box-shadow'", 15},
+		{"transition: opacity 1s,", "CSS validation error: incomplete value for property 'transition'", 23},
+		{"grid-area: 1 / 2 / 3 / 4 / 5", "CSS validation error: invalid value '/' for property 'grid-area'", 25},
+	}
+	v := NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.decl, func(t *testing.T) {
+			err := validateDeclaration(v, tt.decl)
+			test.That(t, err != nil, "must fail")
+			test.String(t, err.Error(), tt.err)
+			test.T(t, err.(*ValidationError).Offset, tt.offset)
+		})
+	}
+}
+
+func TestValidatorAdd(t *testing.T) {
+	v := NewValidator()
+	test.Error(t, v.AddType("size", "small | medium | large"))
+	test.Error(t, v.AddProperty("box-size", "<size> | <length [0,∞]>{1,2}"))
+	test.Error(t, v.AddProperty("box", "<'box-size'> && [ round | square ]?"))
+
+	test.Error(t, validateDeclaration(v, "box-size: medium"))
+	test.Error(t, validateDeclaration(v, "box-size: 1px 2px"))
+	test.Error(t, validateDeclaration(v, "box: square 1px"))
+	test.That(t, validateDeclaration(v, "box-size: huge") != nil, "must fail")
+	test.That(t, validateDeclaration(v, "box: -1px") != nil, "must fail")
+}
+
+func TestValueSyntaxError(t *testing.T) {
+	var tests = []struct {
+		syntax string
+		err    string
+	}{
+		{"", "CSS syntax error: unexpected ending in ''"},
+		{"a |", "CSS syntax error: unexpected ending in 'a |'"},
+		{"[ a", "CSS syntax error: unexpected ending in '[ a'"},
+		{"a ]", "CSS syntax error: unexpected ']' at position 2 in 'a ]'"},
+		{"<length", "CSS syntax error: unexpected '<' at position 0 in '<length'"},
+		{"a{2", "CSS syntax error: unexpected ending in 'a{2'"},
+		{"a{x}", "CSS syntax error: unexpected 'x' at position 2 in 'a{x}'"},
+		{"a && | b", "CSS syntax error: unexpected '|' at position 5 in 'a && | b'"},
+	}
+	v := NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.syntax, func(t *testing.T) {
+			err := v.AddProperty("x", tt.syntax)
+			test.That(t, err != nil, "must fail")
+			test.String(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestValueSyntaxReferences(t *testing.T) {
+	builtin := map[string]bool{}
+	for _, name := range []string{"number", "integer", "length", "percentage", "length-percentage", "angle", "time", "frequency", "resolution", "flex", "zero", "ident", "custom-ident", "dashed-ident", "string", "url", "color", "hex-color", "image", "line-names", "declaration-value"} {
+		builtin[name] = true
+	}
+
+	v := NewValidator()
+	var walk func(string, *valueNode)
+	walk = func(name string, n *valueNode) {
+		if n.typ == valueType && v.types[n.name] == nil && !builtin[n.name] {
+			test.Fail(t, "unknown type", "<"+n.name+">", "in", name)
+		} else if n.typ == valueProperty && v.properties[n.name] == nil {
+			test.Fail(t, "unknown property", "<'"+n.name+"'>", "in", name)
+		}
+		for _, child := range n.children {
+			walk(name, child)
+		}
+	}
+	for name, n := range v.types {
+		walk(name, n)
+	}
+	for name, n := range v.properties {
+		walk(name, n)
+	}
+}
@@ -0,0 +1,339 @@
+package html
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/parse/v2/js"
+)
+
+// ScriptType specifies how inline JavaScript is included in an HTML document.
+type ScriptType int
+
+// ScriptType values.
+const (
+	ClassicScript ScriptType = iota // script element without type or with a JavaScript MIME type
+	ModuleScript                    // script element with type="module"
+	EventHandler                    // event handler attribute such as onclick
+)
+
+func (t ScriptType) String() string {
+	switch t {
+	case ClassicScript:
+		return "ClassicScript"
+	case ModuleScript:
+		return "ModuleScript"
+	case EventHandler:
+		return "EventHandler"
+	}
+	return "Invalid(" + strconv.Itoa(int(t)) + ")"
+}
+
+// jsMimetypes are the JavaScript MIME type essences, see https://mimesniff.spec.whatwg.org/#javascript-mime-type.
+var jsMimetypes = map[string]bool{
+	"application/ecmascript":   true,
+	"application/javascript":   true,
+	"application/x-ecmascript": true,
+	"application/x-javascript": true,
+	"text/ecmascript":          true,
+	"text/javascript":          true,
+	"text/javascript1.0":       true,
+	"text/javascript1.1":       true,
+	"text/javascript1.2":       true,
+	"text/javascript1.3":       true,
+	"text/javascript1.4":       true,
+	"text/javascript1.5":       true,
+	"text/jscript":             true,
+	"text/livescript":          true,
+	"text/x-ecmascript":        true,
+	"text/x-javascript":        true,
+}
+
+// InlineScript is JavaScript found in an HTML document.
+type InlineScript struct {
+	Type   ScriptType
+	Name   []byte  // lowercase tag name for script elements or attribute name for event handlers
+	Offset int     // offset of the JavaScript source in the HTML document
+	JS     []byte  // JavaScript source, with character references decoded for event handlers
+	AST    *js.AST // parsed JavaScript, which is incomplete when Err is set
+	Err    error   // error from js.Parse, where a *parse.Error has the line and column in the HTML document, while a *js.LimitError has its offset and position in JS, see DocOffset
+
+	refs []charRef // decoded character references
+}
+
+// charRef is a decoded character reference at offset in the decoded source that was n bytes long in the HTML document and is m bytes long when decoded.
+type charRef struct {
+	offset int
+	n, m   int
+}
+
+// DocOffset converts an offset in the JavaScript source to the offset in the HTML document. Offsets within a decoded character reference return the offset of the reference.
+func (s *InlineScript) DocOffset(offset int) int {
+	shift := 0
+	for _, ref := range s.refs {
+		if offset < ref.offset+ref.m {
+			if ref.offset <= offset {
+				offset = ref.offset
+			}
+			break
+		}
+		shift += ref.n - ref.m
+	}
+	return s.Offset + offset + shift
+}
+
+// ScriptLexer extracts the inline JavaScript of an HTML document, which are the contents of script elements and the values of event handler attributes. Script elements with a src attribute or a type that is not JavaScript, and scripts inside svg and math elements are skipped.
+type ScriptLexer struct {
+	r *parse.Input
+	l *Lexer
+	o js.Options
+
+	script   *InlineScript // script element whose contents follow
+	inScript bool          // inside the start tag of a script element
+	typ      []byte
+	language []byte
+	hasSrc   bool
+}
+
+// NewScriptLexer returns a new ScriptLexer for a given HTML document, where o are the options used to parse the JavaScript. Options.Module is set for module scripts.
+func NewScriptLexer(r *parse.Input, o js.Options) *ScriptLexer {
+	return &ScriptLexer{
+		r: r,
+		l: NewLexer(r),
+		o: o,
+	}
+}
+
+// Err returns the error of the HTML lexer, which is io.EOF at the end of the document.
+func (z *ScriptLexer) Err() error {
+	return z.l.Err()
+}
+
+// Next returns the next inline script in document order, or nil at the end of the document or when the HTML lexer encounters an error. JavaScript errors do not stop the lexer and are returned in InlineScript.Err.
+func (z *ScriptLexer) Next() *InlineScript {
+	for {
+		tt, data := z.l.Next()
+		script := z.script
+		z.script = nil
+		switch tt {
+		case ErrorToken:
+			return nil
+		case StartTagToken:
+			z.inScript = ToHash(z.l.Text()) == Script
+			z.typ, z.language, z.hasSrc = nil, nil, false
+		case AttributeToken:
+			name := z.l.Text()
+			val := z.l.AttrVal()
+			offset := z.r.Offset() - len(val)
+			if 0 < len(val) && (val[0] == '"' || val[0] == '\'') {
+				offset++
+				if 1 < len(val) && val[len(val)-1] == val[0] {
+					val = val[1 : len(val)-1]
+				} else {
+					val = val[1:]
+				}
+			}
+			if z.inScript {
+				if bytes.Equal(name, []byte("type")) && z.typ == nil {
+					z.typ = val
+				} else if bytes.Equal(name, []byte("language")) && z.language == nil {
+					z.language = val
+				} else if bytes.Equal(name, []byte("src")) {
+					z.hasSrc = true
+				}
+			}
+			if 2 < len(name) && name[0] == 'o' && name[1] == 'n' && z.l.AttrVal() != nil {
+				src, refs := decodeCharRefs(val)
+				return z.parse(&InlineScript{
+					Type:   EventHandler,
+					Name:   name,
+					Offset: offset,
+					JS:     src,
+					refs:   refs,
+				})
+			}
+		case StartTagCloseToken, StartTagVoidToken:
+			if z.inScript && !z.hasSrc {
+				if typ, ok := scriptType(z.typ, z.language); ok {
+					z.script = &InlineScript{
+						Type: typ,
+						Name: []byte("script"),
+					}
+				}
+			}
+			z.inScript = false
+		case TextToken:
+			if script != nil {
+				script.Offset = z.r.Offset() - len(data)
+				script.JS = data
+				return z.parse(script)
+			}
+		}
+	}
+}
+
+func (z *ScriptLexer) parse(script *InlineScript) *InlineScript {
+	o := z.o
+	o.Module = o.Module || script.Type == ModuleScript
+	script.AST, script.Err = js.ParseContext(context.Background(), parse.NewInputBytes(script.JS), o)
+	if err, ok := script.Err.(*parse.Error); ok {
+		offset := script.DocOffset(lineColOffset(script.JS, err.Line, err.Column))
+		script.Err = parse.NewError(bytes.NewReader(z.r.Bytes()), offset, err.Message)
+	}
+	return script
+}
+
+// scriptType returns the type of a script element given its type and language attributes, or false if it is not JavaScript, see https://html.spec.whatwg.org/multipage/scripting.html#prepare-the-script-element.
+func scriptType(typ, language []byte) (ScriptType, bool) {
+	if typ == nil {
+		if len(language) == 0 {
+			return ClassicScript, true
+		}
+		typ = append([]byte("text/"), language...)
+	}
+	typ = parse.ToLower(parse.Copy(parse.TrimWhitespace(typ)))
+	if len(typ) == 0 || jsMimetypes[string(typ)] {
+		return ClassicScript, true
+	} else if bytes.Equal(typ, []byte("module")) {
+		return ModuleScript, true
+	}
+	return 0, false
+}
+
+// lineColOffset returns the offset of a line and column as returned by parse.Position.
+func lineColOffset(b []byte, line, col int) int {
+	i := 0
+	for ; 1 < line && i < len(b); i++ {
+		if b[i] == '\n' {
+			line--
+		} else if b[i] == '\r' {
+			if i+1 < len(b) && b[i+1] == '\n' {
+				i++
+			}
+			line--
+		} else if 0xC0 <= b[i] {
+			if r, n := utf8.DecodeRune(b[i:]); r == '\u2028' || r == '\u2029' {
+				i += n - 1
+				line--
+			}
+		}
+	}
+	for ; 1 < col && i < len(b); col-- {
+		_, n := utf8.DecodeRune(b[i:])
+		i += n
+	}
+	return i
+}
+
+// decodeCharRefs decodes the character references in an attribute value, see https://html.spec.whatwg.org/multipage/parsing.html#character-reference-state. It returns b when there are none.
+func decodeCharRefs(b []byte) ([]byte, []charRef) {
+	var dst []byte
+	var refs []charRef
+	start := 0
+	for i := 0; i < len(b); i++ {
+		if b[i] != '&' {
+			continue
+		}
+		s, n := charRefString(b[i:])
+		if n == 0 {
+			continue
+		}
+		if dst == nil {
+			dst = make([]byte, 0, len(b))
+		}
+		dst = append(dst, b[start:i]...)
+		refs = append(refs, charRef{len(dst), n, len(s)})
+		dst = append(dst, s...)
+		i += n - 1
+		start = i + 1
+	}
+	if dst == nil {
+		return b, nil
+	}
+	return append(dst, b[start:]...), refs
+}
+
+// charRefString returns the decoded string and length of the character reference at the start of an attribute value b, or zero if there is none.
+func charRefString(b []byte) (string, int) {
+	if len(b) < 3 {
+		return "", 0
+	} else if b[1] == '#' {
+		r, n := numericCharRef(b)
+		if n == 0 {
+			return "", 0
+		}
+		return string(r), n // surrogates are encoded as utf8.RuneError
+	}
+
+	// find the longest name, where legacy names without a semicolon are at most six characters long
+	i := 1
+	for i < len(b) && i < 1+maxCharRefLen && isAlphanumeric(b[i]) {
+		i++
+	}
+	if i < len(b) && b[i] == ';' {
+		if s, ok := namedCharRefs[string(b[1:i+1])]; ok {
+			return s, i + 1
+		}
+	}
+	if 1+6 < i {
+		i = 1 + 6
+	}
+	for ; 1 < i; i-- {
+		if s, ok := namedCharRefs[string(b[1:i])]; ok {
+			if i < len(b) && (b[i] == '=' || isAlphanumeric(b[i])) {
+				// legacy references are not decoded in attribute values when followed by = or an alphanumeric
+				return "", 0
+			}
+			return s, i
+		}
+	}
+	return "", 0
+}
+
+// maxCharRefLen is the length of the longest named character reference without its semicolon, &CounterClockwiseContourIntegral;.
+const maxCharRefLen = 31
+
+func isAlphanumeric(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// numericCharRef returns the rune and length of the numeric character reference at the start of b, or zero if there is none.
+func numericCharRef(b []byte) (rune, int) {
+	i := 2
+	base := 10
+	if b[i] == 'x' || b[i] == 'X' {
+		base = 16
+		i++
+	}
+	r := 0
+	digits := 0
+	for ; i < len(b); i++ {
+		c := b[i]
+		d := 0
+		if '0' <= c && c <= '9' {
+			d = int(c - '0')
+		} else if base == 16 && 'a' <= c && c <= 'f' {
+			d = int(c-'a') + 10
+		} else if base == 16 && 'A' <= c && c <= 'F' {
+			d = int(c-'A') + 10
+		} else {
+			break
+		}
+		if r <= utf8.MaxRune {
+			r = r*base + d
+		}
+		digits++
+	}
+	if digits == 0 {
+		return 0, 0
+	} else if i < len(b) && b[i] == ';' {
+		i++
+	}
+	if r == 0 || utf8.MaxRune < r {
+		return utf8.RuneError, i
+	}
+	return rune(r), i
+}
@@ -0,0 +1,130 @@
+package html
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/parse/v2/js"
+	"github.com/tdewolff/test"
+)
+
+func TestScriptLexer(t *testing.T) {
+	var tests = []struct {
+		html     string
+		expected string
+	}{
+		{"<script>var a = 1;</script>", "ClassicScript script 8 `var a = 1;`"},
+		{"<SCRIPT>a</SCRIPT><script></script>", "ClassicScript script 8 `a`"},
+		{"<script type=\"module\">import a from 'b'</script>", "ModuleScript script 22 `import a from 'b'`"},
+		{"<script type=' Text/JavaScript '>a</script><script type=''>b</script>", "ClassicScript script 33 `a` | ClassicScript script 59 `b`"},
+		{"<script type=text/template>{{a}}</script><script type=module type=x>b</script>", "ModuleScript script 68 `b`"},
+		{"<script language=javascript>a</script><script language=vbscript>b</script>", "ClassicScript script 28 `a`"},
+		{"<script src=a.js>a</script><script src=\"a.js\"></script>", ""},
+		{"<script/>a</script>", "ClassicScript script 9 `a`"},
+		{"<svg><script>a</script></svg><math onclick=a></math>", ""},
+		{"<button onclick=\"a(&quot;b&quot;)\" ONLOAD='return false' class=x onx=b>", "EventHandler onclick 17 `a(\"b\")` | EventHandler onload 43 `return false` | EventHandler onx 69 `b`"},
+		{"<div onclick on onclick=\"\">", "EventHandler onclick 25 ``"},
+		{"<script onload=a>b</script>", "EventHandler onload 15 `a` | ClassicScript script 17 `b`"},
+		{"<p onclick='a", "EventHandler onclick 12 `a`"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.html, func(t *testing.T) {
+			z := NewScriptLexer(parse.NewInputString(tt.html), js.Options{})
+			scripts := []string{}
+			for {
+				script := z.Next()
+				if script == nil {
+					break
+				}
+				test.Error(t, script.Err)
+				scripts = append(scripts, fmt.Sprintf("%v %s %d `%s`", script.Type, script.Name, script.Offset, script.JS))
+			}
+			test.T(t, z.Err(), io.EOF)
+			test.String(t, strings.Join(scripts, " | "), tt.expected)
+		})
+	}
+}
+
+func TestScriptLexerErrors(t *testing.T) {
+	var tests = []struct {
+		html   string
+		line   int
+		column int
+	}{
+		{"<p>\n<script>\nvar a = ;\n</script>", 3, 9},
+		{"<a onclick=\"a &amp;&amp; ) \">", 1, 26},
+		{"<a\nonclick='\n&#x61;\n&lt;)'>", 4, 5},
+		{"<script type=module>\nwith (a) {}</script>", 2, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.html, func(t *testing.T) {
+			z := NewScriptLexer(parse.NewInputString(tt.html), js.Options{})
+			script := z.Next()
+			test.That(t, script != nil)
+			perr, ok := script.Err.(*parse.Error)
+			test.That(t, ok, "must be *parse.Error")
+			line, column, _ := perr.Position()
+			test.T(t, line, tt.line, "line")
+			test.T(t, column, tt.column, "column")
+		})
+	}
+
+	// classic scripts are not strict mode code
+	script := NewScriptLexer(parse.NewInputString("<script>with (a) {}</script>"), js.Options{}).Next()
+	test.Error(t, script.Err)
+
+	// limit errors have offsets in the JavaScript source
+	script = NewScriptLexer(parse.NewInputString("<a onclick='&quot;a&quot;;b;c'>"), js.Options{MaxTokens: 3}).Next()
+	limitErr, ok := script.Err.(*js.LimitError)
+	test.That(t, ok, "must be *js.LimitError")
+	test.T(t, limitErr.Offset, 5)
+	test.T(t, script.DocOffset(limitErr.Offset), 27)
+}
+
+func TestDocOffset(t *testing.T) {
+	z := NewScriptLexer(parse.NewInputString("<a onclick='a&amp;b&#x2028;c'>"), js.Options{})
+	script := z.Next()
+	test.String(t, string(script.JS), "a&b c")
+
+	var offsets = []struct {
+		js, html int
+	}{
+		{0, 12},
+		{1, 13},
+		{2, 18},
+		{3, 19},
+		{4, 19},
+		{6, 27},
+		{7, 28},
+	}
+	for _, tt := range offsets {
+		t.Run(fmt.Sprint(tt.js), func(t *testing.T) {
+			test.T(t, script.DocOffset(tt.js), tt.html)
+		})
+	}
+}
+
+func TestDecodeCharRefs(t *testing.T) {
+	var tests = []struct {
+		attrVal  string
+		expected string
+	}{
+		{"abc", "abc"},
+		{"a&#x41;&#66&lt;b", "aAB<b"},
+		{"&amp;&quot;&apos;&gt;", "&\"'>"},
+		{"&bogus;&amp&#;&#x;&", "&bogus;&&#;&#x;&"},
+		{"a&nbsp;b&copy;&lpar;&rpar;", "a\u00a0b\u00a9()"},
+		{"&NotEqualTilde;&CounterClockwiseContourIntegral;", "\u2242\u0338\u2233"},
+		{"&amp &lt+&not.&notit;&ampx&amp=&ampx;", "& <+\u00ac.&notit;&ampx&amp=&ampx;"},
+		{"&#0;&#x110000;&#xD800;&#99999999999;", "����"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.attrVal, func(t *testing.T) {
+			b, _ := decodeCharRefs([]byte(tt.attrVal))
+			test.String(t, string(b), tt.expected)
+		})
+	}
+}
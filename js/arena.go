@@ -0,0 +1,38 @@
+package js
+
+const (
+	arenaChunk      = 256  // number of nodes per chunk
+	arenaSliceChunk = 1024 // number of slice elements per chunk
+)
+
+//go:generate go run arena_gen.go
+
+// arena allocates AST nodes, statement lists, argument lists, binding lists, and the variable arrays of scopes from chunks that are pooled and reused after AST.Release. All methods allocate on the heap when the arena is nil, where nodes are copied into new(T) so that the argument itself does not escape to the heap. The chunks and allocators of each type are generated in arena_types.go.
+type arena struct {
+	arenaChunks
+}
+
+// Release returns the memory of an AST that was parsed with Options.Arena to a pool, so that it is reused by subsequent parses. The AST and all its nodes, variables, and scopes must not be used afterwards. It does nothing for other ASTs.
+func (ast *AST) Release() {
+	if ast.arena != nil {
+		ast.arena.release()
+		ast.arena = nil
+	}
+	ast.Comments = nil
+	ast.BlockStmt = BlockStmt{}
+	ast.ASI = nil
+}
+
+// listCap returns the capacity of a list with the given length and capacity when it is grown by the arena, or zero when it should be appended to as usual. Lists grow by doubling their capacity, and lists too large for a chunk are allocated on the heap.
+func (a *arena) listCap(n, c int) int {
+	if a == nil || n < c {
+		return 0
+	}
+	c *= 2
+	if c < 4 {
+		c = 4
+	} else if arenaSliceChunk < c {
+		return 0
+	}
+	return c
+}
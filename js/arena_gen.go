@@ -0,0 +1,129 @@
+//go:build ignore
+// +build ignore
+
+// This program generates arena_types.go, the allocators of the arena for the node types and slice element types below. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// nodes are the node types that are allocated by newT
+var nodes = []string{
+	"Var", "LiteralExpr", "DotExpr", "CallExpr", "BinaryExpr", "UnaryExpr", "IndexExpr",
+	"ExprStmt", "IfStmt", "ReturnStmt", "VarDecl", "BlockStmt",
+}
+
+// lists are the slice element types that are appended to by appendT, by the name used in the function name
+var lists = []struct {
+	Name, Type string
+}{
+	{"Stmt", "IStmt"},
+	{"Arg", "Arg"},
+	{"Var", "*Var"},
+	{"BindingElement", "BindingElement"},
+}
+
+var tmpl = template.Must(template.New("arena").Funcs(template.FuncMap{"lower": lower}).Parse(`package js
+
+// generated by arena_gen.go; DO NOT EDIT, except for adding more types to the lists in arena_gen.go and rerun go generate
+
+import "sync"
+
+// arenaChunks holds the chunks of each type that the arena allocates from, and the unused part of the current chunk.
+type arenaChunks struct {
+{{- range .Nodes}}
+	{{lower .}}s []{{.}}
+	{{lower .}}Chunks []*[arenaChunk]{{.}}
+{{- end}}
+{{- range .Lists}}
+	{{lower .Name}}List []{{.Type}}
+	{{lower .Name}}ListChunks []*[arenaSliceChunk]{{.Type}}
+{{- end}}
+}
+
+var (
+{{- range .Nodes}}
+	{{lower .}}Pool = sync.Pool{New: func() interface{} { return &[arenaChunk]{{.}}{} }}
+{{- end}}
+{{- range .Lists}}
+	{{lower .Name}}ListPool = sync.Pool{New: func() interface{} { return &[arenaSliceChunk]{{.Type}}{} }}
+{{- end}}
+)
+
+func (a *arenaChunks) release() {
+{{- range .Nodes}}
+	for _, chunk := range a.{{lower .}}Chunks {
+		*chunk = [arenaChunk]{{.}}{}
+		{{lower .}}Pool.Put(chunk)
+	}
+{{- end}}
+{{- range .Lists}}
+	for _, chunk := range a.{{lower .Name}}ListChunks {
+		*chunk = [arenaSliceChunk]{{.Type}}{}
+		{{lower .Name}}ListPool.Put(chunk)
+	}
+{{- end}}
+	*a = arenaChunks{}
+}
+{{range .Nodes}}
+func (a *arena) new{{.}}(n {{.}}) *{{.}} {
+	if a == nil {
+		node := new({{.}})
+		*node = n
+		return node
+	}
+	if len(a.{{lower .}}s) == cap(a.{{lower .}}s) {
+		chunk := {{lower .}}Pool.Get().(*[arenaChunk]{{.}})
+		a.{{lower .}}Chunks = append(a.{{lower .}}Chunks, chunk)
+		a.{{lower .}}s = chunk[:0]
+	}
+	a.{{lower .}}s = append(a.{{lower .}}s, n)
+	return &a.{{lower .}}s[len(a.{{lower .}}s)-1]
+}
+{{end}}
+{{- range .Lists}}
+func (a *arena) append{{.Name}}(list []{{.Type}}, item {{.Type}}) []{{.Type}} {
+	n := a.listCap(len(list), cap(list))
+	if n == 0 {
+		return append(list, item)
+	}
+	if cap(a.{{lower .Name}}List)-len(a.{{lower .Name}}List) < n {
+		chunk := {{lower .Name}}ListPool.Get().(*[arenaSliceChunk]{{.Type}})
+		a.{{lower .Name}}ListChunks = append(a.{{lower .Name}}ListChunks, chunk)
+		a.{{lower .Name}}List = chunk[:0]
+	}
+	i := len(a.{{lower .Name}}List)
+	a.{{lower .Name}}List = a.{{lower .Name}}List[:i+n]
+	return append(append(a.{{lower .Name}}List[i:i:i+n], list...), item)
+}
+{{end}}`))
+
+func lower(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func main() {
+	w := &bytes.Buffer{}
+	if err := tmpl.Execute(w, map[string]interface{}{"Nodes": nodes, "Lists": lists}); err != nil {
+		fatalf("%v", err)
+	}
+	b, err := format.Source(w.Bytes())
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if err := ioutil.WriteFile("arena_types.go", b, 0644); err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "arena_gen: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,94 @@
+package js
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+var arenaTests = []string{
+	"",
+	"a",
+	"var a = b.c(d, ...e), [f, {g}] = h; let i",
+	"function f(a, b = c) { if (a) return b; else { let d = a + b * c; return d } }",
+	"for (const k in o) for (let i = 0; i < k.length; i++) o[k][i] = -i",
+	"switch (a) { case 1: b(); break; default: c() } try { d } catch (e) { f(e) }",
+	"class A extends B { m(a) { return super.m(a?.b ?? `c${a}`) } }; new A(x => x, async () => await y)",
+	"label: while (a) { do continue label; while (b) }",
+	"({a, b: [c]} = d); (e, f) => e + f; (g, h)",
+}
+
+func TestParseArena(t *testing.T) {
+	for _, src := range arenaTests {
+		t.Run(src, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(src))
+			test.Error(t, err)
+			expected, expectedDump := ast.String(), Dump(ast)
+
+			// parse multiple times to reuse released memory
+			for i := 0; i < 3; i++ {
+				ast, err := ParseContext(context.Background(), parse.NewInputString(src), Options{Arena: true})
+				test.Error(t, err)
+				test.String(t, ast.String(), expected)
+				test.String(t, Dump(ast), expectedDump)
+				ast.Release()
+				test.T(t, len(ast.List), 0)
+			}
+		})
+	}
+}
+
+func TestParseArenaLarge(t *testing.T) {
+	// exceeds the chunk sizes of the arena
+	src := arenaSource(50)
+	ast, err := Parse(parse.NewInputString(src))
+	test.Error(t, err)
+	astArena, err := ParseContext(context.Background(), parse.NewInputString(src), Options{Arena: true})
+	test.Error(t, err)
+	test.String(t, astArena.String(), ast.String())
+
+	// appending to lists of the arena must not overwrite other lists
+	list := astArena.List[0].(*VarDecl).List
+	list = append(list, list[0])
+	test.String(t, astArena.String(), ast.String())
+	astArena.Release()
+	astArena.Release()
+}
+
+func arenaSource(n int) string {
+	sb := strings.Builder{}
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&sb, "var a%d = b.c(d%d, e[%d]), f%d = g;\n", i, i, i, i)
+		fmt.Fprintf(&sb, "function h%d(x, y) { if (x.length < y) { return x + y * %d } let z = [x, y]; return z.map(w => w.v) }\n", i, i)
+		fmt.Fprintf(&sb, "for (let j = 0; j < a%d.length; j++) { h%d(a%d[j], j); obj.prop%d = !j }\n", i, i, i, i)
+	}
+	return sb.String()
+}
+
+func BenchmarkParse(b *testing.B) {
+	src := []byte(arenaSource(100))
+	b.ReportAllocs()
+	b.SetBytes(int64(len(src)))
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse(parse.NewInputBytes(src)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseArena(b *testing.B) {
+	src := []byte(arenaSource(100))
+	b.ReportAllocs()
+	b.SetBytes(int64(len(src)))
+	for i := 0; i < b.N; i++ {
+		ast, err := ParseContext(context.Background(), parse.NewInputBytes(src), Options{Arena: true})
+		if err != nil {
+			b.Fatal(err)
+		}
+		ast.Release()
+	}
+}
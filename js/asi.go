@@ -0,0 +1,74 @@
+package js
+
+import "strconv"
+
+// ASIKind specifies the kind of automatic semicolon insertion.
+type ASIKind int
+
+// ASIKind values.
+const (
+	InsertedSemicolon ASIKind = iota // a semicolon was inserted before a line terminator, a closing brace, or the end of input
+	ASIHazard                        // no semicolon was inserted since the line starts with ( [ ` + - or / and continues the expression of the previous line
+)
+
+func (kind ASIKind) String() string {
+	switch kind {
+	case InsertedSemicolon:
+		return "InsertedSemicolon"
+	case ASIHazard:
+		return "ASIHazard"
+	}
+	return "Invalid(" + strconv.Itoa(int(kind)) + ")"
+}
+
+// ASI is a position where the parser relied on automatic semicolon insertion, see Options.ReportASI. Use parse.Position to convert the offset to a line and column.
+type ASI struct {
+	Kind   ASIKind
+	Offset int       // offset of the inserted semicolon, which is directly after the preceding token, or of the token that starts the line for hazards
+	Next   TokenType // token after the inserted semicolon or the token that starts the line, ErrorToken at the end of input
+}
+
+func (asi ASI) String() string {
+	return asi.Kind.String() + "(" + strconv.Itoa(asi.Offset) + " " + asi.Next.String() + ")"
+}
+
+// needsSemicolon returns true for statements that end with a semicolon.
+func needsSemicolon(stmt IStmt) bool {
+	switch stmt := stmt.(type) {
+	case *ExprStmt, *VarDecl, *DirectivePrologueStmt, *ReturnStmt, *ThrowStmt, *BranchStmt, *DebuggerStmt, *DoWhileStmt, *ImportStmt:
+		return true
+	case *ExportStmt:
+		switch stmt.Decl.(type) {
+		case *FuncDecl, *ClassDecl:
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// endStmt consumes the semicolon at the end of a statement or records its automatic insertion.
+func (p *Parser) endStmt(stmt IStmt) {
+	if p.tt == SemicolonToken {
+		p.next()
+	} else if needsSemicolon(stmt) {
+		p.insertSemicolon()
+	}
+}
+
+// insertSemicolon records the automatic insertion of a semicolon after the previous token, at the end of a statement or class field definition.
+func (p *Parser) insertSemicolon() {
+	if p.o.ReportASI && p.err == nil {
+		p.asi = append(p.asi, ASI{InsertedSemicolon, p.prevEnd, p.tt})
+	}
+}
+
+// checkASIHazard records a hazard when the current token starts a line but continues the expression of the previous line.
+func (p *Parser) checkASIHazard() {
+	if p.o.ReportASI && p.prevLT {
+		switch p.tt {
+		case OpenParenToken, OpenBracketToken, TemplateToken, TemplateStartToken, AddToken, SubToken, DivToken, DivEqToken:
+			p.asi = append(p.asi, ASI{ASIHazard, p.l.r.Offset() - len(p.data), p.tt})
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package js
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestASI(t *testing.T) {
+	var tests = []struct {
+		js       string
+		expected string
+	}{
+		{"a;b;", ""},
+		{"a\nb", "InsertedSemicolon(1 Identifier) InsertedSemicolon(3 Error)"},
+		{"a  \n", "InsertedSemicolon(1 Error)"},
+		{"{a}", "InsertedSemicolon(2 })"},
+		{"var a = 1\nlet b\nconst c = 2;", "InsertedSemicolon(9 let) InsertedSemicolon(15 const)"},
+		{"function f() { return\na }", "InsertedSemicolon(21 Identifier) InsertedSemicolon(23 })"},
+		{"x: for (;;) { break x\ncontinue }", "InsertedSemicolon(21 continue) InsertedSemicolon(30 })"},
+		{"throw a\ndebugger\ndo ; while (a) b", "InsertedSemicolon(7 debugger) InsertedSemicolon(16 do) InsertedSemicolon(31 Identifier) InsertedSemicolon(33 Error)"},
+		{"'use strict'\na", "InsertedSemicolon(12 Identifier) InsertedSemicolon(14 Error)"},
+		{"import a from 'b'\nexport {a}\nexport default function () {}\nexport default a", "InsertedSemicolon(17 export) InsertedSemicolon(28 export) InsertedSemicolon(75 Error)"},
+		{"if (a) {}\nfunction f() {}\nclass A {}\nfor (;;) {}", ""},
+		{"import(\"a\").then(f)\nb;", "InsertedSemicolon(19 Identifier)"},
+		{"import(\"a\")\nb", "InsertedSemicolon(11 Identifier) InsertedSemicolon(13 Error)"},
+		{"import(\"a\");", ""},
+		{"class A { x = 1\n y }", "InsertedSemicolon(15 Identifier) InsertedSemicolon(18 })"},
+		{"class A { x; y = 1; #z }", "InsertedSemicolon(22 })"},
+		{"a = b\n(c)", "ASIHazard(6 () InsertedSemicolon(9 Error)"},
+		{"a = b\n[c]", "ASIHazard(6 [) InsertedSemicolon(9 Error)"},
+		{"a = b\n`c`", "ASIHazard(6 Template) InsertedSemicolon(9 Error)"},
+		{"a\n+b\n-c", "ASIHazard(2 +) ASIHazard(5 -) InsertedSemicolon(7 Error)"},
+		{"a\n/b/g", "ASIHazard(2 /) InsertedSemicolon(6 Error)"},
+		{"a\n/= b", "ASIHazard(2 /=) InsertedSemicolon(6 Error)"},
+		{"a\n++b", "InsertedSemicolon(1 ++) InsertedSemicolon(5 Error)"},
+		{"a(\n(b), [c]\n)", "InsertedSemicolon(13 Error)"},
+		{"a;\n(b)", "InsertedSemicolon(6 Error)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := ParseContext(context.Background(), parse.NewInputString(tt.js), Options{ReportASI: true})
+			test.Error(t, err)
+
+			asi := []string{}
+			for _, item := range ast.ASI {
+				asi = append(asi, item.String())
+			}
+			test.String(t, strings.Join(asi, " "), tt.expected)
+		})
+	}
+
+	ast, err := Parse(parse.NewInputString("a\nb"))
+	test.Error(t, err)
+	test.T(t, len(ast.ASI), 0)
+}
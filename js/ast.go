@@ -10,6 +10,9 @@ import (
 type AST struct {
 	Comments  [][]byte // first comments in file
 	BlockStmt          // module
+	ASI       []ASI    // automatic semicolon insertions when Options.ReportASI is set
+
+	arena *arena
 }
 
 func (ast *AST) String() string {
@@ -61,8 +64,8 @@ func (decl DeclType) String() string {
 
 // Var is a variable, where Decl is the type of declaration and can be var|function for function scoped variables, let|const|class for block scoped variables.
 type Var struct {
-	Data []byte
-	Link *Var // is set when merging variable uses, as in:  {a} {var a}  where the first links to the second, only used for undeclared variables
+	Data []byte // spelling of the first occurrence, which may contain unicode escapes, see DecodeIdentifier
+	Link *Var   // is set when merging variable uses, as in:  {a} {var a}  where the first links to the second, only used for undeclared variables
 	Uses uint16
 	Decl DeclType
 }
@@ -75,6 +78,14 @@ func (v *Var) Name() []byte {
 	return v.Data
 }
 
+// equalDecoded returns true if name equals the identifier data with its unicode escapes decoded. As an escape is at least three bytes longer than the UTF-8 encoding of its code point, this is only called when data is at least three bytes longer than name.
+func equalDecoded(name, data []byte) bool {
+	if len(name) != 0 && data[0] != '\\' && data[0] != name[0] {
+		return false
+	}
+	return bytes.IndexByte(data, '\\') != -1 && bytes.Equal(name, DecodeIdentifier(data))
+}
+
 func (v Var) String() string {
 	return string(v.Name())
 }
@@ -130,14 +141,20 @@ type Scope struct {
 	NumArguments   uint16 // offset into Undeclared to mark variables used in arguments
 	IsGlobalOrFunc bool
 	HasWith        bool
+	IsStrict       bool // strict mode code, by a "use strict" directive or inherited from the parent scope, class, or module
 }
 
 func (s Scope) String() string {
 	return "Scope{Declared: " + s.Declared.String() + ", Undeclared: " + s.Undeclared.String() + "}"
 }
 
-// Declare declares a new variable.
+// Declare declares a new variable. Names are compared with their unicode escapes decoded, see DecodeIdentifier.
 func (s *Scope) Declare(decl DeclType, name []byte) (*Var, bool) {
+	return s.declare(nil, decl, name)
+}
+
+func (s *Scope) declare(a *arena, decl DeclType, data []byte) (*Var, bool) {
+	name := DecodeIdentifier(data)
 	// refer to new variable for previously undeclared symbols in the current and lower scopes
 	// this happens in `{ a = 5; } var a` where both a's refer to the same variable
 	curScope := s
@@ -163,7 +180,7 @@ func (s *Scope) Declare(decl DeclType, name []byte) (*Var, bool) {
 		}
 		v.Uses++
 		for s != curScope {
-			curScope.addUndeclared(v) // add variable declaration as used variable to the current scope
+			curScope.addUndeclared(a, v) // add variable declaration as used variable to the current scope
 			curScope = curScope.Parent
 		}
 		return v, true
@@ -174,7 +191,7 @@ func (s *Scope) Declare(decl DeclType, name []byte) (*Var, bool) {
 	if decl != ArgumentDecl { // in case of function f(a=b,b), where the first b is different from the second
 		for i, uv := range s.Undeclared[s.NumArguments:] {
 			// no need to evaluate v.Link as v.Data stays the same and Link is nil in the active scope
-			if 0 < uv.Uses && uv.Decl == NoDecl && bytes.Equal(name, uv.Data) {
+			if 0 < uv.Uses && uv.Decl == NoDecl && (bytes.Equal(name, uv.Data) || len(name)+2 < len(uv.Data) && equalDecoded(name, uv.Data)) {
 				// must be NoDecl so that it can't be a var declaration that has been added
 				v = uv
 				s.Undeclared = append(s.Undeclared[:int(s.NumArguments)+i], s.Undeclared[int(s.NumArguments)+i+1:]...)
@@ -184,21 +201,26 @@ func (s *Scope) Declare(decl DeclType, name []byte) (*Var, bool) {
 	}
 	if v == nil {
 		// add variable to the context list and to the scope
-		v = &Var{name, nil, 0, decl}
+		v = a.newVar(Var{data, nil, 0, decl})
 	} else {
 		v.Decl = decl
 	}
 	v.Uses++
-	s.Declared = append(s.Declared, v)
+	s.Declared = a.appendVar(s.Declared, v)
 	for s != curScope {
-		curScope.addUndeclared(v) // add variable declaration as used variable to the current scope
+		curScope.addUndeclared(a, v) // add variable declaration as used variable to the current scope
 		curScope = curScope.Parent
 	}
 	return v, true
 }
 
-// Use increments the usage of a variable.
+// Use increments the usage of a variable. Names are compared with their unicode escapes decoded, see DecodeIdentifier.
 func (s *Scope) Use(name []byte) *Var {
+	return s.use(nil, name)
+}
+
+func (s *Scope) use(a *arena, data []byte) *Var {
+	name := DecodeIdentifier(data)
 	// check if variable is declared in the current scope
 	v := s.findDeclared(name, false)
 	if v == nil {
@@ -206,8 +228,8 @@ func (s *Scope) Use(name []byte) *Var {
 		v = s.findUndeclared(name)
 		if v == nil {
 			// add variable to the context list and to the scope's undeclared
-			v = &Var{name, nil, 0, NoDecl}
-			s.Undeclared = append(s.Undeclared, v)
+			v = a.newVar(Var{data, nil, 0, NoDecl})
+			s.Undeclared = a.appendVar(s.Undeclared, v)
 		}
 	}
 	v.Uses++
@@ -225,7 +247,7 @@ func (s *Scope) findDeclared(name []byte, skipForInit bool) *Var {
 	for i := len(s.Declared) - 1; start <= i; i-- {
 		v := s.Declared[i]
 		// no need to evaluate v.Link as v.Data stays the same, and Link is always nil in Declared
-		if bytes.Equal(name, v.Data) {
+		if bytes.Equal(name, v.Data) || len(name)+2 < len(v.Data) && equalDecoded(name, v.Data) {
 			return v
 		}
 	}
@@ -236,7 +258,7 @@ func (s *Scope) findDeclared(name []byte, skipForInit bool) *Var {
 func (s *Scope) findUndeclared(name []byte) *Var {
 	for _, v := range s.Undeclared {
 		// no need to evaluate v.Link as v.Data stays the same and Link is nil in the active scope
-		if 0 < v.Uses && bytes.Equal(name, v.Data) {
+		if 0 < v.Uses && (bytes.Equal(name, v.Data) || len(name)+2 < len(v.Data) && equalDecoded(name, v.Data)) {
 			return v
 		}
 	}
@@ -244,14 +266,14 @@ func (s *Scope) findUndeclared(name []byte) *Var {
 }
 
 // add undeclared variable to scope, this is called for the block scope when declaring a var in it
-func (s *Scope) addUndeclared(v *Var) {
+func (s *Scope) addUndeclared(a *arena, v *Var) {
 	// don't add undeclared symbol if it's already there
 	for _, vorig := range s.Undeclared {
 		if v == vorig {
 			return
 		}
 	}
-	s.Undeclared = append(s.Undeclared, v) // add variable declaration as used variable to the current scope
+	s.Undeclared = a.appendVar(s.Undeclared, v) // add variable declaration as used variable to the current scope
 }
 
 // MarkForInit marks the declared variables in current scope as for statement initializer to distinguish from declarations in body.
@@ -266,22 +288,27 @@ func (s *Scope) MarkArguments() {
 
 // HoistUndeclared copies all undeclared variables of the current scope to the parent scope.
 func (s *Scope) HoistUndeclared() {
+	s.hoistUndeclared(nil)
+}
+
+func (s *Scope) hoistUndeclared(a *arena) {
 	for i, vorig := range s.Undeclared {
 		// no need to evaluate vorig.Link as vorig.Data stays the same
 		if 0 < vorig.Uses && vorig.Decl == NoDecl {
-			if v := s.Parent.findDeclared(vorig.Data, false); v != nil {
+			name := DecodeIdentifier(vorig.Data)
+			if v := s.Parent.findDeclared(name, false); v != nil {
 				// check if variable is declared in parent scope
 				v.Uses += vorig.Uses
 				vorig.Link = v
 				s.Undeclared[i] = v // point reference to existing var (to avoid many Link chains)
-			} else if v := s.Parent.findUndeclared(vorig.Data); v != nil {
+			} else if v := s.Parent.findUndeclared(name); v != nil {
 				// check if variable is already used before in parent scope
 				v.Uses += vorig.Uses
 				vorig.Link = v
 				s.Undeclared[i] = v // point reference to existing var (to avoid many Link chains)
 			} else {
 				// add variable to the context list and to the scope's undeclared
-				s.Parent.Undeclared = append(s.Parent.Undeclared, vorig)
+				s.Parent.Undeclared = a.appendVar(s.Parent.Undeclared, vorig)
 			}
 		}
 	}
@@ -294,11 +321,12 @@ func (s *Scope) UndeclareScope() {
 	for _, vorig := range s.Declared {
 		// no need to evaluate vorig.Link as vorig.Data stays the same, and Link is always nil in Declared
 		// vorig.Uses will be atleast 1
-		if v := s.Parent.findDeclared(vorig.Data, false); v != nil {
+		name := DecodeIdentifier(vorig.Data)
+		if v := s.Parent.findDeclared(name, false); v != nil {
 			// check if variable has been declared in this scope
 			v.Uses += vorig.Uses
 			vorig.Link = v
-		} else if v := s.Parent.findUndeclared(vorig.Data); v != nil {
+		} else if v := s.Parent.findUndeclared(name); v != nil {
 			// check if variable is already used before in the current or lower scopes
 			v.Uses += vorig.Uses
 			vorig.Link = v
@@ -1716,6 +1744,7 @@ func (n Args) JS() string {
 type NewExpr struct {
 	X    IExpr
 	Args *Args // can be nil
+	Pure bool  // preceded by a /*#__PURE__*/ or /*@__PURE__*/ annotation
 }
 
 func (n NewExpr) String() string {
@@ -1727,18 +1756,23 @@ func (n NewExpr) String() string {
 
 // JS converts the node back to valid JavaScript
 func (n NewExpr) JS() string {
+	s := ""
+	if n.Pure {
+		s += "/*#__PURE__*/ "
+	}
 	if n.Args != nil {
-		return "new " + n.X.JS() + "(" + n.Args.JS() + ")"
+		return s + "new " + n.X.JS() + "(" + n.Args.JS() + ")"
 	}
 
 	// always use parentheses to prevent errors when chaining e.g. new Date().getTime()
-	return "new " + n.X.JS() + "()"
+	return s + "new " + n.X.JS() + "()"
 }
 
 // CallExpr is a call expression.
 type CallExpr struct {
 	X    IExpr
 	Args Args
+	Pure bool // preceded by a /*#__PURE__*/ or /*@__PURE__*/ annotation
 }
 
 func (n CallExpr) String() string {
@@ -1747,6 +1781,9 @@ func (n CallExpr) String() string {
 
 // JS converts the node back to valid JavaScript
 func (n CallExpr) JS() string {
+	if n.Pure {
+		return "/*#__PURE__*/ " + n.X.JS() + "(" + n.Args.JS() + ")"
+	}
 	return n.X.JS() + "(" + n.Args.JS() + ")"
 }
 
@@ -0,0 +1,153 @@
+package js
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/tdewolff/parse/v2"
+)
+
+// Interner deduplicates identifier names across ASTs so that equal names share the same memory. Interned names are copies and no longer reference the input buffer. It is safe for concurrent use, where names are spread over shards with separate locks so that concurrent parsers rarely wait on each other.
+type Interner struct {
+	shards [internerShards]internerShard
+}
+
+const internerShards = 64
+
+type internerShard struct {
+	sync.Mutex
+	names map[string][]byte
+}
+
+// NewInterner returns a new, empty Interner.
+func NewInterner() *Interner {
+	in := &Interner{}
+	for i := range in.shards {
+		in.shards[i].names = map[string][]byte{}
+	}
+	return in
+}
+
+// shard returns the shard of a name using its FNV-1a hash.
+func (in *Interner) shard(b []byte) *internerShard {
+	h := uint32(2166136261)
+	for _, c := range b {
+		h = (h ^ uint32(c)) * 16777619
+	}
+	return &in.shards[h%internerShards]
+}
+
+// Intern returns the shared copy of b, which must not be modified.
+func (in *Interner) Intern(b []byte) []byte {
+	s := in.shard(b)
+	s.Lock()
+	defer s.Unlock()
+	if name, ok := s.names[string(b)]; ok {
+		return name
+	}
+	name := make([]byte, len(b))
+	copy(name, b)
+	s.names[string(name)] = name
+	return name
+}
+
+// Len returns the number of distinct names.
+func (in *Interner) Len() int {
+	n := 0
+	for i := range in.shards {
+		s := &in.shards[i]
+		s.Lock()
+		n += len(s.names)
+		s.Unlock()
+	}
+	return n
+}
+
+// InternAST replaces the names of all variables and identifier literals (such as property names) in ast by their interned copies. Only these names are detached from the input buffer, the AST still references it for other literals, such as strings, numbers, and comments.
+func (in *Interner) InternAST(ast *AST) {
+	Walk(&interner{in, map[string][]byte{}}, ast)
+}
+
+// interner interns the names of a single AST, where names that were seen before in the AST are found without locking.
+type interner struct {
+	*Interner
+	local map[string][]byte
+}
+
+func (in *interner) intern(b []byte) []byte {
+	if name, ok := in.local[string(b)]; ok {
+		return name
+	}
+	name := in.Intern(b)
+	in.local[string(name)] = name
+	return name
+}
+
+func (in *interner) scope(s *Scope) {
+	for _, v := range s.Declared {
+		v.Data = in.intern(v.Data)
+	}
+	for _, v := range s.Undeclared {
+		v.Data = in.intern(v.Data)
+	}
+}
+
+func (in *interner) Enter(n INode) IVisitor {
+	switch n := n.(type) {
+	case *Var:
+		n.Data = in.intern(n.Data)
+	case *LiteralExpr:
+		if n.TokenType == IdentifierToken || n.TokenType == PrivateIdentifierToken {
+			n.Data = in.intern(n.Data)
+		}
+	case *BlockStmt:
+		in.scope(&n.Scope)
+	case *SwitchStmt:
+		in.scope(&n.Scope)
+	}
+	return in
+}
+
+func (in *interner) Exit(n INode) {}
+
+// BatchOptions are the options for ParseAll.
+type BatchOptions struct {
+	Options            // parser options for every input
+	Workers  int       // maximum number of concurrent parsers, defaults to runtime.GOMAXPROCS(0)
+	Interner *Interner // interns identifier names across all ASTs that parsed without error when not nil
+}
+
+// ParseAll parses the inputs concurrently and returns the ASTs and errors in the order of the inputs, where the AST of a failed input is incomplete as for ParseContext and is not interned. Once ctx is cancelled, the remaining inputs fail with a LimitError.
+func ParseAll(ctx context.Context, inputs []*parse.Input, o BatchOptions) ([]*AST, []error) {
+	workers := o.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if len(inputs) < workers {
+		workers = len(inputs)
+	}
+
+	asts := make([]*AST, len(inputs))
+	errs := make([]error, len(inputs))
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for j := 0; j < workers; j++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				asts[i], errs[i] = ParseContext(ctx, inputs[i], o.Options)
+				if errs[i] == nil && o.Interner != nil {
+					o.Interner.InternAST(asts[i])
+				}
+			}
+		}()
+	}
+	for i := range inputs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return asts, errs
+}
@@ -0,0 +1,91 @@
+package js
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestParseAll(t *testing.T) {
+	srcs := []string{"var a = b.c", "a(", "let a; a.c", "b = {c: 1}", "x"}
+	for _, workers := range []int{0, 1, 2, 10} {
+		inputs := []*parse.Input{}
+		for _, src := range srcs {
+			inputs = append(inputs, parse.NewInputString(src))
+		}
+		asts, errs := ParseAll(context.Background(), inputs, BatchOptions{Workers: workers})
+		test.T(t, len(asts), len(srcs))
+		test.T(t, len(errs), len(srcs))
+		for i, src := range srcs {
+			if i == 1 {
+				test.That(t, errs[i] != nil, "must fail:", src)
+				continue
+			}
+			test.Error(t, errs[i])
+			test.String(t, asts[i].String(), parseString(t, src))
+		}
+	}
+}
+
+func parseString(t *testing.T, src string) string {
+	ast, err := Parse(parse.NewInputString(src))
+	test.Error(t, err)
+	return ast.String()
+}
+
+func TestParseAllInterner(t *testing.T) {
+	srcs := []string{"var a = b.c", "let a; a.c", "b = {c: 1}"}
+	inputs := []*parse.Input{}
+	for _, src := range srcs {
+		inputs = append(inputs, parse.NewInputString(src))
+	}
+	interner := NewInterner()
+	asts, errs := ParseAll(context.Background(), inputs, BatchOptions{Interner: interner})
+	for _, err := range errs {
+		test.Error(t, err)
+	}
+	test.T(t, interner.Len(), 3) // a b c
+
+	a0 := asts[0].BlockStmt.Scope.Declared[0].Data
+	a1 := asts[1].BlockStmt.Scope.Declared[0].Data
+	test.String(t, string(a0), "a")
+	test.That(t, &a0[0] == &a1[0], "names must be shared")
+	c0 := asts[0].List[0].(*VarDecl).List[0].Default.(*DotExpr).Y.Data
+	c1 := asts[1].List[1].(*ExprStmt).Value.(*DotExpr).Y.Data
+	test.That(t, &c0[0] == &c1[0], "property names must be shared")
+}
+
+func TestInternerConcurrent(t *testing.T) {
+	interner := NewInterner()
+	names := make([][][]byte, 4)
+	wg := sync.WaitGroup{}
+	for i := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				names[i] = append(names[i], interner.Intern([]byte(fmt.Sprintf("v%d", j))))
+			}
+		}(i)
+	}
+	wg.Wait()
+	test.T(t, interner.Len(), 100)
+	for j := 0; j < 100; j++ {
+		test.That(t, &names[0][j][0] == &names[3][j][0], "names must be shared")
+	}
+}
+
+func TestParseAllCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, errs := ParseAll(ctx, []*parse.Input{parse.NewInputString("a"), parse.NewInputString("b")}, BatchOptions{})
+	for _, err := range errs {
+		limitErr, ok := err.(*LimitError)
+		test.That(t, ok, "must be LimitError")
+		test.T(t, limitErr.Limit, ContextLimit)
+	}
+}
@@ -0,0 +1,435 @@
+// Package build contains constructors for JavaScript AST nodes of the js package. The constructors set the token types and add parentheses by means of GroupExpr where operator precedence requires them, so that the JS output of the built nodes is valid JavaScript.
+// Scopes of blocks and functions are linked to their enclosing scopes as the tree is built, but variables are not resolved: each Ident returns a new undeclared variable, so the same *js.Var must be passed to refer to the same variable.
+package build
+
+import (
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/tdewolff/parse/v2/js"
+)
+
+// Module returns an AST with the statements in the global scope.
+func Module(stmts ...js.IStmt) *js.AST {
+	ast := &js.AST{}
+	ast.List = stmts
+	ast.Scope.Func = &ast.Scope
+	ast.Scope.IsGlobalOrFunc = true
+	for _, stmt := range stmts {
+		adopt(&ast.Scope, stmt)
+	}
+	return ast
+}
+
+// Ident returns a new undeclared variable with the given name.
+func Ident(name string) *js.Var {
+	return &js.Var{Data: []byte(name)}
+}
+
+// Num returns a numeric literal. Negative numbers are returned as unary expressions, and NaN and infinities as the global variables NaN and Infinity.
+func Num(f float64) js.IExpr {
+	if math.IsNaN(f) {
+		return Ident("NaN")
+	} else if f < 0 || f == 0 && math.Signbit(f) {
+		return &js.UnaryExpr{Op: js.NegToken, X: Num(-f)}
+	} else if math.IsInf(f, 1) {
+		return Ident("Infinity")
+	}
+	return literal(js.DecimalToken, strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+// Str returns a double-quoted string literal.
+func Str(s string) *js.LiteralExpr {
+	return &js.LiteralExpr{TokenType: js.StringToken, Data: quote(s)}
+}
+
+// Bool returns the true or false literal.
+func Bool(b bool) *js.LiteralExpr {
+	if b {
+		return literal(js.TrueToken, "true")
+	}
+	return literal(js.FalseToken, "false")
+}
+
+// Null returns the null literal.
+func Null() *js.LiteralExpr {
+	return literal(js.NullToken, "null")
+}
+
+// This returns the this keyword.
+func This() *js.LiteralExpr {
+	return literal(js.ThisToken, "this")
+}
+
+// Undefined returns void 0.
+func Undefined() *js.UnaryExpr {
+	return &js.UnaryExpr{Op: js.VoidToken, X: literal(js.DecimalToken, "0")}
+}
+
+// Array returns an array literal, nil values are elisions.
+func Array(values ...js.IExpr) *js.ArrayExpr {
+	array := &js.ArrayExpr{List: make([]js.Element, len(values))}
+	for i, value := range values {
+		array.List[i].Value = group(value, js.OpAssign)
+	}
+	return array
+}
+
+// Prop returns an object property with the given key, which is an identifier, a number, or otherwise a string literal.
+func Prop(key string, value js.IExpr) js.Property {
+	name := &js.PropertyName{Literal: *literal(js.IdentifierToken, key)}
+	if !js.AsIdentifierName([]byte(key)) {
+		if js.AsDecimalLiteral([]byte(key)) {
+			name.Literal.TokenType = js.DecimalToken
+		} else {
+			name.Literal = *Str(key)
+		}
+	}
+	return js.Property{Name: name, Value: group(value, js.OpAssign)}
+}
+
+// Object returns an object literal.
+func Object(props ...js.Property) *js.ObjectExpr {
+	return &js.ObjectExpr{List: props}
+}
+
+// Member returns a member expression x.name, or x["name"] if name is not an identifier.
+func Member(x js.IExpr, name string) js.IExpr {
+	if !js.AsIdentifierName([]byte(name)) {
+		return Index(x, Str(name))
+	}
+	x = object(x)
+	return &js.DotExpr{X: x, Y: *literal(js.IdentifierToken, name), Prec: memberPrec(x)}
+}
+
+// Index returns a member expression x[y].
+func Index(x, y js.IExpr) *js.IndexExpr {
+	x = object(x)
+	return &js.IndexExpr{X: x, Y: y, Prec: memberPrec(x)}
+}
+
+// Call returns a call expression.
+func Call(x js.IExpr, args ...js.IExpr) *js.CallExpr {
+	return &js.CallExpr{X: group(x, js.OpCall), Args: arguments(args)}
+}
+
+// New returns a new expression, which is always printed with arguments.
+func New(x js.IExpr, args ...js.IExpr) *js.NewExpr {
+	list := arguments(args)
+	return &js.NewExpr{X: group(x, js.OpMember), Args: &list}
+}
+
+// Unary returns a unary expression, op must be a prefix or postfix operator such as js.NotToken, js.TypeofToken, or js.PostIncrToken.
+func Unary(op js.TokenType, x js.IExpr) *js.UnaryExpr {
+	if op == js.PostIncrToken || op == js.PostDecrToken {
+		return &js.UnaryExpr{Op: op, X: group(x, js.OpLHS)}
+	}
+	x = group(x, js.OpUnary)
+	if unary, ok := x.(*js.UnaryExpr); ok && (op == js.PosToken || op == js.NegToken || op == js.PreIncrToken || op == js.PreDecrToken) {
+		// prevent - -a from being printed as --a
+		if unary.Op == js.PosToken || unary.Op == js.NegToken || unary.Op == js.PreIncrToken || unary.Op == js.PreDecrToken {
+			x = &js.GroupExpr{X: x}
+		}
+	}
+	return &js.UnaryExpr{Op: op, X: x}
+}
+
+// Binary returns a binary expression, op must be a binary operator such as js.AddToken or an assignment operator such as js.AddEqToken.
+func Binary(op js.TokenType, x, y js.IExpr) *js.BinaryExpr {
+	prec := js.BinaryPrec(op)
+	if prec == js.OpAssign {
+		return &js.BinaryExpr{Op: op, X: group(x, js.OpLHS), Y: group(y, js.OpAssign)}
+	} else if op == js.ExpToken {
+		// right-associative and the left operand cannot be a unary expression
+		return &js.BinaryExpr{Op: op, X: group(x, js.OpUpdate), Y: group(y, js.OpExp)}
+	}
+	x, y = group(x, prec), group(y, prec+1)
+	if op == js.NullishToken || op == js.OrToken || op == js.AndToken {
+		// ?? cannot be mixed with || or && without parentheses
+		x, y = groupNullish(op, x), groupNullish(op, y)
+	}
+	return &js.BinaryExpr{Op: op, X: x, Y: y}
+}
+
+// Assign returns an assignment expression x = y.
+func Assign(x, y js.IExpr) *js.BinaryExpr {
+	return Binary(js.EqToken, x, y)
+}
+
+// Cond returns a conditional expression cond ? x : y.
+func Cond(cond, x, y js.IExpr) *js.CondExpr {
+	return &js.CondExpr{Cond: group(cond, js.OpCoalesce), X: group(x, js.OpAssign), Y: group(y, js.OpAssign)}
+}
+
+// Seq returns a comma-separated sequence of expressions.
+func Seq(xs ...js.IExpr) js.IExpr {
+	if len(xs) == 0 {
+		return Undefined()
+	}
+	expr := group(xs[0], js.OpAssign)
+	for _, x := range xs[1:] {
+		expr = &js.BinaryExpr{Op: js.CommaToken, X: expr, Y: group(x, js.OpAssign)}
+	}
+	return expr
+}
+
+// Arrow returns an arrow function with the parameters and the statements as body.
+func Arrow(params []*js.Var, body ...js.IStmt) *js.ArrowFunc {
+	arrow := &js.ArrowFunc{Params: parameters(params)}
+	function(&arrow.Body, params, body)
+	return arrow
+}
+
+// Func returns a function declaration or expression, name can be nil.
+func Func(name *js.Var, params []*js.Var, body ...js.IStmt) *js.FuncDecl {
+	if name != nil && name.Decl == js.NoDecl {
+		name.Decl = js.FunctionDecl
+	}
+	fun := &js.FuncDecl{Name: name, Params: parameters(params)}
+	function(&fun.Body, params, body)
+	return fun
+}
+
+////////////////////////////////////////////////////////////////
+
+// Expr returns an expression statement, which is parenthesized if it would otherwise start with a function, class, or object literal.
+func Expr(x js.IExpr) *js.ExprStmt {
+	if startsWithDecl(x) {
+		x = &js.GroupExpr{X: x}
+	}
+	return &js.ExprStmt{Value: x}
+}
+
+// Block returns a block statement.
+func Block(stmts ...js.IStmt) *js.BlockStmt {
+	block := &js.BlockStmt{List: stmts}
+	detach(&block.Scope, false)
+	for _, stmt := range stmts {
+		adopt(&block.Scope, stmt)
+	}
+	return block
+}
+
+// If returns an if statement, els can be nil.
+func If(cond js.IExpr, body, els js.IStmt) *js.IfStmt {
+	return &js.IfStmt{Cond: cond, Body: body, Else: els}
+}
+
+// Return returns a return statement, x can be nil.
+func Return(x js.IExpr) *js.ReturnStmt {
+	return &js.ReturnStmt{Value: x}
+}
+
+// Throw returns a throw statement.
+func Throw(x js.IExpr) *js.ThrowStmt {
+	return &js.ThrowStmt{Value: x}
+}
+
+// Var returns a var declaration, init can be nil.
+func Var(name *js.Var, init js.IExpr) *js.VarDecl {
+	return declaration(js.VarToken, js.VariableDecl, name, init)
+}
+
+// Let returns a let declaration, init can be nil.
+func Let(name *js.Var, init js.IExpr) *js.VarDecl {
+	return declaration(js.LetToken, js.LexicalDecl, name, init)
+}
+
+// Const returns a const declaration.
+func Const(name *js.Var, init js.IExpr) *js.VarDecl {
+	return declaration(js.ConstToken, js.LexicalDecl, name, init)
+}
+
+////////////////////////////////////////////////////////////////
+
+func declaration(tt js.TokenType, decl js.DeclType, name *js.Var, init js.IExpr) *js.VarDecl {
+	if name.Decl == js.NoDecl {
+		name.Decl = decl
+	}
+	if init != nil {
+		init = group(init, js.OpAssign)
+	}
+	return &js.VarDecl{TokenType: tt, List: []js.BindingElement{{Binding: name, Default: init}}}
+}
+
+func parameters(params []*js.Var) js.Params {
+	list := make([]js.BindingElement, len(params))
+	for i, param := range params {
+		list[i].Binding = param
+	}
+	return js.Params{List: list}
+}
+
+func arguments(args []js.IExpr) js.Args {
+	list := make([]js.Arg, len(args))
+	for i, arg := range args {
+		list[i].Value = group(arg, js.OpAssign)
+	}
+	return js.Args{List: list}
+}
+
+// function sets up the body of a function with its parameters declared in its scope.
+func function(body *js.BlockStmt, params []*js.Var, stmts []js.IStmt) {
+	body.List = stmts
+	detach(&body.Scope, true)
+	for _, param := range params {
+		param.Decl = js.ArgumentDecl
+		body.Scope.Declared = append(body.Scope.Declared, param)
+	}
+	for _, stmt := range stmts {
+		adopt(&body.Scope, stmt)
+	}
+}
+
+// detach initializes a scope with its own global scope as parent, until it is adopted by an enclosing scope. Blocks are only printed with braces if they have a parent scope.
+func detach(scope *js.Scope, isFunc bool) {
+	global := &js.Scope{IsGlobalOrFunc: true}
+	global.Func = global
+	*scope = js.Scope{Parent: global, Func: global, IsGlobalOrFunc: isFunc}
+	if isFunc {
+		scope.Func = scope
+	}
+}
+
+// adopt links the outermost scopes within a node to the given scope.
+func adopt(scope *js.Scope, n js.INode) {
+	js.Walk(&linker{scope, scope.Func}, n)
+}
+
+type linker struct {
+	parent *js.Scope // nil for nested scopes whose parent is already set
+	fun    *js.Scope
+}
+
+func (l *linker) Enter(n js.INode) js.IVisitor {
+	var scope *js.Scope
+	isFunc := false
+	switch n := n.(type) {
+	case *js.BlockStmt:
+		scope = &n.Scope
+	case *js.SwitchStmt:
+		scope = &n.Scope
+	case *js.FuncDecl:
+		scope, isFunc = &n.Body.Scope, true
+	case *js.MethodDecl:
+		scope, isFunc = &n.Body.Scope, true
+	case *js.ArrowFunc:
+		scope, isFunc = &n.Body.Scope, true
+	default:
+		return l
+	}
+	if l.parent != nil {
+		scope.Parent = l.parent
+	}
+	if isFunc {
+		return nil
+	}
+	// the function scope of nested blocks changes along
+	scope.Func = l.fun
+	return &linker{nil, l.fun}
+}
+
+func (l *linker) Exit(n js.INode) {}
+
+func literal(tt js.TokenType, data string) *js.LiteralExpr {
+	return &js.LiteralExpr{TokenType: tt, Data: []byte(data)}
+}
+
+// group parenthesizes the expression if its precedence is lower than prec.
+func group(x js.IExpr, prec js.OpPrec) js.IExpr {
+	if js.ExprPrec(x) < prec {
+		return &js.GroupExpr{X: x}
+	}
+	return x
+}
+
+func groupNullish(op js.TokenType, x js.IExpr) js.IExpr {
+	if binary, ok := x.(*js.BinaryExpr); ok && binary.Op != op && (binary.Op == js.NullishToken || op == js.NullishToken) && (binary.Op == js.NullishToken || binary.Op == js.OrToken || binary.Op == js.AndToken) {
+		return &js.GroupExpr{X: x}
+	}
+	return x
+}
+
+// object parenthesizes the object of a member expression if needed, including number literals as in (1).toString().
+func object(x js.IExpr) js.IExpr {
+	if literal, ok := x.(*js.LiteralExpr); ok && literal.TokenType == js.DecimalToken {
+		return &js.GroupExpr{X: x}
+	}
+	return group(x, js.OpCall)
+}
+
+func memberPrec(x js.IExpr) js.OpPrec {
+	if js.ExprPrec(x) < js.OpMember {
+		return js.OpCall
+	}
+	return js.OpMember
+}
+
+// startsWithDecl returns true if the leftmost token of the expression starts a function, class, or block.
+func startsWithDecl(x js.IExpr) bool {
+	for {
+		switch n := x.(type) {
+		case *js.FuncDecl, *js.ClassDecl, *js.ObjectExpr:
+			return true
+		case *js.BinaryExpr:
+			x = n.X
+		case *js.CondExpr:
+			x = n.Cond
+		case *js.CallExpr:
+			x = n.X
+		case *js.DotExpr:
+			x = n.X
+		case *js.IndexExpr:
+			x = n.X
+		case *js.OptChainExpr:
+			x = n.X
+		case *js.TemplateExpr:
+			if n.Tag == nil {
+				return false
+			}
+			x = n.Tag
+		case *js.UnaryExpr:
+			if n.Op != js.PostIncrToken && n.Op != js.PostDecrToken {
+				return false
+			}
+			x = n.X
+		default:
+			return false
+		}
+	}
+}
+
+// quote returns a double-quoted JavaScript string literal.
+func quote(s string) []byte {
+	b := make([]byte, 0, len(s)+2)
+	b = append(b, '"')
+	for i := 0; i < len(s); {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		switch r {
+		case '"', '\\':
+			b = append(b, '\\', byte(r))
+		case '\n':
+			b = append(b, '\\', 'n')
+		case '\r':
+			b = append(b, '\\', 'r')
+		case '\t':
+			b = append(b, '\\', 't')
+		case '\u2028', '\u2029':
+			// line terminators are not allowed in string literals before ES2019
+			b = append(b, `\u202`...)
+			b = append(b, byte('0'+r-'\u2020'))
+		default:
+			if r == utf8.RuneError && n == 1 {
+				b = append(b, `\uFFFD`...)
+			} else if r < 0x20 || r == 0x7F {
+				b = append(b, `\x`...)
+				b = append(b, "0123456789ABCDEF"[r>>4], "0123456789ABCDEF"[r&15])
+			} else {
+				b = append(b, s[i:i+n]...)
+			}
+		}
+		i += n
+	}
+	return append(b, '"')
+}
@@ -0,0 +1,96 @@
+package build
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/parse/v2/js"
+	"github.com/tdewolff/test"
+)
+
+func TestBuild(t *testing.T) {
+	a, b, c := Ident("a"), Ident("b"), Ident("c")
+	var tests = []struct {
+		node     js.INode
+		expected string
+	}{
+		{Num(5), "5"},
+		{Num(-0.5), "-0.5"},
+		{Num(1e21), "1e+21"},
+		{Num(math.NaN()), "NaN"},
+		{Num(math.Inf(-1)), "-Infinity"},
+		{Str("a\"b\\c\n\u2028\x00é"), `"a\"b\\c\n\u2028\x00é"`},
+		{Str("\xff"), `"\uFFFD"`},
+		{Bool(true), "true"},
+		{Null(), "null"},
+		{Undefined(), "void 0"},
+		{Array(Num(1), nil, Seq(a, b)), "[1, , (a , b)]"},
+		{Object(Prop("a", a), Prop("b-c", Num(1)), Prop("1", b), Prop("d", Seq(a, b))), `{a, "b-c": 1, 1: b, d: (a , b)}`},
+		{Member(a, "b"), "a.b"},
+		{Member(a, "b-c"), `a["b-c"]`},
+		{Member(Num(1), "toString"), "(1).toString"},
+		{Member(Binary(js.AddToken, a, b), "c"), "(a + b).c"},
+		{Member(Call(a), "b"), "a().b"},
+		{Index(a, Seq(b, c)), "a[b , c]"},
+		{Call(Member(a, "b"), b, Seq(b, c)), "a.b(b, (b , c))"},
+		{Call(Func(nil, nil)), "function () { }()"},
+		{New(a), "new a()"},
+		{New(Call(a)), "new (a())()"},
+		{New(Member(Call(a), "b")), "new (a().b)()"},
+		{Unary(js.NotToken, Binary(js.AndToken, a, b)), "!(a && b)"},
+		{Unary(js.NegToken, Unary(js.NegToken, a)), "-(-a)"},
+		{Unary(js.NegToken, Num(-1)), "-(-1)"},
+		{Unary(js.TypeofToken, a), "typeof a"},
+		{Unary(js.PostIncrToken, Member(a, "b")), "a.b++"},
+		{Binary(js.SubToken, a, Binary(js.SubToken, b, c)), "a - (b - c)"},
+		{Binary(js.SubToken, Binary(js.SubToken, a, b), c), "a - b - c"},
+		{Binary(js.MulToken, Binary(js.AddToken, a, b), c), "(a + b) * c"},
+		{Binary(js.ExpToken, Unary(js.NegToken, a), Binary(js.ExpToken, b, c)), "(-a) ** b ** c"},
+		{Binary(js.ExpToken, Binary(js.ExpToken, a, b), c), "(a ** b) ** c"},
+		{Binary(js.NullishToken, Binary(js.OrToken, a, b), c), "(a || b) ?? c"},
+		{Binary(js.AndToken, Binary(js.NullishToken, a, b), c), "(a ?? b) && c"},
+		{Assign(a, Assign(b, c)), "a = b = c"},
+		{Assign(Member(a, "b"), Seq(b, c)), "a.b = (b , c)"},
+		{Binary(js.AddEqToken, a, Cond(b, c, a)), "a += b ? c : a"},
+		{Cond(Assign(a, b), Seq(a, b), c), "(a = b) ? (a , b) : c"},
+		{Cond(Cond(a, b, c), a, Cond(a, b, c)), "(a ? b : c) ? a : a ? b : c"},
+		{Seq(a, Seq(b, c)), "a , (b , c)"},
+		{Arrow([]*js.Var{a}, Return(Object())), "(a) => { return {}; }"},
+		{Expr(Call(Func(nil, nil))), "(function () { }())"},
+		{Expr(Member(Object(), "a")), "({}.a)"},
+		{Expr(Assign(a, Object())), "a = {}"},
+		{If(a, Return(b), If(b, Block(Expr(a)), nil)), "if (a) { return b } else { if (b) { a; } }"},
+		{Func(a, []*js.Var{b, c}, Var(Ident("d"), Seq(b, c)), Return(nil)), "function a (b, c) { var d = (b , c); return; }"},
+		{Block(Let(a, Num(1)), Const(b, a), Throw(New(Ident("Error"), Str("e")))), `{ let a = 1; const b = a; throw new Error("e"); }`},
+		{Module(Expr(Call(This())), Block()), "this(); { }; "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			test.String(t, tt.node.JS(), tt.expected)
+
+			// output must be valid JavaScript
+			src := tt.node.JS()
+			if _, ok := tt.node.(js.IExpr); ok {
+				src = "x = " + src
+			}
+			_, err := js.Parse(parse.NewInputString(src))
+			test.Error(t, err)
+		})
+	}
+}
+
+func TestBuildScopes(t *testing.T) {
+	a := Ident("a")
+	inner := Block(Expr(a))
+	f := Func(Ident("f"), []*js.Var{a}, If(a, inner, nil))
+	ast := Module(f)
+
+	test.That(t, f.Body.Scope.Parent == &ast.Scope, "function scope must be in the global scope")
+	test.That(t, f.Body.Scope.Func == &f.Body.Scope, "function scope must be its own function scope")
+	test.That(t, inner.Scope.Parent == &f.Body.Scope, "block scope must be in the function scope")
+	test.That(t, inner.Scope.Func == &f.Body.Scope, "block must be in the function")
+	test.T(t, a.Decl, js.ArgumentDecl)
+	test.T(t, f.Name.Decl, js.FunctionDecl)
+	test.T(t, len(f.Body.Scope.Declared), 1)
+}
@@ -0,0 +1,838 @@
+package js
+
+import "bytes"
+
+// Clone returns a deep copy of the node and all its children, so that the copy can be transformed without affecting the original. Variables declared in the scopes of the node are copied once and consistently remapped for all their uses and links, and the Parent and Func pointers of cloned scopes point to the cloned scopes. Variables and scopes outside of the node remain shared with the original. If detach is set, all byte slices are copied as well so that the copy no longer references the input buffer. The names of the shared variables are replaced by copies in place, which the original then uses as well, so the original must not be used concurrently.
+func Clone(n INode, detach bool) INode {
+	c := &cloner{
+		detach: detach,
+		owned:  map[*Var]bool{},
+		vars:   map[*Var]*Var{},
+		scopes: map[*Scope]*Scope{},
+	}
+	Walk(c, n)
+	return c.node(n)
+}
+
+type cloner struct {
+	detach bool
+	owned  map[*Var]bool // variables declared within the cloned node
+	vars   map[*Var]*Var
+	scopes map[*Scope]*Scope
+}
+
+// Enter collects the variables that are declared within the cloned node before cloning, since uses may precede declarations.
+func (c *cloner) Enter(n INode) IVisitor {
+	var s *Scope
+	switch n := n.(type) {
+	case *BlockStmt:
+		s = &n.Scope
+	case *SwitchStmt:
+		s = &n.Scope
+	default:
+		return c
+	}
+	for _, v := range s.Declared {
+		c.owned[v] = true
+	}
+	if s.Parent == nil {
+		// undeclared variables of the global scope are owned by the module
+		for _, v := range s.Undeclared {
+			c.owned[v] = true
+		}
+	}
+	return c
+}
+
+func (c *cloner) Exit(n INode) {}
+
+func (c *cloner) bytes(b []byte) []byte {
+	if !c.detach || b == nil {
+		return b
+	}
+	return append([]byte{}, b...)
+}
+
+func (c *cloner) variable(v *Var) *Var {
+	if v == nil {
+		return nil
+	} else if w, ok := c.vars[v]; ok {
+		return w
+	}
+
+	w := v
+	if c.owned[v] {
+		w = &Var{c.bytes(v.Data), nil, v.Uses, v.Decl}
+		c.vars[v] = w
+		w.Link = c.variable(v.Link)
+	} else if v.Link != nil {
+		// use of a variable that is declared within the cloned node
+		if link := c.variable(v.Link); link != v.Link {
+			w = &Var{c.bytes(v.Data), link, v.Uses, v.Decl}
+		}
+	}
+	if w == v && c.detach {
+		// variable declared outside of the cloned node is shared, copy its name so that it no longer references the input buffer
+		v.Data = c.bytes(v.Data)
+	}
+	c.vars[v] = w
+	return w
+}
+
+func (c *cloner) varArray(vs VarArray) VarArray {
+	if vs == nil {
+		return nil
+	}
+	ws := make(VarArray, len(vs))
+	for i, v := range vs {
+		ws[i] = c.variable(v)
+	}
+	return ws
+}
+
+// scope clones the src scope into dst, which must be at its final address so that child scopes can refer to it.
+func (c *cloner) scope(dst, src *Scope) {
+	c.scopes[src] = dst
+	*dst = *src
+	if parent, ok := c.scopes[src.Parent]; ok {
+		dst.Parent = parent
+	}
+	if fun, ok := c.scopes[src.Func]; ok {
+		dst.Func = fun
+	}
+	dst.Declared = c.varArray(src.Declared)
+	dst.Undeclared = c.varArray(src.Undeclared)
+}
+
+func (c *cloner) block(dst, src *BlockStmt) {
+	c.scope(&dst.Scope, &src.Scope)
+	dst.List = c.stmts(src.List)
+}
+
+func (c *cloner) blockPtr(src *BlockStmt) *BlockStmt {
+	if src == nil {
+		return nil
+	}
+	dst := &BlockStmt{}
+	c.block(dst, src)
+	return dst
+}
+
+func (c *cloner) stmt(stmt IStmt) IStmt {
+	if stmt == nil {
+		return nil
+	}
+	return c.node(stmt).(IStmt)
+}
+
+func (c *cloner) stmts(list []IStmt) []IStmt {
+	if list == nil {
+		return nil
+	}
+	stmts := make([]IStmt, len(list))
+	for i, stmt := range list {
+		stmts[i] = c.stmt(stmt)
+	}
+	return stmts
+}
+
+func (c *cloner) expr(expr IExpr) IExpr {
+	if expr == nil {
+		return nil
+	}
+	return c.node(expr).(IExpr)
+}
+
+func (c *cloner) binding(binding IBinding) IBinding {
+	if binding == nil {
+		return nil
+	}
+	return c.node(binding).(IBinding)
+}
+
+func (c *cloner) literal(n LiteralExpr) LiteralExpr {
+	return LiteralExpr{n.TokenType, c.bytes(n.Data)}
+}
+
+func (c *cloner) propertyName(n PropertyName) PropertyName {
+	return PropertyName{c.literal(n.Literal), c.expr(n.Computed)}
+}
+
+func (c *cloner) propertyNamePtr(n *PropertyName) *PropertyName {
+	if n == nil {
+		return nil
+	}
+	name := c.propertyName(*n)
+	return &name
+}
+
+func (c *cloner) bindingElement(n BindingElement) BindingElement {
+	return BindingElement{c.binding(n.Binding), c.expr(n.Default)}
+}
+
+func (c *cloner) bindingElements(list []BindingElement) []BindingElement {
+	if list == nil {
+		return nil
+	}
+	elements := make([]BindingElement, len(list))
+	for i, element := range list {
+		elements[i] = c.bindingElement(element)
+	}
+	return elements
+}
+
+func (c *cloner) params(n Params) Params {
+	return Params{c.bindingElements(n.List), c.binding(n.Rest)}
+}
+
+func (c *cloner) args(n Args) Args {
+	if n.List == nil {
+		return Args{}
+	}
+	args := Args{make([]Arg, len(n.List))}
+	for i, arg := range n.List {
+		args.List[i] = Arg{c.expr(arg.Value), arg.Rest}
+	}
+	return args
+}
+
+func (c *cloner) aliases(list []Alias) []Alias {
+	if list == nil {
+		return nil
+	}
+	aliases := make([]Alias, len(list))
+	for i, alias := range list {
+		aliases[i] = Alias{c.bytes(alias.Name), c.bytes(alias.Binding)}
+	}
+	return aliases
+}
+
+func (c *cloner) caseClause(n CaseClause) CaseClause {
+	return CaseClause{n.TokenType, c.expr(n.Cond), c.stmts(n.List)}
+}
+
+func (c *cloner) method(n *MethodDecl) *MethodDecl {
+	method := &MethodDecl{Static: n.Static, Async: n.Async, Generator: n.Generator, Get: n.Get, Set: n.Set}
+	c.block(&method.Body, &n.Body) // before the parameters whose scopes are inside the body
+	method.Params = c.params(n.Params)
+	method.Name = c.propertyName(n.Name)
+	return method
+}
+
+// node clones a node, children are cloned in the same order as Walk visits them so that scopes are cloned before the scopes they contain.
+func (c *cloner) node(n INode) INode {
+	switch n := n.(type) {
+	case *AST:
+		ast := &AST{}
+		if n.Comments != nil {
+			ast.Comments = make([][]byte, len(n.Comments))
+			for i, comment := range n.Comments {
+				ast.Comments[i] = c.bytes(comment)
+			}
+		}
+		if n.ASI != nil {
+			ast.ASI = append([]ASI{}, n.ASI...)
+		}
+		c.block(&ast.BlockStmt, &n.BlockStmt)
+		return ast
+	case *Var:
+		return c.variable(n)
+	case *BlockStmt:
+		return c.blockPtr(n)
+	case *EmptyStmt:
+		return &EmptyStmt{}
+	case *ExprStmt:
+		return &ExprStmt{c.expr(n.Value)}
+	case *IfStmt:
+		stmt := &IfStmt{}
+		stmt.Body = c.stmt(n.Body)
+		stmt.Else = c.stmt(n.Else)
+		stmt.Cond = c.expr(n.Cond)
+		return stmt
+	case *DoWhileStmt:
+		stmt := &DoWhileStmt{}
+		stmt.Body = c.stmt(n.Body)
+		stmt.Cond = c.expr(n.Cond)
+		return stmt
+	case *WhileStmt:
+		stmt := &WhileStmt{}
+		stmt.Body = c.stmt(n.Body)
+		stmt.Cond = c.expr(n.Cond)
+		return stmt
+	case *ForStmt:
+		stmt := &ForStmt{}
+		stmt.Body = c.blockPtr(n.Body)
+		stmt.Init = c.expr(n.Init)
+		stmt.Cond = c.expr(n.Cond)
+		stmt.Post = c.expr(n.Post)
+		return stmt
+	case *ForInStmt:
+		stmt := &ForInStmt{}
+		stmt.Body = c.blockPtr(n.Body)
+		stmt.Init = c.expr(n.Init)
+		stmt.Value = c.expr(n.Value)
+		return stmt
+	case *ForOfStmt:
+		stmt := &ForOfStmt{Await: n.Await}
+		stmt.Body = c.blockPtr(n.Body)
+		stmt.Init = c.expr(n.Init)
+		stmt.Value = c.expr(n.Value)
+		return stmt
+	case *CaseClause:
+		clause := c.caseClause(*n)
+		return &clause
+	case *SwitchStmt:
+		stmt := &SwitchStmt{}
+		c.scope(&stmt.Scope, &n.Scope)
+		if n.List != nil {
+			stmt.List = make([]CaseClause, len(n.List))
+			for i, clause := range n.List {
+				stmt.List[i] = c.caseClause(clause)
+			}
+		}
+		stmt.Init = c.expr(n.Init)
+		return stmt
+	case *BranchStmt:
+		return &BranchStmt{n.Type, c.bytes(n.Label)}
+	case *ReturnStmt:
+		return &ReturnStmt{c.expr(n.Value)}
+	case *WithStmt:
+		stmt := &WithStmt{}
+		stmt.Body = c.stmt(n.Body)
+		stmt.Cond = c.expr(n.Cond)
+		return stmt
+	case *LabelledStmt:
+		return &LabelledStmt{c.bytes(n.Label), c.stmt(n.Value)}
+	case *ThrowStmt:
+		return &ThrowStmt{c.expr(n.Value)}
+	case *TryStmt:
+		stmt := &TryStmt{}
+		stmt.Body = c.blockPtr(n.Body)
+		stmt.Catch = c.blockPtr(n.Catch)
+		stmt.Finally = c.blockPtr(n.Finally)
+		stmt.Binding = c.binding(n.Binding)
+		return stmt
+	case *DebuggerStmt:
+		return &DebuggerStmt{}
+	case *Alias:
+		return &Alias{c.bytes(n.Name), c.bytes(n.Binding)}
+	case *ImportStmt:
+		return &ImportStmt{c.aliases(n.List), c.bytes(n.Default), c.bytes(n.Module)}
+	case *ExportStmt:
+		return &ExportStmt{c.aliases(n.List), c.bytes(n.Module), n.Default, c.expr(n.Decl)}
+	case *DirectivePrologueStmt:
+		return &DirectivePrologueStmt{c.bytes(n.Value)}
+	case *PropertyName:
+		return c.propertyNamePtr(n)
+	case *BindingArray:
+		return &BindingArray{c.bindingElements(n.List), c.binding(n.Rest)}
+	case *BindingObjectItem:
+		return &BindingObjectItem{c.propertyNamePtr(n.Key), c.bindingElement(n.Value)}
+	case *BindingObject:
+		binding := &BindingObject{}
+		if n.List != nil {
+			binding.List = make([]BindingObjectItem, len(n.List))
+			for i, item := range n.List {
+				binding.List[i] = BindingObjectItem{c.propertyNamePtr(item.Key), c.bindingElement(item.Value)}
+			}
+		}
+		binding.Rest = c.variable(n.Rest)
+		return binding
+	case *BindingElement:
+		element := c.bindingElement(*n)
+		return &element
+	case *VarDecl:
+		return &VarDecl{n.TokenType, c.bindingElements(n.List)}
+	case *Params:
+		params := c.params(*n)
+		return &params
+	case *FuncDecl:
+		fun := &FuncDecl{Async: n.Async, Generator: n.Generator}
+		c.block(&fun.Body, &n.Body) // before the parameters whose scopes are inside the body
+		fun.Params = c.params(n.Params)
+		fun.Name = c.variable(n.Name)
+		return fun
+	case *MethodDecl:
+		return c.method(n)
+	case *FieldDefinition:
+		return &FieldDefinition{c.propertyName(n.Name), c.expr(n.Init)}
+	case *ClassDecl:
+		class := &ClassDecl{}
+		class.Name = c.variable(n.Name)
+		class.Extends = c.expr(n.Extends)
+		if n.Definitions != nil {
+			class.Definitions = make([]FieldDefinition, len(n.Definitions))
+			for i, definition := range n.Definitions {
+				class.Definitions[i] = FieldDefinition{c.propertyName(definition.Name), c.expr(definition.Init)}
+			}
+		}
+		if n.Methods != nil {
+			class.Methods = make([]*MethodDecl, len(n.Methods))
+			for i, method := range n.Methods {
+				class.Methods[i] = c.method(method)
+			}
+		}
+		return class
+	case *LiteralExpr:
+		literal := c.literal(*n)
+		return &literal
+	case *Element:
+		return &Element{c.expr(n.Value), n.Spread}
+	case *ArrayExpr:
+		array := &ArrayExpr{}
+		if n.List != nil {
+			array.List = make([]Element, len(n.List))
+			for i, element := range n.List {
+				array.List[i] = Element{c.expr(element.Value), element.Spread}
+			}
+		}
+		return array
+	case *Property:
+		return &Property{c.propertyNamePtr(n.Name), n.Spread, c.expr(n.Value), c.expr(n.Init)}
+	case *ObjectExpr:
+		object := &ObjectExpr{}
+		if n.List != nil {
+			object.List = make([]Property, len(n.List))
+			for i, property := range n.List {
+				object.List[i] = Property{c.propertyNamePtr(property.Name), property.Spread, c.expr(property.Value), c.expr(property.Init)}
+			}
+		}
+		return object
+	case *TemplatePart:
+		return &TemplatePart{c.bytes(n.Value), c.expr(n.Expr)}
+	case *TemplateExpr:
+		template := &TemplateExpr{Tail: c.bytes(n.Tail), Prec: n.Prec}
+		if n.List != nil {
+			template.List = make([]TemplatePart, len(n.List))
+			for i, part := range n.List {
+				template.List[i] = TemplatePart{c.bytes(part.Value), c.expr(part.Expr)}
+			}
+		}
+		template.Tag = c.expr(n.Tag)
+		return template
+	case *GroupExpr:
+		return &GroupExpr{c.expr(n.X)}
+	case *IndexExpr:
+		return &IndexExpr{c.expr(n.X), c.expr(n.Y), n.Prec}
+	case *DotExpr:
+		return &DotExpr{c.expr(n.X), c.literal(n.Y), n.Prec}
+	case *NewTargetExpr:
+		return &NewTargetExpr{}
+	case *ImportMetaExpr:
+		return &ImportMetaExpr{}
+	case *Arg:
+		return &Arg{c.expr(n.Value), n.Rest}
+	case *Args:
+		args := c.args(*n)
+		return &args
+	case *NewExpr:
+		expr := &NewExpr{Pure: n.Pure}
+		if n.Args != nil {
+			args := c.args(*n.Args)
+			expr.Args = &args
+		}
+		expr.X = c.expr(n.X)
+		return expr
+	case *CallExpr:
+		expr := &CallExpr{Pure: n.Pure}
+		expr.Args = c.args(n.Args)
+		expr.X = c.expr(n.X)
+		return expr
+	case *OptChainExpr:
+		return &OptChainExpr{c.expr(n.X), c.expr(n.Y)}
+	case *UnaryExpr:
+		return &UnaryExpr{n.Op, c.expr(n.X)}
+	case *BinaryExpr:
+		return &BinaryExpr{n.Op, c.expr(n.X), c.expr(n.Y)}
+	case *CondExpr:
+		return &CondExpr{c.expr(n.Cond), c.expr(n.X), c.expr(n.Y)}
+	case *YieldExpr:
+		return &YieldExpr{n.Generator, c.expr(n.X)}
+	case *ArrowFunc:
+		arrow := &ArrowFunc{Async: n.Async}
+		c.block(&arrow.Body, &n.Body) // before the parameters whose scopes are inside the body
+		arrow.Params = c.params(n.Params)
+		return arrow
+	}
+	return n
+}
+
+////////////////////////////////////////////////////////////////
+
+// Equal returns true if both nodes are structurally equal, that is they have the same node types, tokens, flags, and literal values, and their variables have the same names and declaration types and correspond one-to-one. Scopes and precedence hints are not compared, so that a clone is equal to its original.
+func Equal(a, b INode) bool {
+	e := &equaler{
+		vars: map[*Var]*Var{},
+		rev:  map[*Var]*Var{},
+	}
+	return e.node(a, b)
+}
+
+type equaler struct {
+	vars, rev map[*Var]*Var
+}
+
+func (e *equaler) variable(a, b *Var) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	for a.Link != nil {
+		a = a.Link
+	}
+	for b.Link != nil {
+		b = b.Link
+	}
+	if w, ok := e.vars[a]; ok {
+		return w == b
+	} else if _, ok := e.rev[b]; ok {
+		return false
+	} else if a.Decl != b.Decl || !equalIdentifier(a.Data, b.Data) {
+		return false
+	}
+	e.vars[a] = b
+	e.rev[b] = a
+	return true
+}
+
+func (e *equaler) stmt(a, b IStmt) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return e.node(a, b)
+}
+
+func (e *equaler) stmts(a, b []IStmt) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !e.stmt(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *equaler) expr(a, b IExpr) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return e.node(a, b)
+}
+
+func (e *equaler) binding(a, b IBinding) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return e.node(a, b)
+}
+
+func (e *equaler) block(a, b *BlockStmt) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return e.stmts(a.List, b.List)
+}
+
+func (e *equaler) literal(a, b LiteralExpr) bool {
+	return a.TokenType == b.TokenType && bytes.Equal(a.Data, b.Data)
+}
+
+func (e *equaler) propertyName(a, b *PropertyName) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return e.literal(a.Literal, b.Literal) && e.expr(a.Computed, b.Computed)
+}
+
+func (e *equaler) bindingElements(a, b []BindingElement) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !e.binding(a[i].Binding, b[i].Binding) || !e.expr(a[i].Default, b[i].Default) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *equaler) params(a, b *Params) bool {
+	return e.bindingElements(a.List, b.List) && e.binding(a.Rest, b.Rest)
+}
+
+func (e *equaler) args(a, b *Args) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	} else if len(a.List) != len(b.List) {
+		return false
+	}
+	for i := range a.List {
+		if a.List[i].Rest != b.List[i].Rest || !e.expr(a.List[i].Value, b.List[i].Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *equaler) aliases(a, b []Alias) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i].Name, b[i].Name) || !bytes.Equal(a[i].Binding, b[i].Binding) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *equaler) caseClause(a, b *CaseClause) bool {
+	return a.TokenType == b.TokenType && e.expr(a.Cond, b.Cond) && e.stmts(a.List, b.List)
+}
+
+func (e *equaler) method(a, b *MethodDecl) bool {
+	return a.Static == b.Static && a.Async == b.Async && a.Generator == b.Generator && a.Get == b.Get && a.Set == b.Set && e.propertyName(&a.Name, &b.Name) && e.params(&a.Params, &b.Params) && e.block(&a.Body, &b.Body)
+}
+
+func (e *equaler) property(a, b *Property) bool {
+	return a.Spread == b.Spread && e.propertyName(a.Name, b.Name) && e.expr(a.Value, b.Value) && e.expr(a.Init, b.Init)
+}
+
+func (e *equaler) templatePart(a, b *TemplatePart) bool {
+	return bytes.Equal(a.Value, b.Value) && e.expr(a.Expr, b.Expr)
+}
+
+func (e *equaler) node(a, b INode) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	switch a := a.(type) {
+	case *AST:
+		b, ok := b.(*AST)
+		return ok && e.block(&a.BlockStmt, &b.BlockStmt)
+	case *Var:
+		b, ok := b.(*Var)
+		return ok && e.variable(a, b)
+	case *BlockStmt:
+		b, ok := b.(*BlockStmt)
+		return ok && e.block(a, b)
+	case *EmptyStmt:
+		_, ok := b.(*EmptyStmt)
+		return ok
+	case *ExprStmt:
+		b, ok := b.(*ExprStmt)
+		return ok && e.expr(a.Value, b.Value)
+	case *IfStmt:
+		b, ok := b.(*IfStmt)
+		return ok && e.expr(a.Cond, b.Cond) && e.stmt(a.Body, b.Body) && e.stmt(a.Else, b.Else)
+	case *DoWhileStmt:
+		b, ok := b.(*DoWhileStmt)
+		return ok && e.stmt(a.Body, b.Body) && e.expr(a.Cond, b.Cond)
+	case *WhileStmt:
+		b, ok := b.(*WhileStmt)
+		return ok && e.expr(a.Cond, b.Cond) && e.stmt(a.Body, b.Body)
+	case *ForStmt:
+		b, ok := b.(*ForStmt)
+		return ok && e.expr(a.Init, b.Init) && e.expr(a.Cond, b.Cond) && e.expr(a.Post, b.Post) && e.block(a.Body, b.Body)
+	case *ForInStmt:
+		b, ok := b.(*ForInStmt)
+		return ok && e.expr(a.Init, b.Init) && e.expr(a.Value, b.Value) && e.block(a.Body, b.Body)
+	case *ForOfStmt:
+		b, ok := b.(*ForOfStmt)
+		return ok && a.Await == b.Await && e.expr(a.Init, b.Init) && e.expr(a.Value, b.Value) && e.block(a.Body, b.Body)
+	case *CaseClause:
+		b, ok := b.(*CaseClause)
+		return ok && e.caseClause(a, b)
+	case *SwitchStmt:
+		b, ok := b.(*SwitchStmt)
+		if !ok || len(a.List) != len(b.List) || !e.expr(a.Init, b.Init) {
+			return false
+		}
+		for i := range a.List {
+			if !e.caseClause(&a.List[i], &b.List[i]) {
+				return false
+			}
+		}
+		return true
+	case *BranchStmt:
+		b, ok := b.(*BranchStmt)
+		return ok && a.Type == b.Type && bytes.Equal(a.Label, b.Label)
+	case *ReturnStmt:
+		b, ok := b.(*ReturnStmt)
+		return ok && e.expr(a.Value, b.Value)
+	case *WithStmt:
+		b, ok := b.(*WithStmt)
+		return ok && e.expr(a.Cond, b.Cond) && e.stmt(a.Body, b.Body)
+	case *LabelledStmt:
+		b, ok := b.(*LabelledStmt)
+		return ok && bytes.Equal(a.Label, b.Label) && e.stmt(a.Value, b.Value)
+	case *ThrowStmt:
+		b, ok := b.(*ThrowStmt)
+		return ok && e.expr(a.Value, b.Value)
+	case *TryStmt:
+		b, ok := b.(*TryStmt)
+		return ok && e.block(a.Body, b.Body) && e.binding(a.Binding, b.Binding) && e.block(a.Catch, b.Catch) && e.block(a.Finally, b.Finally)
+	case *DebuggerStmt:
+		_, ok := b.(*DebuggerStmt)
+		return ok
+	case *Alias:
+		b, ok := b.(*Alias)
+		return ok && bytes.Equal(a.Name, b.Name) && bytes.Equal(a.Binding, b.Binding)
+	case *ImportStmt:
+		b, ok := b.(*ImportStmt)
+		return ok && e.aliases(a.List, b.List) && bytes.Equal(a.Default, b.Default) && bytes.Equal(a.Module, b.Module)
+	case *ExportStmt:
+		b, ok := b.(*ExportStmt)
+		return ok && e.aliases(a.List, b.List) && bytes.Equal(a.Module, b.Module) && a.Default == b.Default && e.expr(a.Decl, b.Decl)
+	case *DirectivePrologueStmt:
+		b, ok := b.(*DirectivePrologueStmt)
+		return ok && bytes.Equal(a.Value, b.Value)
+	case *PropertyName:
+		b, ok := b.(*PropertyName)
+		return ok && e.propertyName(a, b)
+	case *BindingArray:
+		b, ok := b.(*BindingArray)
+		return ok && e.bindingElements(a.List, b.List) && e.binding(a.Rest, b.Rest)
+	case *BindingObjectItem:
+		b, ok := b.(*BindingObjectItem)
+		return ok && e.propertyName(a.Key, b.Key) && e.bindingElements([]BindingElement{a.Value}, []BindingElement{b.Value})
+	case *BindingObject:
+		b, ok := b.(*BindingObject)
+		if !ok || len(a.List) != len(b.List) || !e.variable(a.Rest, b.Rest) {
+			return false
+		}
+		for i := range a.List {
+			if !e.propertyName(a.List[i].Key, b.List[i].Key) || !e.bindingElements([]BindingElement{a.List[i].Value}, []BindingElement{b.List[i].Value}) {
+				return false
+			}
+		}
+		return true
+	case *BindingElement:
+		b, ok := b.(*BindingElement)
+		return ok && e.bindingElements([]BindingElement{*a}, []BindingElement{*b})
+	case *VarDecl:
+		b, ok := b.(*VarDecl)
+		return ok && a.TokenType == b.TokenType && e.bindingElements(a.List, b.List)
+	case *Params:
+		b, ok := b.(*Params)
+		return ok && e.params(a, b)
+	case *FuncDecl:
+		b, ok := b.(*FuncDecl)
+		return ok && a.Async == b.Async && a.Generator == b.Generator && e.variable(a.Name, b.Name) && e.params(&a.Params, &b.Params) && e.block(&a.Body, &b.Body)
+	case *MethodDecl:
+		b, ok := b.(*MethodDecl)
+		return ok && e.method(a, b)
+	case *FieldDefinition:
+		b, ok := b.(*FieldDefinition)
+		return ok && e.propertyName(&a.Name, &b.Name) && e.expr(a.Init, b.Init)
+	case *ClassDecl:
+		b, ok := b.(*ClassDecl)
+		if !ok || len(a.Definitions) != len(b.Definitions) || len(a.Methods) != len(b.Methods) || !e.variable(a.Name, b.Name) || !e.expr(a.Extends, b.Extends) {
+			return false
+		}
+		for i := range a.Definitions {
+			if !e.propertyName(&a.Definitions[i].Name, &b.Definitions[i].Name) || !e.expr(a.Definitions[i].Init, b.Definitions[i].Init) {
+				return false
+			}
+		}
+		for i := range a.Methods {
+			if !e.method(a.Methods[i], b.Methods[i]) {
+				return false
+			}
+		}
+		return true
+	case *LiteralExpr:
+		b, ok := b.(*LiteralExpr)
+		return ok && e.literal(*a, *b)
+	case *Element:
+		b, ok := b.(*Element)
+		return ok && a.Spread == b.Spread && e.expr(a.Value, b.Value)
+	case *ArrayExpr:
+		b, ok := b.(*ArrayExpr)
+		if !ok || len(a.List) != len(b.List) {
+			return false
+		}
+		for i := range a.List {
+			if a.List[i].Spread != b.List[i].Spread || !e.expr(a.List[i].Value, b.List[i].Value) {
+				return false
+			}
+		}
+		return true
+	case *Property:
+		b, ok := b.(*Property)
+		return ok && e.property(a, b)
+	case *ObjectExpr:
+		b, ok := b.(*ObjectExpr)
+		if !ok || len(a.List) != len(b.List) {
+			return false
+		}
+		for i := range a.List {
+			if !e.property(&a.List[i], &b.List[i]) {
+				return false
+			}
+		}
+		return true
+	case *TemplatePart:
+		b, ok := b.(*TemplatePart)
+		return ok && e.templatePart(a, b)
+	case *TemplateExpr:
+		b, ok := b.(*TemplateExpr)
+		if !ok || len(a.List) != len(b.List) || !e.expr(a.Tag, b.Tag) || !bytes.Equal(a.Tail, b.Tail) {
+			return false
+		}
+		for i := range a.List {
+			if !e.templatePart(&a.List[i], &b.List[i]) {
+				return false
+			}
+		}
+		return true
+	case *GroupExpr:
+		b, ok := b.(*GroupExpr)
+		return ok && e.expr(a.X, b.X)
+	case *IndexExpr:
+		b, ok := b.(*IndexExpr)
+		return ok && e.expr(a.X, b.X) && e.expr(a.Y, b.Y)
+	case *DotExpr:
+		b, ok := b.(*DotExpr)
+		return ok && e.expr(a.X, b.X) && e.literal(a.Y, b.Y)
+	case *NewTargetExpr:
+		_, ok := b.(*NewTargetExpr)
+		return ok
+	case *ImportMetaExpr:
+		_, ok := b.(*ImportMetaExpr)
+		return ok
+	case *Arg:
+		b, ok := b.(*Arg)
+		return ok && a.Rest == b.Rest && e.expr(a.Value, b.Value)
+	case *Args:
+		b, ok := b.(*Args)
+		return ok && e.args(a, b)
+	case *NewExpr:
+		b, ok := b.(*NewExpr)
+		return ok && a.Pure == b.Pure && e.expr(a.X, b.X) && e.args(a.Args, b.Args)
+	case *CallExpr:
+		b, ok := b.(*CallExpr)
+		return ok && a.Pure == b.Pure && e.expr(a.X, b.X) && e.args(&a.Args, &b.Args)
+	case *OptChainExpr:
+		b, ok := b.(*OptChainExpr)
+		return ok && e.expr(a.X, b.X) && e.expr(a.Y, b.Y)
+	case *UnaryExpr:
+		b, ok := b.(*UnaryExpr)
+		return ok && a.Op == b.Op && e.expr(a.X, b.X)
+	case *BinaryExpr:
+		b, ok := b.(*BinaryExpr)
+		return ok && a.Op == b.Op && e.expr(a.X, b.X) && e.expr(a.Y, b.Y)
+	case *CondExpr:
+		b, ok := b.(*CondExpr)
+		return ok && e.expr(a.Cond, b.Cond) && e.expr(a.X, b.X) && e.expr(a.Y, b.Y)
+	case *YieldExpr:
+		b, ok := b.(*YieldExpr)
+		return ok && a.Generator == b.Generator && e.expr(a.X, b.X)
+	case *ArrowFunc:
+		b, ok := b.(*ArrowFunc)
+		return ok && a.Async == b.Async && e.params(&a.Params, &b.Params) && e.block(&a.Body, &b.Body)
+	}
+	return false
+}
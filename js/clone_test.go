@@ -0,0 +1,134 @@
+package js
+
+import (
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestClone(t *testing.T) {
+	var tests = []string{
+		"var a = 5; a++",
+		"a; {let a; a}; var b = a",
+		"function f(a, b = a, ...c) { return a + b + c.length }",
+		"for (let i = 0; i < n; i++) { setTimeout(() => i) }",
+		"switch (a) { case 1: let b = 2; break; default: b }",
+		"try { a() } catch ({message}) { log(message) } finally { b }",
+		"class A extends B { x = 1; static m(a) { return super.m(a) } get y() { return this.x } }",
+		"import a, {b as c} from 'd'; export {c}; export default function() {}",
+		"label: for (const [a, , b = 1, ...c] of d) { continue label }",
+		"x = {a, b: 2, [c]: 3, ...d, e() {}}; y = [1, , ...z]",
+		"tag`a${b}c${d}e`; a?.b?.[c]?.(d); new A; function f() { new.target }; x = import.meta",
+		"async function* f() { yield* g(); await h }; (async (a) => a)",
+		"if (a) b; else if (c) d; else { e }; do a; while (b); while (c) d; with (a) b",
+		"`use strict`; typeof a === 'undefined' ? void 0 : (-a) ** 2",
+	}
+	for _, js := range tests {
+		t.Run(js, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(js))
+			test.Error(t, err)
+
+			clone := Clone(ast, false).(*AST)
+			test.String(t, clone.JS(), ast.JS())
+			test.That(t, Equal(ast, clone), "clone must equal original")
+			test.That(t, Equal(clone, ast), "original must equal clone")
+
+			// no variables are shared
+			vars := map[*Var]bool{}
+			Walk(&varCollector{vars}, ast)
+			cloneVars := map[*Var]bool{}
+			Walk(&varCollector{cloneVars}, clone)
+			for v := range cloneVars {
+				test.That(t, !vars[v], "variable "+string(v.Data)+" must not be shared")
+			}
+		})
+	}
+}
+
+type varCollector struct {
+	vars map[*Var]bool
+}
+
+func (v *varCollector) Enter(n INode) IVisitor {
+	if n, ok := n.(*Var); ok {
+		v.vars[n] = true
+	}
+	return v
+}
+
+func (v *varCollector) Exit(n INode) {}
+
+func TestCloneScope(t *testing.T) {
+	ast, err := Parse(parse.NewInputString("var a; function f(b) { a = b; c }"))
+	test.Error(t, err)
+
+	clone := Clone(ast, false).(*AST)
+	f := ast.List[1].(*FuncDecl)
+	g := clone.List[1].(*FuncDecl)
+	test.That(t, g.Body.Scope.Parent == &clone.Scope, "parent scope must be the cloned scope")
+	test.That(t, g.Body.Scope.Func == &g.Body.Scope, "function scope must be the cloned scope")
+
+	// variables are remapped consistently
+	assign := g.Body.List[0].(*ExprStmt).Value.(*BinaryExpr)
+	test.That(t, assign.X.(*Var).Link == clone.Scope.Declared[0], "a must link to the cloned declaration")
+	test.That(t, assign.Y.(*Var) == g.Params.List[0].Binding.(*Var), "b must refer to the cloned parameter")
+	test.That(t, g.Name == clone.Scope.Declared[1], "f must refer to the cloned declaration")
+
+	// renaming the clone does not affect the original
+	clone.Scope.Declared[0].Data = []byte("x")
+	test.String(t, clone.JS(), "var x; function f (b) { x = b; c; }; ")
+	test.String(t, ast.JS(), "var a; function f (b) { a = b; c; }; ")
+
+	// cloning a subtree keeps variables declared outside shared
+	h := Clone(f, false).(*FuncDecl)
+	test.That(t, h.Name == f.Name, "f is declared outside of the subtree")
+	test.That(t, h.Params.List[0].Binding != f.Params.List[0].Binding, "b is declared inside the subtree")
+	test.That(t, h.Body.List[0].(*ExprStmt).Value.(*BinaryExpr).X == f.Body.List[0].(*ExprStmt).Value.(*BinaryExpr).X, "a is declared outside of the subtree")
+	test.That(t, h.Body.Scope.Parent == f.Body.Scope.Parent, "parent scope is outside of the subtree")
+}
+
+func TestCloneDetach(t *testing.T) {
+	b := append(make([]byte, 0, 32), "var a = 'str'; b.c"...) // spare capacity so that the input buffer is not reallocated
+	ast, err := Parse(parse.NewInputBytes(b))
+	test.Error(t, err)
+
+	clone := Clone(ast, true)
+	copy(b, "var x = 'xyz'; y.z")
+	test.String(t, clone.JS(), "var a = 'str'; b.c; ")
+
+	// variables declared outside of a cloned subtree are shared, but their names are detached
+	b = append(make([]byte, 0, 64), "var a; function f(b) { a = b + 'str' }"...)
+	ast, err = Parse(parse.NewInputBytes(b))
+	test.Error(t, err)
+
+	f := Clone(ast.List[1], true).(*FuncDecl)
+	copy(b, "var x; function g(y) { x = y + 'xyz' }")
+	test.String(t, f.JS(), "function f (b) { a = b + 'str'; }")
+}
+
+func TestEqual(t *testing.T) {
+	var tests = []struct {
+		a, b  string
+		equal bool
+	}{
+		{"a + b", "a+b", true},
+		{"a + b", "a - b", false},
+		{"a + b", "b + a", false},
+		{"var a; a", "var b; b", false},
+		{"a = 1", "(a = 1)", false},
+		{"f(a, ...b)", "f(a, b)", false},
+		{"function f(a) { return a }", "function f(a) { return a }", true},
+		{"let a; { let a; a }", "let a; { let b; a }", false},
+		{"var \\u0061; a", "var a; \\u{61}", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" == "+tt.b, func(t *testing.T) {
+			a, err := Parse(parse.NewInputString(tt.a))
+			test.Error(t, err)
+			b, err := Parse(parse.NewInputString(tt.b))
+			test.Error(t, err)
+			test.T(t, Equal(a, b), tt.equal)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package js
+
+import "bytes"
+
+// Require is a call to require with a string literal as module specifier.
+type Require struct {
+	Module []byte // module specifier including quotes
+	Call   *CallExpr
+}
+
+// CommonJS contains the dependencies and exports of a CommonJS module.
+type CommonJS struct {
+	IsCommonJS bool // references require, module, or exports as global variables
+	ESModule   bool // marked as transpiled ES module by exports.__esModule
+	Requires   []Require
+	Exports    []Export
+}
+
+// CommonJSModule returns the require calls with literal module specifiers and the exports of a CommonJS module, in order of appearance. Exports are found from assignments to exports.name, module.exports.name, and module.exports, and from Object.defineProperty(exports, "name", ...). Exports use the same representation as for ES modules, so that `module.exports = require("a")` is a StarExport and `exports.b = require("a").c` is a ReExport. Only references to the global require, module, and exports variables are considered.
+func CommonJSModule(ast *AST) CommonJS {
+	v := &commonJSVisitor{}
+	Walk(v, ast)
+	return v.CommonJS
+}
+
+type commonJSVisitor struct {
+	CommonJS
+}
+
+func (v *commonJSVisitor) Enter(n INode) IVisitor {
+	switch n := n.(type) {
+	case *Var:
+		if isGlobalVar(n, "require") || isGlobalVar(n, "module") || isGlobalVar(n, "exports") {
+			v.IsCommonJS = true
+		}
+	case *CallExpr:
+		if module := requireModule(n); module != nil {
+			v.Requires = append(v.Requires, Require{module, n})
+		} else if dot, ok := n.X.(*DotExpr); ok && isGlobalVar(dot.X, "Object") && bytes.Equal(dot.Y.Data, []byte("defineProperty")) && len(n.Args.List) == 3 && isExportsObject(n.Args.List[0].Value) {
+			// Object.defineProperty(exports, "name", descriptor)
+			if lit, ok := n.Args.List[1].Value.(*LiteralExpr); ok && lit.TokenType == StringToken {
+				v.addExport(lit.Data[1:len(lit.Data)-1], nil)
+			}
+		}
+	case *BinaryExpr:
+		if n.Op != EqToken {
+			break
+		}
+		value := n.Y
+		for {
+			if assign, ok := value.(*BinaryExpr); ok && assign.Op == EqToken {
+				value = assign.Y // module.exports = exports = value
+			} else {
+				break
+			}
+		}
+
+		if isModuleExports(n.X) {
+			// module.exports = value
+			if module := requireModule(value); module != nil {
+				v.Exports = append(v.Exports, Export{Name: starBytes, Module: module, Kind: StarExport})
+			} else if object, ok := value.(*ObjectExpr); ok {
+				for _, item := range object.List {
+					if item.Spread {
+						continue
+					} else if method, ok := item.Value.(*MethodDecl); ok {
+						if !method.Name.IsComputed() {
+							export := Export{Name: propertyKey(method.Name), Kind: FunctionExport, Type: FunctionValue}
+							if !method.Get && !method.Set {
+								export.Async = method.Async
+								export.Generator = method.Generator
+								export.Params = exportParams(method.Params)
+							} else {
+								export.Kind = ExprExport
+								export.Type = UnknownValue
+							}
+							v.Exports = append(v.Exports, export)
+						}
+					} else if item.Name != nil && !item.Name.IsComputed() {
+						v.addExport(propertyKey(*item.Name), item.Value)
+					}
+				}
+			} else {
+				v.addExport(defaultBytes, value)
+			}
+		} else if name, object := exportsProperty(n.X); object != nil {
+			// exports.name = value
+			v.addExport(name, value)
+		}
+	}
+	return v
+}
+
+func (v *commonJSVisitor) Exit(n INode) {}
+
+// addExport adds an export of the given value under the given name, value can be nil.
+func (v *commonJSVisitor) addExport(name []byte, value IExpr) {
+	if bytes.Equal(name, []byte("__esModule")) {
+		v.ESModule = true
+		return
+	}
+
+	export := Export{Kind: ExprExport}
+	if module := requireModule(value); module != nil {
+		// exports.name = require("module")
+		export.Kind = NamespaceExport
+		export.Module = module
+		export.Type = ObjectValue
+	} else if dot, ok := value.(*DotExpr); ok && requireModule(dot.X) != nil {
+		// exports.name = require("module").local
+		export.Kind = ReExport
+		export.Module = requireModule(dot.X)
+		export.Local = dot.Y.Data
+	} else if value != nil {
+		export = exportOfExpr(value)
+	}
+	export.Name = name
+	v.Exports = append(v.Exports, export)
+}
+
+// requireModule returns the module specifier if the expression is a call to the global require with a string literal, otherwise it returns nil.
+func requireModule(expr IExpr) []byte {
+	call, ok := expr.(*CallExpr)
+	if !ok || !isGlobalVar(call.X, "require") || len(call.Args.List) != 1 || call.Args.List[0].Rest {
+		return nil
+	}
+	switch arg := call.Args.List[0].Value.(type) {
+	case *LiteralExpr:
+		if arg.TokenType == StringToken {
+			return arg.Data
+		}
+	case *TemplateExpr:
+		if arg.Tag == nil && len(arg.List) == 0 {
+			return arg.Tail
+		}
+	}
+	return nil
+}
+
+// isGlobalVar returns true if the expression is a reference to an undeclared variable with the given name.
+func isGlobalVar(expr IExpr, name string) bool {
+	v, ok := expr.(*Var)
+	if !ok {
+		return false
+	}
+	for v.Link != nil {
+		v = v.Link
+	}
+	return v.Decl == NoDecl && string(DecodeIdentifier(v.Data)) == name
+}
+
+// isModuleExports returns true for module.exports and module["exports"].
+func isModuleExports(expr IExpr) bool {
+	name, object := memberName(expr)
+	return object != nil && isGlobalVar(object, "module") && bytes.Equal(name, []byte("exports"))
+}
+
+// isExportsObject returns true for exports and module.exports.
+func isExportsObject(expr IExpr) bool {
+	return isGlobalVar(expr, "exports") || isModuleExports(expr)
+}
+
+// exportsProperty returns the property name and the exports object for exports.name and module.exports.name.
+func exportsProperty(expr IExpr) ([]byte, IExpr) {
+	if name, object := memberName(expr); object != nil && isExportsObject(object) {
+		return name, object
+	}
+	return nil, nil
+}
+
+// memberName returns the property name and the object of a dot expression or an index expression with a string literal.
+func memberName(expr IExpr) ([]byte, IExpr) {
+	switch n := expr.(type) {
+	case *DotExpr:
+		return n.Y.Data, n.X
+	case *IndexExpr:
+		if lit, ok := n.Y.(*LiteralExpr); ok && lit.TokenType == StringToken {
+			return lit.Data[1 : len(lit.Data)-1], n.X
+		}
+	}
+	return nil, nil
+}
+
+// propertyKey returns the name of a non-computed property name, stripping quotes from strings.
+func propertyKey(name PropertyName) []byte {
+	if name.Literal.TokenType == StringToken {
+		return name.Literal.Data[1 : len(name.Literal.Data)-1]
+	}
+	return name.Literal.Data
+}
@@ -0,0 +1,80 @@
+package js
+
+import (
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestCommonJSModule(t *testing.T) {
+	var tests = []struct {
+		js       string
+		requires []string
+		exports  []string
+	}{
+		{"var a = 5", []string{}, []string{}},
+		{"var a = require('a'); const {b} = require(\"b\")", []string{"'a'", "\"b\""}, []string{}},
+		{"require(`a`); require(`a${b}`); require(a); require('a', 'b')", []string{"`a`"}, []string{}},
+		{"function f(require) { require('a') }", []string{}, []string{}},
+		{"if (a) { b(require('a')) } class A { m() { require('b') } }", []string{"'a'", "'b'"}, []string{}},
+		{"exports.a = 5; exports['b'] = function(c) {}", []string{}, []string{"ExprExport(a: number)", "FunctionExport(b: function (c))"}},
+		{"module.exports.a = b", []string{}, []string{"ExprExport(a = b)"}},
+		{"module.exports = class A {}", []string{}, []string{"ClassExport(default: class)"}},
+		{"module.exports = {a, b: 'str', c() {}, get d() {}, ...e, [f]: 1}", []string{}, []string{"ExprExport(a)", "ExprExport(b: string)", "FunctionExport(c: function ())", "ExprExport(d)"}},
+		{"module.exports = exports = {a: 1}", []string{}, []string{"ExprExport(a: number)"}},
+		{"module.exports = require('a')", []string{"'a'"}, []string{"StarExport(* from 'a')"}},
+		{"exports.a = require('a'); exports.b = require('b').c", []string{"'a'", "'b'"}, []string{"NamespaceExport(a from 'a': object)", "ReExport(b = c from 'b')"}},
+		{"Object.defineProperty(exports, 'a', {get: function() { return b }})", []string{}, []string{"ExprExport(a)"}},
+		{"var exports = {}; exports.a = 5", []string{}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(tt.js))
+			test.Error(t, err)
+
+			cjs := CommonJSModule(ast)
+			requires := []string{}
+			for _, require := range cjs.Requires {
+				requires = append(requires, string(require.Module))
+			}
+			exports := []string{}
+			for _, export := range cjs.Exports {
+				exports = append(exports, export.String())
+			}
+			test.T(t, requires, tt.requires)
+			test.T(t, exports, tt.exports)
+		})
+	}
+}
+
+func TestCommonJSModuleDetection(t *testing.T) {
+	var tests = []struct {
+		js         string
+		isCommonJS bool
+		esModule   bool
+	}{
+		{"var a = 5", false, false},
+		{"export var a = 5", false, false},
+		{"require('a')", true, false},
+		{"module.exports = 5", true, false},
+		{"typeof exports === 'object'", true, false},
+		{"let module = {}; module.exports = 5", false, false},
+		{"exports.__esModule = true", true, true},
+		{"Object.defineProperty(exports, '__esModule', {value: true})", true, true},
+		{"Object.defineProperty(module.exports, \"__esModule\", {value: true})", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(tt.js))
+			test.Error(t, err)
+
+			cjs := CommonJSModule(ast)
+			test.T(t, cjs.IsCommonJS, tt.isCommonJS, "IsCommonJS")
+			test.T(t, cjs.ESModule, tt.esModule, "ESModule")
+			for _, export := range cjs.Exports {
+				test.That(t, string(export.Name) != "__esModule", "__esModule is not an export")
+			}
+		})
+	}
+}
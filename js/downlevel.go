@@ -0,0 +1,1377 @@
+package js
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/tdewolff/parse/v2"
+)
+
+// DownlevelPass is a transformation that rewrites ES2015+ syntax into its ES5 equivalent.
+type DownlevelPass uint32
+
+// DownlevelPass values.
+const (
+	ArrowFuncPass     DownlevelPass = 1 << iota // arrow functions into function expressions, capturing this, arguments, and new.target
+	ClassPass                                   // classes into constructor functions with prototype methods
+	TemplatePass                                // template literals into string concatenations, tagged templates into calls
+	DestructuringPass                           // binding and assignment patterns into member accesses
+	SpreadPass                                  // spread arguments and array elements into apply and concat calls
+	OptChainPass                                // optional chains into conditional expressions
+	AllPasses         = ArrowFuncPass | ClassPass | TemplatePass | DestructuringPass | SpreadPass | OptChainPass
+)
+
+// Downlevel rewrites the AST in place so that the constructs of the given passes are printed by JS() as ES5. Helper variables such as _this and _ref are declared by a var statement at the top of the enclosing function, and their names do not collide with other variables in the AST. Default values and rest elements of parameters are transformed by the DestructuringPass. Apart from adding these declarations, scope information and variable uses are not updated. The transforms are loose: class methods are enumerable, destructuring and spread require array-like values instead of arbitrary iterables, tagged templates receive a new strings array on each call, and class fields are always initialized on the instance as the AST does not distinguish static fields. Constructs that cannot be transformed, such as classes with private members, are left as is and the first one is reported in the returned error.
+func Downlevel(ast *AST, passes DownlevelPass) error {
+	t := &downleveler{
+		passes:  passes,
+		names:   varNames{},
+		methods: map[*BlockStmt]downlevelFrame{},
+	}
+	Walk(t.names, ast)
+
+	f := &downlevelFrame{body: &ast.BlockStmt}
+	t.frames = append(t.frames, f)
+	ast.List = t.stmts(ast.List)
+	t.declare(f, nil)
+	return t.err
+}
+
+// downlevelFrame is a function body being transformed.
+type downlevelFrame struct {
+	body            *BlockStmt // nil for class field initializers
+	arrow           bool
+	this, arguments *Var // captured by arrow functions
+	newTarget       *Var // captured by arrow functions
+	temps           []*Var
+	super           *Var // parent class of class methods
+	static          bool
+}
+
+type downleveler struct {
+	passes  DownlevelPass
+	names   varNames
+	frames  []*downlevelFrame
+	methods map[*BlockStmt]downlevelFrame // super of generated class methods
+	err     error
+}
+
+func (t *downleveler) fail(construct string) {
+	if t.err == nil {
+		t.err = fmt.Errorf("cannot downlevel %s", construct)
+	}
+}
+
+func (t *downleveler) frame() *downlevelFrame {
+	return t.frames[len(t.frames)-1]
+}
+
+// newVar returns a new variable whose name is not used elsewhere.
+func (t *downleveler) newVar(name string, decl DeclType) *Var {
+	data := name
+	for i := 2; t.names[data]; i++ {
+		data = name + strconv.Itoa(i)
+	}
+	t.names[data] = true
+	return &Var{[]byte(data), nil, 1, decl}
+}
+
+// temp returns a new variable that is declared in the enclosing function.
+func (t *downleveler) temp() *Var {
+	v := t.newVar("_ref", VariableDecl)
+	for i := len(t.frames) - 1; 0 <= i; i-- {
+		if t.frames[i].body != nil {
+			t.frames[i].temps = append(t.frames[i].temps, v)
+			break
+		}
+	}
+	return v
+}
+
+// capture returns the non-arrow function whose this, arguments, and new.target are used by the current arrow function, or nil if they need not be captured.
+func (t *downleveler) capture() *downlevelFrame {
+	if t.passes&ArrowFuncPass == 0 || !t.frame().arrow {
+		return nil
+	}
+	for i := len(t.frames) - 1; 0 <= i; i-- {
+		if !t.frames[i].arrow {
+			if t.frames[i].body == nil {
+				t.fail("this, arguments, or new.target in an arrow function in a class field")
+				return nil
+			}
+			return t.frames[i]
+		}
+	}
+	return nil
+}
+
+func (t *downleveler) this() IExpr {
+	if f := t.capture(); f != nil {
+		if f.this == nil {
+			f.this = t.newVar("_this", VariableDecl)
+		}
+		return f.this
+	}
+	return thisExpr()
+}
+
+// declare adds the captured and temporary variables of the function, followed by stmts, to the top of its body.
+func (t *downleveler) declare(f *downlevelFrame, stmts []IStmt) {
+	decl := &VarDecl{TokenType: VarToken}
+	if f.this != nil {
+		decl.List = append(decl.List, BindingElement{f.this, thisExpr()})
+	}
+	if f.arguments != nil {
+		decl.List = append(decl.List, BindingElement{f.arguments, globalVar("arguments")})
+	}
+	if f.newTarget != nil {
+		decl.List = append(decl.List, BindingElement{f.newTarget, &NewTargetExpr{}})
+	}
+	for _, v := range f.temps {
+		decl.List = append(decl.List, BindingElement{Binding: v})
+	}
+	if len(decl.List) != 0 {
+		stmts = append([]IStmt{decl}, stmts...)
+		for _, item := range decl.List {
+			f.body.Scope.Declared = append(f.body.Scope.Declared, item.Binding.(*Var))
+		}
+	}
+	if len(stmts) == 0 {
+		return
+	}
+
+	// insert after the directive prologue
+	i := 0
+	for i < len(f.body.List) {
+		if _, ok := f.body.List[i].(*DirectivePrologueStmt); !ok {
+			break
+		}
+		i++
+	}
+	f.body.List = append(f.body.List[:i:i], append(stmts, f.body.List[i:]...)...)
+}
+
+// varNames is the set of variable names in an AST.
+type varNames map[string]bool
+
+func (names varNames) Enter(n INode) IVisitor {
+	if v, ok := n.(*Var); ok {
+		names[string(v.Data)] = true
+	}
+	return names
+}
+
+func (names varNames) Exit(n INode) {}
+
+////////////////////////////////////////////////////////////////
+
+func (t *downleveler) stmts(list []IStmt) []IStmt {
+	for i := 0; i < len(list); i++ {
+		if export, ok := list[i].(*ExportStmt); ok && export.Default && t.passes&ClassPass != 0 {
+			if class, ok := export.Decl.(*ClassDecl); ok && class.Name != nil {
+				// export default class A {}  =>  var A = ...; export default A
+				decl := &VarDecl{VarToken, []BindingElement{{class.Name, t.expr(class, OpAssign)}}}
+				export.Decl = class.Name
+				list = append(list[:i:i], append([]IStmt{decl}, list[i:]...)...)
+				i++
+				continue
+			}
+		}
+		list[i] = t.stmt(list[i])
+	}
+	return list
+}
+
+func (t *downleveler) stmt(stmt IStmt) IStmt {
+	switch n := stmt.(type) {
+	case *BlockStmt:
+		n.List = t.stmts(n.List)
+	case *ExprStmt:
+		n.Value = t.expr(n.Value, OpExpr)
+		if startsWithDecl(n.Value) {
+			n.Value = &GroupExpr{n.Value}
+		}
+	case *IfStmt:
+		n.Cond = t.expr(n.Cond, OpExpr)
+		n.Body = t.stmt(n.Body)
+		n.Else = t.stmt(n.Else)
+	case *DoWhileStmt:
+		n.Body = t.stmt(n.Body)
+		n.Cond = t.expr(n.Cond, OpExpr)
+	case *WhileStmt:
+		n.Cond = t.expr(n.Cond, OpExpr)
+		n.Body = t.stmt(n.Body)
+	case *ForStmt:
+		n.Init = t.expr(n.Init, OpExpr)
+		n.Cond = t.expr(n.Cond, OpExpr)
+		n.Post = t.expr(n.Post, OpExpr)
+		t.stmt(n.Body)
+	case *ForInStmt:
+		n.Init = t.forInit(n.Init, n.Body)
+		n.Value = t.expr(n.Value, OpExpr)
+		t.stmt(n.Body)
+	case *ForOfStmt:
+		n.Init = t.forInit(n.Init, n.Body)
+		n.Value = t.expr(n.Value, OpAssign)
+		t.stmt(n.Body)
+	case *SwitchStmt:
+		n.Init = t.expr(n.Init, OpExpr)
+		for i := range n.List {
+			n.List[i].Cond = t.expr(n.List[i].Cond, OpExpr)
+			n.List[i].List = t.stmts(n.List[i].List)
+		}
+	case *ReturnStmt:
+		n.Value = t.expr(n.Value, OpExpr)
+	case *ThrowStmt:
+		n.Value = t.expr(n.Value, OpExpr)
+	case *WithStmt:
+		n.Cond = t.expr(n.Cond, OpExpr)
+		n.Body = t.stmt(n.Body)
+	case *LabelledStmt:
+		n.Value = t.stmt(n.Value)
+	case *TryStmt:
+		t.stmt(n.Body)
+		if n.Catch != nil {
+			if t.passes&DestructuringPass != 0 && isPattern(n.Binding) {
+				// catch ({a}) {}  =>  catch (_ref) { var {a} = _ref }
+				v := t.newVar("_ref", CatchDecl)
+				decl := &VarDecl{VarToken, []BindingElement{{n.Binding, v}}}
+				n.Binding = v
+				n.Catch.List = append([]IStmt{decl}, n.Catch.List...)
+			} else {
+				n.Binding = t.binding(n.Binding)
+			}
+			t.stmt(n.Catch)
+		}
+		if n.Finally != nil {
+			t.stmt(n.Finally)
+		}
+	case *ExportStmt:
+		if class, ok := n.Decl.(*ClassDecl); ok && !n.Default && t.passes&ClassPass != 0 {
+			// export class A {}  =>  export var A = ...
+			n.Decl = &VarDecl{VarToken, []BindingElement{{class.Name, t.expr(class, OpAssign)}}}
+		} else {
+			n.Decl = t.expr(n.Decl, OpAssign)
+		}
+	case *VarDecl:
+		t.varDecl(n)
+	case *FuncDecl:
+		t.function(&n.Params, &n.Body, false)
+	case *ClassDecl:
+		if t.passes&ClassPass != 0 && n.Name != nil {
+			// class A {}  =>  var A = ...
+			return &VarDecl{VarToken, []BindingElement{{n.Name, t.expr(n, OpAssign)}}}
+		}
+		t.expr(n, OpAssign)
+	}
+	return stmt
+}
+
+// forInit transforms the initializer of a for-in or for-of statement, moving destructuring to the start of the body.
+func (t *downleveler) forInit(init IExpr, body *BlockStmt) IExpr {
+	if t.passes&DestructuringPass != 0 {
+		if decl, ok := init.(*VarDecl); ok && len(decl.List) == 1 && isPattern(decl.List[0].Binding) {
+			// for (var [a] of b) {}  =>  for (var _ref of b) { var [a] = _ref }
+			declType := LexicalDecl
+			if decl.TokenType == VarToken {
+				declType = VariableDecl
+			}
+			v := t.newVar("_ref", declType)
+			body.List = append([]IStmt{&VarDecl{decl.TokenType, []BindingElement{{decl.List[0].Binding, v}}}}, body.List...)
+			return &VarDecl{decl.TokenType, []BindingElement{{Binding: v}}}
+		} else if isAssignmentPattern(init) {
+			// for ([a] of b) {}  =>  for (_ref of b) { [a] = _ref }
+			v := t.temp()
+			body.List = append([]IStmt{&ExprStmt{&BinaryExpr{EqToken, init, v}}}, body.List...)
+			return v
+		}
+	}
+	if _, ok := init.(*VarDecl); ok {
+		return t.expr(init, OpExpr)
+	}
+	return t.target(init)
+}
+
+func (t *downleveler) varDecl(n *VarDecl) {
+	list := make([]BindingElement, 0, len(n.List))
+	for _, item := range n.List {
+		if t.passes&DestructuringPass != 0 && isPattern(item.Binding) && item.Default != nil {
+			value := t.expr(item.Default, OpAssign)
+			v, ok := value.(*Var)
+			r := t.ref(value, ok && !bindsVar(item.Binding, v))
+			list = t.destructure(list, item.Binding, r)
+			if r.init != nil {
+				// pattern without bindings, the value must still be evaluated
+				list = append(list, BindingElement{r.v.(*Var), r.init})
+			}
+		} else {
+			item.Binding = t.binding(item.Binding)
+			item.Default = t.expr(item.Default, OpAssign)
+			list = append(list, item)
+		}
+	}
+	n.List = list
+}
+
+// binding transforms default values and computed property names in a binding pattern.
+func (t *downleveler) binding(binding IBinding) IBinding {
+	switch n := binding.(type) {
+	case *BindingArray:
+		for i := range n.List {
+			n.List[i].Binding = t.binding(n.List[i].Binding)
+			n.List[i].Default = t.expr(n.List[i].Default, OpAssign)
+		}
+		n.Rest = t.binding(n.Rest)
+	case *BindingObject:
+		for i := range n.List {
+			if n.List[i].Key != nil && n.List[i].Key.IsComputed() {
+				n.List[i].Key.Computed = t.expr(n.List[i].Key.Computed, OpAssign)
+			}
+			n.List[i].Value.Binding = t.binding(n.List[i].Value.Binding)
+			n.List[i].Value.Default = t.expr(n.List[i].Value.Default, OpAssign)
+		}
+	}
+	return binding
+}
+
+// target transforms an assignment target without rewriting the spread elements of assignment patterns.
+func (t *downleveler) target(target IExpr) IExpr {
+	switch n := target.(type) {
+	case *ArrayExpr:
+		for i := range n.List {
+			n.List[i].Value = t.target(n.List[i].Value)
+		}
+		return n
+	case *ObjectExpr:
+		for i := range n.List {
+			if n.List[i].Name != nil && n.List[i].Name.IsComputed() {
+				n.List[i].Name.Computed = t.expr(n.List[i].Name.Computed, OpAssign)
+			}
+			n.List[i].Value = t.target(n.List[i].Value)
+			n.List[i].Init = t.expr(n.List[i].Init, OpAssign)
+		}
+		return n
+	case *BinaryExpr:
+		if n.Op == EqToken {
+			// default value
+			n.X = t.target(n.X)
+			n.Y = t.expr(n.Y, OpAssign)
+			return n
+		}
+	}
+	return t.expr(target, OpLHS)
+}
+
+// function transforms the parameters and body of a function, arrow function, or method.
+func (t *downleveler) function(params *Params, body *BlockStmt, arrow bool) {
+	f := &downlevelFrame{body: body, arrow: arrow}
+	if method, ok := t.methods[body]; ok {
+		f.super, f.static = method.super, method.static
+	} else if arrow {
+		f.super, f.static = t.frame().super, t.frame().static
+	}
+	t.frames = append(t.frames, f)
+
+	var prologue []IStmt
+	for i, item := range params.List {
+		if t.passes&DestructuringPass != 0 && isPattern(item.Binding) {
+			// function f({a} = b) {}  =>  function f(_ref) { var {a} = _ref === void 0 ? b : _ref }
+			v := t.newVar("_ref", ArgumentDecl)
+			body.Scope.Declared = append(body.Scope.Declared, v)
+			value := IExpr(v)
+			if item.Default != nil {
+				value = &CondExpr{isUndefined(v), item.Default, v}
+			}
+			prologue = append(prologue, &VarDecl{VarToken, []BindingElement{{item.Binding, value}}})
+			params.List[i] = BindingElement{Binding: v}
+		} else if t.passes&DestructuringPass != 0 && item.Default != nil {
+			// function f(a = b) {}  =>  function f(a) { if (a === void 0) { a = b } }
+			v := item.Binding.(*Var)
+			prologue = append(prologue, &IfStmt{isUndefined(v), &ExprStmt{&BinaryExpr{EqToken, v, item.Default}}, nil})
+			params.List[i].Default = nil
+		} else {
+			params.List[i].Binding = t.binding(item.Binding)
+			params.List[i].Default = t.expr(item.Default, OpAssign)
+		}
+	}
+	prologue = t.stmts(prologue)
+	if params.Rest != nil && t.passes&DestructuringPass != 0 && (!arrow || t.passes&ArrowFuncPass != 0) {
+		// function f(...a) {}  =>  function f() { var a = Array.prototype.slice.call(arguments, 0) }
+		// arguments must not be captured, so the declaration is not transformed
+		value := arraySlice(globalVar("arguments"), decimal(len(params.List)))
+		if v, ok := params.Rest.(*Var); ok {
+			prologue = append(prologue, &VarDecl{VarToken, []BindingElement{{v, value}}})
+		} else {
+			prologue = append(prologue, &VarDecl{VarToken, t.destructure(nil, params.Rest, t.ref(value, false))})
+		}
+		params.Rest = nil
+	} else {
+		params.Rest = t.binding(params.Rest)
+	}
+	body.List = t.stmts(body.List)
+
+	t.frames = t.frames[:len(t.frames)-1]
+	t.declare(f, prologue)
+}
+
+////////////////////////////////////////////////////////////////
+
+// expr transforms an expression, and wraps the result in parentheses if its precedence is lower than prec.
+func (t *downleveler) expr(expr IExpr, prec OpPrec) IExpr {
+	if expr == nil {
+		return nil
+	}
+	return group(t.transform(expr), prec)
+}
+
+func (t *downleveler) transform(expr IExpr) IExpr {
+	switch n := expr.(type) {
+	case *Var:
+		if isGlobalVar(n, "arguments") {
+			if f := t.capture(); f != nil {
+				if f.arguments == nil {
+					f.arguments = t.newVar("_arguments", VariableDecl)
+				}
+				return f.arguments
+			}
+		}
+	case *LiteralExpr:
+		if n.TokenType == ThisToken {
+			return t.this()
+		} else if n.TokenType == SuperToken && t.passes&ArrowFuncPass != 0 && t.frame().arrow && t.frame().super == nil {
+			t.fail("super in an arrow function")
+		}
+	case *NewTargetExpr:
+		if f := t.capture(); f != nil {
+			if f.newTarget == nil {
+				f.newTarget = t.newVar("_newTarget", VariableDecl)
+			}
+			return f.newTarget
+		}
+	case *ArrayExpr:
+		for i := range n.List {
+			n.List[i].Value = t.expr(n.List[i].Value, OpAssign)
+		}
+		if t.passes&SpreadPass != 0 && hasSpread(n.List) {
+			return t.spreadArray(nil, n.List)
+		}
+	case *ObjectExpr:
+		for i := range n.List {
+			item := &n.List[i]
+			if item.Name != nil && item.Name.IsComputed() {
+				item.Name.Computed = t.expr(item.Name.Computed, OpAssign)
+			}
+			if method, ok := item.Value.(*MethodDecl); ok {
+				if method.Name.IsComputed() {
+					method.Name.Computed = t.expr(method.Name.Computed, OpAssign)
+				}
+				t.function(&method.Params, &method.Body, false)
+			} else {
+				item.Value = t.expr(item.Value, OpAssign)
+			}
+			item.Init = t.expr(item.Init, OpAssign)
+		}
+	case *TemplateExpr:
+		if t.passes&OptChainPass != 0 && hasOptChain(n) {
+			return t.optChain(n)
+		}
+		n.Tag = t.expr(n.Tag, n.Prec)
+		for i := range n.List {
+			n.List[i].Expr = t.expr(n.List[i].Expr, OpExpr)
+		}
+		if t.passes&TemplatePass != 0 {
+			return t.template(n)
+		}
+	case *GroupExpr:
+		n.X = t.expr(n.X, OpExpr)
+	case *DotExpr:
+		if t.passes&OptChainPass != 0 && hasOptChain(n) {
+			return t.optChain(n)
+		} else if super := t.super(n.X); super != nil {
+			return &DotExpr{super, n.Y, OpMember}
+		}
+		n.X = t.expr(n.X, n.Prec)
+	case *IndexExpr:
+		if t.passes&OptChainPass != 0 && hasOptChain(n) {
+			return t.optChain(n)
+		} else if super := t.super(n.X); super != nil {
+			return &IndexExpr{super, t.expr(n.Y, OpExpr), OpMember}
+		}
+		n.X = t.expr(n.X, n.Prec)
+		n.Y = t.expr(n.Y, OpExpr)
+	case *OptChainExpr:
+		if t.passes&OptChainPass != 0 {
+			return t.optChain(n)
+		}
+		n.X = t.expr(n.X, OpCall)
+		switch y := n.Y.(type) {
+		case *CallExpr:
+			y.Args = t.args(y.Args)
+		case *IndexExpr:
+			y.Y = t.expr(y.Y, OpExpr)
+		case *TemplateExpr:
+			for i := range y.List {
+				y.List[i].Expr = t.expr(y.List[i].Expr, OpExpr)
+			}
+		}
+	case *CallExpr:
+		if t.passes&OptChainPass != 0 && hasOptChain(n) {
+			return t.optChain(n)
+		}
+		args := t.args(n.Args)
+		if lit, ok := n.X.(*LiteralExpr); ok && lit.TokenType == SuperToken && t.frame().super != nil {
+			// super(a)  =>  _super.call(this, a)
+			return t.callThis(t.frame().super, t.this(), args, n.Pure)
+		}
+		switch x := n.X.(type) {
+		case *DotExpr:
+			if super := t.super(x.X); super != nil {
+				// super.f(a)  =>  _super.prototype.f.call(this, a)
+				return t.callThis(&DotExpr{super, x.Y, OpMember}, t.this(), args, n.Pure)
+			}
+		case *IndexExpr:
+			if super := t.super(x.X); super != nil {
+				return t.callThis(&IndexExpr{super, t.expr(x.Y, OpExpr), OpMember}, t.this(), args, n.Pure)
+			}
+		}
+		return t.call(t.expr(n.X, OpCall), args, n.Pure)
+	case *NewExpr:
+		n.X = t.expr(n.X, OpMember)
+		if n.Args != nil {
+			*n.Args = t.args(*n.Args)
+			if t.passes&SpreadPass != 0 && hasSpread(argsElements(*n.Args)) {
+				// new F(...a)  =>  new (Function.prototype.bind.apply(F, [null].concat(a)))()
+				bind := member(member(member(globalVar("Function"), "prototype"), "bind"), "apply")
+				array := t.spreadArray([]IExpr{&LiteralExpr{NullToken, []byte("null")}}, argsElements(*n.Args))
+				n.X = &GroupExpr{&CallExpr{bind, Args{[]Arg{{n.X, false}, {array, false}}}, false}}
+				n.Args = &Args{}
+			}
+		}
+	case *UnaryExpr:
+		if n.Op == DeleteToken && t.passes&OptChainPass != 0 && hasOptChain(n.X) {
+			// delete a?.b  =>  a == null ? true : delete a.b
+			cond, value := t.optChainCond(n.X)
+			return &CondExpr{cond, &LiteralExpr{TrueToken, []byte("true")}, &UnaryExpr{DeleteToken, value}}
+		} else if n.Op == PostIncrToken || n.Op == PostDecrToken {
+			n.X = t.expr(n.X, OpLHS)
+		} else {
+			n.X = t.expr(n.X, OpUnary)
+		}
+	case *BinaryExpr:
+		if n.Op == EqToken && t.passes&DestructuringPass != 0 && isAssignmentPattern(n.X) {
+			// [a, b] = c  =>  _ref = c, a = _ref[0], b = _ref[1], _ref
+			r := t.ref(t.expr(n.Y, OpAssign), false)
+			list := t.destructureAssign(nil, n.X, r)
+			if r.init != nil {
+				list = append(list, r.get())
+			}
+			return sequence(append(list, r.v))
+		} else if isAssignmentPattern(n.X) {
+			n.X = t.target(n.X)
+			n.Y = t.expr(n.Y, OpAssign)
+			break
+		}
+		prec := BinaryPrec(n.Op)
+		if prec == OpAssign {
+			n.X = t.expr(n.X, OpLHS)
+			n.Y = t.expr(n.Y, OpAssign)
+		} else if n.Op == ExpToken {
+			n.X = t.expr(n.X, OpUpdate)
+			n.Y = t.expr(n.Y, OpExp)
+		} else {
+			n.X = t.expr(n.X, prec)
+			n.Y = t.expr(n.Y, prec+1)
+		}
+	case *CondExpr:
+		n.Cond = t.expr(n.Cond, OpCoalesce)
+		n.X = t.expr(n.X, OpAssign)
+		n.Y = t.expr(n.Y, OpAssign)
+	case *YieldExpr:
+		n.X = t.expr(n.X, OpAssign)
+	case *ArrowFunc:
+		t.function(&n.Params, &n.Body, true)
+		if t.passes&ArrowFuncPass != 0 {
+			// a => b  =>  function (a) { return b }
+			return &FuncDecl{Async: n.Async, Params: n.Params, Body: n.Body}
+		}
+	case *FuncDecl:
+		t.function(&n.Params, &n.Body, false)
+	case *ClassDecl:
+		if t.passes&ClassPass != 0 {
+			if class := t.class(n); class != nil {
+				return t.expr(class, OpAssign)
+			}
+		}
+		n.Extends = t.expr(n.Extends, OpLHS)
+		for i := range n.Definitions {
+			if n.Definitions[i].Name.IsComputed() {
+				n.Definitions[i].Name.Computed = t.expr(n.Definitions[i].Name.Computed, OpAssign)
+			}
+			// field initializers are evaluated with this being the instance
+			t.frames = append(t.frames, &downlevelFrame{})
+			n.Definitions[i].Init = t.expr(n.Definitions[i].Init, OpAssign)
+			t.frames = t.frames[:len(t.frames)-1]
+		}
+		for _, method := range n.Methods {
+			if method.Name.IsComputed() {
+				method.Name.Computed = t.expr(method.Name.Computed, OpAssign)
+			}
+			t.function(&method.Params, &method.Body, false)
+		}
+	case *VarDecl:
+		t.varDecl(n)
+	}
+	return expr
+}
+
+func (t *downleveler) args(args Args) Args {
+	for i := range args.List {
+		args.List[i].Value = t.expr(args.List[i].Value, OpAssign)
+	}
+	return args
+}
+
+// super returns the replacement for super as the object of a member expression in a class method, or nil otherwise.
+func (t *downleveler) super(expr IExpr) IExpr {
+	if lit, ok := expr.(*LiteralExpr); !ok || lit.TokenType != SuperToken || t.frame().super == nil {
+		return nil
+	} else if t.frame().static {
+		return t.frame().super
+	}
+	return member(t.frame().super, "prototype")
+}
+
+// call returns a call expression, where spread arguments are passed using apply.
+func (t *downleveler) call(x IExpr, args Args, pure bool) IExpr {
+	if t.passes&SpreadPass == 0 || !hasSpread(argsElements(args)) {
+		return &CallExpr{group(x, OpCall), args, pure}
+	}
+
+	// f(...a)  =>  f.apply(void 0, a)
+	// o.f(...a)  =>  o.f.apply(o, a)
+	var this IExpr = voidZero()
+	switch m := x.(type) {
+	case *DotExpr:
+		r := t.ref(m.X, isSimple(m.X))
+		x, this = &DotExpr{group(r.get(), OpCall), m.Y, OpCall}, r.v
+	case *IndexExpr:
+		r := t.ref(m.X, isSimple(m.X))
+		x, this = &IndexExpr{group(r.get(), OpCall), m.Y, OpCall}, r.v
+	}
+	return &CallExpr{member(x, "apply"), Args{[]Arg{{this, false}, {t.applyArgs(args), false}}}, pure}
+}
+
+// callThis returns a call of function x with the given value for this.
+func (t *downleveler) callThis(x, this IExpr, args Args, pure bool) IExpr {
+	if t.passes&SpreadPass != 0 && hasSpread(argsElements(args)) {
+		return &CallExpr{member(x, "apply"), Args{[]Arg{{this, false}, {t.applyArgs(args), false}}}, pure}
+	}
+	return &CallExpr{member(x, "call"), Args{append([]Arg{{this, false}}, args.List...)}, pure}
+}
+
+// applyArgs returns an array-like value of the arguments to pass to apply.
+func (t *downleveler) applyArgs(args Args) IExpr {
+	if len(args.List) == 1 {
+		return args.List[0].Value
+	}
+	return t.spreadArray(nil, argsElements(args))
+}
+
+// spreadArray returns an array of prefix followed by the elements, where spread elements are concatenated.
+func (t *downleveler) spreadArray(prefix []IExpr, elements []Element) IExpr {
+	// [a, ...b, c]  =>  [a].concat(Array.prototype.slice.call(b), [c])
+	parts := []IExpr{}
+	array := &ArrayExpr{}
+	for _, value := range prefix {
+		array.List = append(array.List, Element{Value: value})
+	}
+	for _, item := range elements {
+		if item.Spread {
+			if len(array.List) != 0 {
+				parts = append(parts, array)
+				array = &ArrayExpr{}
+			}
+			parts = append(parts, arraySlice(item.Value, nil))
+		} else {
+			array.List = append(array.List, item)
+		}
+	}
+	if len(array.List) != 0 || len(parts) == 0 {
+		parts = append(parts, array)
+	}
+
+	args := Args{}
+	for _, part := range parts[1:] {
+		args.List = append(args.List, Arg{part, false})
+	}
+	if len(args.List) == 0 {
+		return parts[0]
+	}
+	return &CallExpr{member(parts[0], "concat"), args, false}
+}
+
+////////////////////////////////////////////////////////////////
+
+// downlevelRef is a value that is used multiple times but evaluated once, by assigning it to a temporary variable on first use.
+type downlevelRef struct {
+	v    IExpr
+	init IExpr // assigned to v on first use
+}
+
+// ref returns a reference to the value, which is used as is when simple is true.
+func (t *downleveler) ref(value IExpr, simple bool) *downlevelRef {
+	if simple {
+		return &downlevelRef{v: value}
+	}
+	return &downlevelRef{t.temp(), value}
+}
+
+func (r *downlevelRef) get() IExpr {
+	if r.init != nil {
+		init := r.init
+		r.init = nil
+		return &GroupExpr{&BinaryExpr{EqToken, r.v, init}}
+	}
+	return r.v
+}
+
+// destructure appends the variables of the binding pattern to the list of declarations.
+func (t *downleveler) destructure(list []BindingElement, binding IBinding, r *downlevelRef) []BindingElement {
+	bind := func(binding IBinding, value, def IExpr) {
+		if def != nil {
+			// (_ref2 = value) === void 0 ? def : _ref2
+			ref := t.ref(value, false)
+			value = &CondExpr{isUndefined(ref.get()), t.expr(def, OpAssign), ref.v}
+		}
+		if v, ok := binding.(*Var); ok {
+			list = append(list, BindingElement{v, value})
+		} else {
+			list = t.destructure(list, binding, t.ref(value, false))
+		}
+	}
+
+	switch n := binding.(type) {
+	case *BindingArray:
+		for i, item := range n.List {
+			if item.Binding != nil {
+				bind(item.Binding, &IndexExpr{r.get(), decimal(i), OpMember}, item.Default)
+			}
+		}
+		if n.Rest != nil {
+			bind(n.Rest, arraySlice(r.get(), decimal(len(n.List))), nil)
+		}
+	case *BindingObject:
+		keys := []IExpr{}
+		for _, item := range n.List {
+			key := t.propertyKey(*item.Key, &keys, n.Rest != nil)
+			bind(item.Value.Binding, propertyAccess(r.get(), key), item.Value.Default)
+		}
+		if n.Rest != nil {
+			list = append(list, BindingElement{n.Rest, objectRest(r.get(), keys)})
+		}
+	}
+	return list
+}
+
+// destructureAssign appends the assignments of the assignment pattern to the list of expressions.
+func (t *downleveler) destructureAssign(list []IExpr, target IExpr, r *downlevelRef) []IExpr {
+	assign := func(target, value, def IExpr) {
+		if lhs, ok := target.(*BinaryExpr); ok && lhs.Op == EqToken {
+			target, def = lhs.X, lhs.Y
+		}
+		if def != nil {
+			ref := t.ref(value, false)
+			value = &CondExpr{isUndefined(ref.get()), t.expr(def, OpAssign), ref.v}
+		}
+		if isAssignmentPattern(target) {
+			list = t.destructureAssign(list, target, t.ref(value, false))
+		} else {
+			list = append(list, &BinaryExpr{EqToken, t.expr(target, OpLHS), value})
+		}
+	}
+
+	switch n := target.(type) {
+	case *ArrayExpr:
+		for i, item := range n.List {
+			if item.Value == nil {
+				continue
+			} else if item.Spread {
+				assign(item.Value, arraySlice(r.get(), decimal(i)), nil)
+			} else {
+				assign(item.Value, &IndexExpr{r.get(), decimal(i), OpMember}, nil)
+			}
+		}
+	case *ObjectExpr:
+		hasRest := 0 < len(n.List) && n.List[len(n.List)-1].Spread
+		keys := []IExpr{}
+		for _, item := range n.List {
+			if item.Spread {
+				assign(item.Value, objectRest(r.get(), keys), nil)
+			} else {
+				key := t.propertyKey(*item.Name, &keys, hasRest)
+				assign(item.Value, propertyAccess(r.get(), key), item.Init)
+			}
+		}
+	}
+	return list
+}
+
+// propertyKey returns the property name of a destructured property, and appends it to keys when the pattern has a rest element. Computed names are assigned to a temporary variable to be used again by the rest element.
+func (t *downleveler) propertyKey(name PropertyName, keys *[]IExpr, hasRest bool) PropertyName {
+	if !hasRest {
+		if name.IsComputed() {
+			name.Computed = t.expr(name.Computed, OpExpr)
+		}
+		return name
+	} else if !name.IsComputed() {
+		*keys = append(*keys, propertyString(name))
+		return name
+	}
+	v := t.temp()
+	*keys = append(*keys, &BinaryExpr{AddToken, v, &LiteralExpr{StringToken, []byte(`""`)}})
+	return PropertyName{Computed: &BinaryExpr{EqToken, v, t.expr(name.Computed, OpAssign)}}
+}
+
+////////////////////////////////////////////////////////////////
+
+// optChain transforms a chain of member and call expressions that contains optional chains.
+func (t *downleveler) optChain(expr IExpr) IExpr {
+	// a?.b.c  =>  a == null ? void 0 : a.b.c
+	cond, value := t.optChainCond(expr)
+	return &CondExpr{cond, voidZero(), value}
+}
+
+// optChainCond returns the condition that short-circuits a chain that contains optional chains, and the chain without them.
+func (t *downleveler) optChainCond(expr IExpr) (IExpr, IExpr) {
+	checks := []IExpr{}
+	value := t.chain(expr, &checks)
+	cond := checks[0]
+	for _, check := range checks[1:] {
+		cond = &BinaryExpr{OrToken, cond, check}
+	}
+	return cond, value
+}
+
+// chain transforms a link of a chain, appending the null checks that short-circuit the chain.
+func (t *downleveler) chain(expr IExpr, checks *[]IExpr) IExpr {
+	if !hasOptChain(expr) {
+		return t.expr(expr, OpCall)
+	}
+
+	switch n := expr.(type) {
+	case *OptChainExpr:
+		x := t.chain(n.X, checks)
+		var this IExpr
+		if _, ok := n.Y.(*CallExpr); ok {
+			// keep the object as this value in a?.b?.()
+			switch m := x.(type) {
+			case *DotExpr:
+				r := t.ref(m.X, isSimple(m.X))
+				x, this = &DotExpr{group(r.get(), OpCall), m.Y, OpCall}, r.v
+			case *IndexExpr:
+				r := t.ref(m.X, isSimple(m.X))
+				x, this = &IndexExpr{group(r.get(), OpCall), m.Y, OpCall}, r.v
+			}
+		}
+		r := t.ref(x, isSimple(x))
+		*checks = append(*checks, &BinaryExpr{EqEqToken, group(r.get(), OpEquals), &LiteralExpr{NullToken, []byte("null")}})
+
+		switch y := n.Y.(type) {
+		case *CallExpr:
+			if this != nil {
+				return t.callThis(r.v, this, t.args(y.Args), y.Pure)
+			}
+			return t.call(r.v, t.args(y.Args), y.Pure)
+		case *IndexExpr:
+			return &IndexExpr{r.v, t.expr(y.Y, OpExpr), OpCall}
+		case *TemplateExpr:
+			template := &TemplateExpr{r.v, y.List, y.Tail, OpCall}
+			return t.transform(template)
+		case *LiteralExpr:
+			if y.TokenType == PrivateIdentifierToken {
+				t.fail("private member in an optional chain")
+			}
+			return &DotExpr{r.v, *y, OpCall}
+		}
+	case *DotExpr:
+		n.X = t.chain(n.X, checks)
+	case *IndexExpr:
+		n.X = t.chain(n.X, checks)
+		n.Y = t.expr(n.Y, OpExpr)
+	case *CallExpr:
+		return t.call(t.chain(n.X, checks), t.args(n.Args), n.Pure)
+	case *TemplateExpr:
+		n.Tag = t.chain(n.Tag, checks)
+		for i := range n.List {
+			n.List[i].Expr = t.expr(n.List[i].Expr, OpExpr)
+		}
+		if t.passes&TemplatePass != 0 {
+			return t.template(n)
+		}
+	}
+	return expr
+}
+
+// template transforms a template literal into a string concatenation, or a tagged template into a call.
+func (t *downleveler) template(n *TemplateExpr) IExpr {
+	texts := make([][]byte, 0, len(n.List)+1)
+	for _, item := range n.List {
+		texts = append(texts, item.Value[1:len(item.Value)-2]) // `...${ or }...${
+	}
+	texts = append(texts, n.Tail[1:len(n.Tail)-1]) // }...` or `...`
+
+	if n.Tag == nil {
+		// `a${b}c`  =>  "a" + b + "c"
+		var expr IExpr = &LiteralExpr{StringToken, templateString(texts[0])}
+		for i, item := range n.List {
+			expr = &BinaryExpr{AddToken, expr, group(item.Expr, OpMul)}
+			if text := texts[i+1]; len(text) != 0 {
+				expr = &BinaryExpr{AddToken, expr, &LiteralExpr{StringToken, templateString(text)}}
+			}
+		}
+		return expr
+	}
+
+	// f`a${b}`  =>  f(Object.freeze(Object.defineProperty(["a", ""], "raw", {value: Object.freeze(["a", ""])})), b)
+	cooked, raw := &ArrayExpr{}, &ArrayExpr{}
+	for _, text := range texts {
+		cooked.List = append(cooked.List, Element{Value: &LiteralExpr{StringToken, templateString(text)}})
+		raw.List = append(raw.List, Element{Value: &LiteralExpr{StringToken, templateRawString(text)}})
+	}
+	freeze := member(globalVar("Object"), "freeze")
+	descriptor := &ObjectExpr{[]Property{{Name: &PropertyName{Literal: LiteralExpr{IdentifierToken, []byte("value")}}, Value: &CallExpr{freeze, Args{[]Arg{{raw, false}}}, false}}}}
+	strings := &CallExpr{freeze, Args{[]Arg{{&CallExpr{member(globalVar("Object"), "defineProperty"), Args{[]Arg{{cooked, false}, {&LiteralExpr{StringToken, []byte(`"raw"`)}, false}, {descriptor, false}}}, false}, false}}}, false}
+
+	args := Args{[]Arg{{strings, false}}}
+	for _, item := range n.List {
+		args.List = append(args.List, Arg{group(item.Expr, OpAssign), false})
+	}
+	return &CallExpr{group(n.Tag, OpCall), args, false}
+}
+
+// class transforms a class into an immediately invoked function that returns the constructor function. It returns nil if the class cannot be transformed.
+func (t *downleveler) class(n *ClassDecl) IExpr {
+	for _, item := range n.Definitions {
+		if item.Name.Literal.TokenType == PrivateIdentifierToken {
+			t.fail("private class members")
+			return nil
+		}
+	}
+	for _, item := range n.Methods {
+		if item.Name.Literal.TokenType == PrivateIdentifierToken {
+			t.fail("private class members")
+			return nil
+		}
+	}
+
+	// class A extends B { constructor(a) { super(a) } f() {} }
+	// =>  (function (_super) { function A(a) { _super.call(this, a) } A.prototype = Object.create(_super && _super.prototype, ...); A.__proto__ = _super; A.prototype.f = function () {}; return A })(B)
+	name := n.Name
+	if name == nil {
+		name = t.newVar("_class", ExprDecl)
+	}
+	var super *Var
+	iife := &FuncDecl{}
+	for i := len(t.frames) - 1; 0 <= i; i-- {
+		if t.frames[i].body != nil {
+			iife.Body.Scope.Parent = &t.frames[i].body.Scope
+			break
+		}
+	}
+	iife.Body.Scope.Func = &iife.Body.Scope
+	iife.Body.IsGlobalOrFunc = true
+	args := Args{}
+	if n.Extends != nil {
+		super = t.newVar("_super", ArgumentDecl)
+		iife.Params.List = []BindingElement{{Binding: super}}
+		iife.Body.Scope.Declared = append(iife.Body.Scope.Declared, super)
+		args.List = []Arg{{n.Extends, false}}
+	}
+	iife.Body.Scope.Declared = append(iife.Body.Scope.Declared, name)
+
+	ctor := &FuncDecl{Name: name}
+	hasCtor := false
+	for _, method := range n.Methods {
+		if isConstructor(method) {
+			ctor.Params, ctor.Body = method.Params, method.Body
+			hasCtor = true
+		}
+	}
+	if !hasCtor {
+		ctor.Body.Scope.Parent = &iife.Body.Scope
+		ctor.Body.Scope.Func = &ctor.Body.Scope
+		ctor.Body.IsGlobalOrFunc = true
+		if super != nil {
+			// constructor(...args) { super(...args) }
+			ctor.Body.List = []IStmt{&ExprStmt{&CallExpr{member(super, "apply"), Args{[]Arg{{thisExpr(), false}, {globalVar("arguments"), false}}}, false}}}
+		}
+	}
+	if len(n.Definitions) != 0 {
+		// initialize fields after the super call
+		i := 0
+		for j, item := range ctor.Body.List {
+			if stmt, ok := item.(*ExprStmt); ok {
+				if call, ok := stmt.Value.(*CallExpr); ok {
+					if lit, ok := call.X.(*LiteralExpr); ok && lit.TokenType == SuperToken {
+						i = j + 1
+						break
+					}
+				}
+			}
+		}
+		if super != nil && i == 0 && len(ctor.Body.List) != 0 {
+			i = 1 // default constructor
+		}
+		fields := []IStmt{}
+		for _, item := range n.Definitions {
+			var init IExpr = voidZero()
+			if item.Init != nil {
+				init = item.Init
+			}
+			fields = append(fields, &ExprStmt{&BinaryExpr{EqToken, propertyAccess(thisExpr(), item.Name), init}})
+		}
+		ctor.Body.List = append(ctor.Body.List[:i:i], append(fields, ctor.Body.List[i:]...)...)
+	}
+	t.methods[&ctor.Body] = downlevelFrame{super: super}
+	iife.Body.List = append(iife.Body.List, ctor)
+
+	if super != nil {
+		// A.prototype = Object.create(_super && _super.prototype, {constructor: {value: A, writable: true, configurable: true}})
+		descriptor := &ObjectExpr{[]Property{
+			{Name: &PropertyName{Literal: LiteralExpr{IdentifierToken, []byte("value")}}, Value: name},
+			{Name: &PropertyName{Literal: LiteralExpr{IdentifierToken, []byte("writable")}}, Value: &LiteralExpr{TrueToken, []byte("true")}},
+			{Name: &PropertyName{Literal: LiteralExpr{IdentifierToken, []byte("configurable")}}, Value: &LiteralExpr{TrueToken, []byte("true")}},
+		}}
+		properties := &ObjectExpr{[]Property{{Name: &PropertyName{Literal: LiteralExpr{IdentifierToken, []byte("constructor")}}, Value: descriptor}}}
+		proto := &BinaryExpr{AndToken, super, member(super, "prototype")}
+		create := &CallExpr{member(globalVar("Object"), "create"), Args{[]Arg{{proto, false}, {properties, false}}}, false}
+		iife.Body.List = append(iife.Body.List,
+			&ExprStmt{&BinaryExpr{EqToken, member(name, "prototype"), create}},
+			&ExprStmt{&BinaryExpr{EqToken, member(name, "__proto__"), super}},
+		)
+	}
+
+	for _, method := range n.Methods {
+		if isConstructor(method) {
+			continue
+		}
+		var object IExpr = member(name, "prototype")
+		if method.Static {
+			object = name
+		}
+		f := &FuncDecl{Async: method.Async, Generator: method.Generator, Params: method.Params, Body: method.Body}
+		t.methods[&f.Body] = downlevelFrame{super: super, static: method.Static}
+		if method.Get || method.Set {
+			// Object.defineProperty(A.prototype, "f", {get: function () {}, configurable: true})
+			kind := "get"
+			if method.Set {
+				kind = "set"
+			}
+			descriptor := &ObjectExpr{[]Property{
+				{Name: &PropertyName{Literal: LiteralExpr{IdentifierToken, []byte(kind)}}, Value: f},
+				{Name: &PropertyName{Literal: LiteralExpr{IdentifierToken, []byte("configurable")}}, Value: &LiteralExpr{TrueToken, []byte("true")}},
+			}}
+			key := method.Name.Computed
+			if key == nil {
+				key = propertyString(method.Name)
+			}
+			define := &CallExpr{member(globalVar("Object"), "defineProperty"), Args{[]Arg{{object, false}, {key, false}, {descriptor, false}}}, false}
+			iife.Body.List = append(iife.Body.List, &ExprStmt{define})
+		} else {
+			iife.Body.List = append(iife.Body.List, &ExprStmt{&BinaryExpr{EqToken, propertyAccess(object, method.Name), f}})
+		}
+	}
+	iife.Body.List = append(iife.Body.List, &ReturnStmt{name})
+	return &CallExpr{&GroupExpr{iife}, args, false}
+}
+
+////////////////////////////////////////////////////////////////
+
+func thisExpr() IExpr {
+	return &LiteralExpr{ThisToken, []byte("this")}
+}
+
+func voidZero() IExpr {
+	return &UnaryExpr{VoidToken, &LiteralExpr{DecimalToken, []byte("0")}}
+}
+
+// globalVar returns a reference to a global variable.
+func globalVar(name string) *Var {
+	return &Var{[]byte(name), nil, 1, NoDecl}
+}
+
+func member(x IExpr, name string) *DotExpr {
+	return &DotExpr{group(x, OpCall), LiteralExpr{IdentifierToken, []byte(name)}, OpCall}
+}
+
+// isUndefined returns the expression `x === void 0`.
+func isUndefined(x IExpr) IExpr {
+	return &BinaryExpr{EqEqEqToken, group(x, OpEquals), voidZero()}
+}
+
+// arraySlice returns the expression `Array.prototype.slice.call(x, start)`.
+func arraySlice(x, start IExpr) IExpr {
+	args := Args{[]Arg{{group(x, OpAssign), false}}}
+	if start != nil {
+		args.List = append(args.List, Arg{start, false})
+	}
+	return &CallExpr{member(member(member(globalVar("Array"), "prototype"), "slice"), "call"), args, false}
+}
+
+// objectRest returns an expression that copies the own properties of x except those in keys.
+func objectRest(x IExpr, keys []IExpr) IExpr {
+	ast, err := Parse(parse.NewInputString("(function (o, k) { var r = {}; for (var p in o) if (Object.prototype.hasOwnProperty.call(o, p) && k.indexOf(p) < 0) r[p] = o[p]; return r })"))
+	if err != nil {
+		panic(err)
+	}
+	f := ast.List[0].(*ExprStmt).Value
+	array := &ArrayExpr{}
+	for _, key := range keys {
+		array.List = append(array.List, Element{Value: key})
+	}
+	return &CallExpr{f, Args{[]Arg{{group(x, OpAssign), false}, {array, false}}}, false}
+}
+
+// propertyAccess returns the member expression of x for the property name.
+func propertyAccess(x IExpr, name PropertyName) IExpr {
+	if name.IsComputed() {
+		return &IndexExpr{group(x, OpCall), name.Computed, OpCall}
+	} else if name.Literal.TokenType == StringToken || IsNumeric(name.Literal.TokenType) {
+		literal := name.Literal
+		return &IndexExpr{group(x, OpCall), &literal, OpCall}
+	}
+	return &DotExpr{group(x, OpCall), name.Literal, OpCall}
+}
+
+// propertyString returns the property name of a non-computed name as a string literal.
+func propertyString(name PropertyName) IExpr {
+	if name.Literal.TokenType == StringToken {
+		literal := name.Literal
+		return &literal
+	} else if name.Literal.TokenType == DecimalToken {
+		return &LiteralExpr{StringToken, []byte(`"` + string(name.Literal.Data) + `"`)}
+	} else if IsNumeric(name.Literal.TokenType) {
+		return &CallExpr{globalVar("String"), Args{[]Arg{{&LiteralExpr{name.Literal.TokenType, name.Literal.Data}, false}}}, false}
+	}
+	return &LiteralExpr{StringToken, []byte(`"` + string(name.Literal.Data) + `"`)}
+}
+
+// sequence returns the comma expression of the list.
+func sequence(list []IExpr) IExpr {
+	expr := list[0]
+	for _, item := range list[1:] {
+		expr = &BinaryExpr{CommaToken, expr, group(item, OpAssign)}
+	}
+	return expr
+}
+
+func decimal(i int) IExpr {
+	return &LiteralExpr{DecimalToken, []byte(strconv.Itoa(i))}
+}
+
+func isConstructor(method *MethodDecl) bool {
+	return !method.Static && !method.Name.IsComputed() && bytes.Equal(propertyKey(method.Name), []byte("constructor"))
+}
+
+func isPattern(binding IBinding) bool {
+	switch binding.(type) {
+	case *BindingArray, *BindingObject:
+		return true
+	}
+	return false
+}
+
+func isAssignmentPattern(expr IExpr) bool {
+	switch expr.(type) {
+	case *ArrayExpr, *ObjectExpr:
+		return true
+	}
+	return false
+}
+
+// isSimple returns true for expressions that can be evaluated multiple times without side effects.
+func isSimple(expr IExpr) bool {
+	switch n := expr.(type) {
+	case *Var:
+		return true
+	case *LiteralExpr:
+		return n.TokenType == ThisToken
+	}
+	return false
+}
+
+// bindsVar returns true if the variable is bound by the pattern.
+func bindsVar(binding IBinding, v *Var) bool {
+	for v.Link != nil {
+		v = v.Link
+	}
+	for _, bound := range bindingVars(binding) {
+		if bound == v {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSpread(elements []Element) bool {
+	for _, item := range elements {
+		if item.Spread {
+			return true
+		}
+	}
+	return false
+}
+
+func argsElements(args Args) []Element {
+	elements := make([]Element, len(args.List))
+	for i, item := range args.List {
+		elements[i] = Element{item.Value, item.Rest}
+	}
+	return elements
+}
+
+// hasOptChain returns true if the expression is a chain of member and call expressions that contains an optional chain.
+func hasOptChain(expr IExpr) bool {
+	for {
+		switch n := expr.(type) {
+		case *OptChainExpr:
+			return true
+		case *DotExpr:
+			expr = n.X
+		case *IndexExpr:
+			expr = n.X
+		case *CallExpr:
+			expr = n.X
+		case *TemplateExpr:
+			if n.Tag == nil {
+				return false
+			}
+			expr = n.Tag
+		default:
+			return false
+		}
+	}
+}
+
+// startsWithDecl returns true if an expression statement would start with a function, class, or object literal.
+func startsWithDecl(expr IExpr) bool {
+	for {
+		switch n := expr.(type) {
+		case *FuncDecl, *ClassDecl, *ObjectExpr:
+			return true
+		case *BinaryExpr:
+			expr = n.X
+		case *CondExpr:
+			expr = n.Cond
+		case *CallExpr:
+			expr = n.X
+		case *DotExpr:
+			expr = n.X
+		case *IndexExpr:
+			expr = n.X
+		case *OptChainExpr:
+			expr = n.X
+		case *TemplateExpr:
+			if n.Tag == nil {
+				return false
+			}
+			expr = n.Tag
+		case *UnaryExpr:
+			if n.Op != PostIncrToken && n.Op != PostDecrToken {
+				return false
+			}
+			expr = n.X
+		default:
+			return false
+		}
+	}
+}
+
+// group wraps the expression in parentheses if its precedence is lower than prec.
+func group(expr IExpr, prec OpPrec) IExpr {
+	if expr != nil && ExprPrec(expr) < prec {
+		return &GroupExpr{expr}
+	}
+	return expr
+}
+
+// templateString converts the text of a template literal into a double-quoted string literal.
+func templateString(text []byte) []byte {
+	s := make([]byte, 0, len(text)+2)
+	s = append(s, '"')
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c == '\\' && i+1 < len(text) {
+			if text[i+1] == 'u' && i+2 < len(text) && text[i+2] == '{' {
+				// code point escapes are not supported by ES5
+				if end := bytes.IndexByte(text[i+3:], '}'); end != -1 {
+					if r, err := strconv.ParseUint(string(text[i+3:i+3+end]), 16, 32); err == nil {
+						s = appendUnicodeEscape(s, rune(r))
+						i += 3 + end
+						continue
+					}
+				}
+			}
+			s = append(s, c, text[i+1])
+			i++
+			if text[i] == '\r' && i+1 < len(text) && text[i+1] == '\n' {
+				s = append(s, '\n')
+				i++
+			}
+		} else {
+			s = appendTemplateChar(s, text, &i)
+		}
+	}
+	return append(s, '"')
+}
+
+// templateRawString converts the raw text of a template literal into a double-quoted string literal.
+func templateRawString(text []byte) []byte {
+	s := make([]byte, 0, len(text)+2)
+	s = append(s, '"')
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' {
+			s = append(s, '\\', '\\')
+		} else {
+			s = appendTemplateChar(s, text, &i)
+		}
+	}
+	return append(s, '"')
+}
+
+// appendTemplateChar appends text[*i] to a string literal, where line terminators are normalized and escaped.
+func appendTemplateChar(s, text []byte, i *int) []byte {
+	c := text[*i]
+	switch {
+	case c == '"':
+		return append(s, '\\', '"')
+	case c == '\r':
+		if *i+1 < len(text) && text[*i+1] == '\n' {
+			*i++
+		}
+		return append(s, '\\', 'n')
+	case c == '\n':
+		return append(s, '\\', 'n')
+	case c == 0xE2 && *i+2 < len(text) && text[*i+1] == 0x80 && (text[*i+2] == 0xA8 || text[*i+2] == 0xA9):
+		// U+2028 and U+2029
+		*i += 2
+		return appendUnicodeEscape(s, 0x2000+rune(text[*i])-0x80)
+	}
+	return append(s, c)
+}
+
+// appendUnicodeEscape appends the \uXXXX escape of r, using a surrogate pair if needed.
+func appendUnicodeEscape(s []byte, r rune) []byte {
+	if 0xFFFF < r {
+		r -= 0x10000
+		s = appendUnicodeEscape(s, 0xD800+(r>>10))
+		return appendUnicodeEscape(s, 0xDC00+(r&0x3FF))
+	}
+	hex := strconv.FormatInt(int64(r), 16)
+	s = append(s, '\\', 'u')
+	for i := len(hex); i < 4; i++ {
+		s = append(s, '0')
+	}
+	return append(s, hex...)
+}
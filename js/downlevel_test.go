@@ -0,0 +1,103 @@
+package js
+
+import (
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestDownlevel(t *testing.T) {
+	var tests = []struct {
+		js       string
+		passes   DownlevelPass
+		expected string
+	}{
+		{"var f = a => a", ArrowFuncPass, "var f = function (a) { return a; }; "},
+		{"var f = async (a, b) => { return a }", ArrowFuncPass, "var f = async function (a, b) { return a; }; "},
+		{"function f() { return () => this.a + arguments[0] }", ArrowFuncPass, "function f () { var _this = this, _arguments = arguments; return function () { return _this.a + _arguments[0]; }; }; "},
+		{"\"use strict\"; var f = () => () => this", ArrowFuncPass, "\"use strict\"; var _this = this; var f = function () { return function () { return _this; }; }; "},
+		{"function f() { var g = () => new.target }", ArrowFuncPass, "function f () { var _newTarget = new.target; var g = function () { return _newTarget; }; }; "},
+		{"function f() { return () => [this, new.target] }", ArrowFuncPass, "function f () { var _this = this, _newTarget = new.target; return function () { return [_this, _newTarget]; }; }; "},
+		{"x => x", ArrowFuncPass, "(function (x) { return x; }); "},
+		{"var f = (a) => ({a})", ArrowFuncPass, "var f = function (a) { return ({a}); }; "},
+		{"class A {}", ClassPass, "var A = (function () { function A () { }; return A; })(); "},
+		{"class A { constructor(a) { this.a = a } f() {} static g() {} get h() { return 1 } set h(v) {} [\"i\"]() {} }", ClassPass, "var A = (function () { function A (a) { this.a = a; }; A.prototype.f = function () { }; A.g = function () { }; Object.defineProperty(A.prototype, \"h\", {get: function () { return 1; }, configurable: true}); Object.defineProperty(A.prototype, \"h\", {set: function (v) { }, configurable: true}); A.prototype[\"i\"] = function () { }; return A; })(); "},
+		{"class B extends A { b = 1; f() { return super.f(1) } }", ClassPass, "var B = (function (_super) { function B () { _super.apply(this, arguments); this.b = 1; }; B.prototype = Object.create(_super && _super.prototype, {constructor: {value: B, writable: true, configurable: true}}); B.__proto__ = _super; B.prototype.f = function () { return _super.prototype.f.call(this, 1); }; return B; })(A); "},
+		{"class B extends A { constructor() { super(); } static f() { return super.f } }", ClassPass, "var B = (function (_super) { function B () { _super.call(this); }; B.prototype = Object.create(_super && _super.prototype, {constructor: {value: B, writable: true, configurable: true}}); B.__proto__ = _super; B.f = function () { return _super.f; }; return B; })(A); "},
+		{"var A = class {}", ClassPass, "var A = (function () { function _class () { }; return _class; })(); "},
+		{"export class A {}", ClassPass, "export var A = (function () { function A () { }; return A; })(); "},
+		{"export default class A {}", ClassPass, "var A = (function () { function A () { }; return A; })(); export default A; "},
+		{"class _class {} var B = class {}", ClassPass, "var _class = (function () { function _class () { }; return _class; })(); var B = (function () { function _class2 () { }; return _class2; })(); "},
+		{"var s = `a`", TemplatePass, "var s = \"a\"; "},
+		{"var s = `a${b}c${d + e}`", TemplatePass, "var s = \"a\" + b + \"c\" + (d + e); "},
+		{"var s = `${b}`.length", TemplatePass, "var s = (\"\" + b).length; "},
+		{"var s = `\"\\u{1F600}\n`", TemplatePass, "var s = \"\\\"\\ud83d\\ude00\\n\"; "},
+		{"var s = tag`a${b}\\n`", TemplatePass, "var s = tag(Object.freeze(Object.defineProperty([\"a\", \"\\n\"], \"raw\", {value: Object.freeze([\"a\", \"\\\\n\"])})), b); "},
+		{"var [a, , b = 1, ...c] = d", DestructuringPass, "var _ref; var a = d[0], b = (_ref = d[2]) === void 0 ? 1 : _ref, c = Array.prototype.slice.call(d, 3); "},
+		{"var {a, b: {c} = {}, \"d\": e, [f]: g} = h", DestructuringPass, "var _ref, _ref2; var a = h.a, c = (_ref2 = (_ref = h.b) === void 0 ? {} : _ref).c, e = h.d, g = h[f]; "},
+		{"let {a, ...b} = c", DestructuringPass, "let a = c.a, b = (function (o, k) { var r = {}; for (var p in o) { if (Object.prototype.hasOwnProperty.call(o, p) && k.indexOf(p) < 0) { r[p] = o[p] }; }; return r; })(c, [\"a\"]); "},
+		{"var [a, b] = a", DestructuringPass, "var _ref; var a = (_ref = a)[0], b = _ref[1]; "},
+		{"[a, b] = [b, a]", DestructuringPass, "var _ref; a = (_ref = [b, a])[0] , b = _ref[1] , _ref; "},
+		{"({a, b: c = 1} = d)", DestructuringPass, "var _ref, _ref2; (a = (_ref = d).a , c = (_ref2 = _ref.b) === void 0 ? 1 : _ref2 , _ref); "},
+		{"function f({a}, b = 1, ...c) {}", DestructuringPass, "function f (_ref, b) { var a = _ref.a; if (b === void 0) { b = 1 }; var c = Array.prototype.slice.call(arguments, 2); }; "},
+		{"for (const [a, b] of c) {}", DestructuringPass, "for (const _ref of c) { const a = _ref[0], b = _ref[1]; }; "},
+		{"try {} catch ({message}) {}", DestructuringPass, "try { } catch(_ref) { var message = _ref.message; }; "},
+		{"f(...a)", SpreadPass, "f.apply(void 0, a); "},
+		{"f(a, ...b, c)", SpreadPass, "f.apply(void 0, [a].concat(Array.prototype.slice.call(b), [c])); "},
+		{"a.f(...b)", SpreadPass, "a.f.apply(a, b); "},
+		{"a().f(...b)", SpreadPass, "var _ref; (_ref = a()).f.apply(_ref, b); "},
+		{"new F(...a)", SpreadPass, "new (Function.prototype.bind.apply(F, [null].concat(Array.prototype.slice.call(a))))(); "},
+		{"var a = [...b]", SpreadPass, "var a = Array.prototype.slice.call(b); "},
+		{"var a = [1, ...b, 2]", SpreadPass, "var a = [1].concat(Array.prototype.slice.call(b), [2]); "},
+		{"a?.b", OptChainPass, "a == null ? void 0 : a.b; "},
+		{"a.b?.c.d", OptChainPass, "var _ref; (_ref = a.b) == null ? void 0 : _ref.c.d; "},
+		{"a?.[0]?.b", OptChainPass, "var _ref; a == null || (_ref = a[0]) == null ? void 0 : _ref.b; "},
+		{"a.b?.()", OptChainPass, "var _ref; (_ref = a.b) == null ? void 0 : _ref.call(a); "},
+		{"f()?.b", OptChainPass, "var _ref; (_ref = f()) == null ? void 0 : _ref.b; "},
+		{"delete a?.b", OptChainPass, "a == null ? true : delete a.b; "},
+		{"x = delete a.b?.[c]", OptChainPass, "var _ref; x = (_ref = a.b) == null ? true : delete _ref[c]; "},
+		{"x = (a?.b).c", OptChainPass, "x = (a == null ? void 0 : a.b).c; "},
+		{"class A extends B { f(...a) { return () => super.f(...a) } }", AllPasses, "var A = (function (_super) { function A () { _super.apply(this, arguments); }; A.prototype = Object.create(_super && _super.prototype, {constructor: {value: A, writable: true, configurable: true}}); A.__proto__ = _super; A.prototype.f = function () { var _this = this; var a = Array.prototype.slice.call(arguments, 0); return function () { return _super.prototype.f.apply(_this, a); }; }; return A; })(B); "},
+		{"var f = ([a], {b}) => `${a}${b}`", AllPasses, "var f = function (_ref, _ref2) { var a = _ref[0]; var b = _ref2.b; return \"\" + a + b; }; "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(tt.js))
+			test.Error(t, err)
+			test.Error(t, Downlevel(ast, tt.passes))
+			test.String(t, ast.JS(), tt.expected)
+		})
+	}
+}
+
+func TestDownlevelPasses(t *testing.T) {
+	// passes that are not selected leave the AST unchanged
+	js := "class A { f(a, ...b) { return () => `${this}` + [...b] + a?.c } }"
+	ast, err := Parse(parse.NewInputString(js))
+	test.Error(t, err)
+	expected := ast.JS()
+	test.Error(t, Downlevel(ast, 0))
+	test.String(t, ast.JS(), expected)
+}
+
+func TestDownlevelError(t *testing.T) {
+	var tests = []struct {
+		js     string
+		passes DownlevelPass
+		err    string
+	}{
+		{"class A { #a = 1 }", ClassPass, "cannot downlevel private class members"},
+		{"class A { f() { return () => super.f() } }", ArrowFuncPass, "cannot downlevel super in an arrow function"},
+		{"class A { a = () => this }", ArrowFuncPass, "cannot downlevel this, arguments, or new.target in an arrow function in a class field"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(tt.js))
+			test.Error(t, err)
+			err = Downlevel(ast, tt.passes)
+			test.That(t, err != nil, "must return error")
+			test.String(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package js
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+var (
+	varType   = reflect.TypeOf(Var{})
+	scopeType = reflect.TypeOf(Scope{})
+)
+
+// Dump returns an indented tree representation of a node, see Fdump.
+func Dump(n interface{}) string {
+	b := &bytes.Buffer{}
+	Fdump(b, n) // never fails for bytes.Buffer
+	return b.String()
+}
+
+// Fdump writes an indented tree representation of a node to w, similar to go/ast.Fprint. Every line shows the field name and the type of the node or value, where nil, false, zero, and empty fields are omitted. Scopes are numbered in the order they appear and variables are printed on a single line with their declaration type, the scope they are declared in, and their number of uses. Fields named Offset are always printed so that offsets show up for nodes that record them.
+func Fdump(w io.Writer, n interface{}) error {
+	v := reflect.ValueOf(n)
+	if v.IsValid() && v.Kind() != reflect.Ptr {
+		// make the value addressable so that scopes can be identified
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		v = ptr.Elem()
+	}
+
+	d := &dumper{
+		w:      w,
+		scopes: map[*Scope]int{},
+		vars:   map[*Var]int{},
+	}
+	d.collect(v)
+	d.dump(v)
+	d.printf("\n")
+	return d.err
+}
+
+type dumper struct {
+	w      io.Writer
+	err    error
+	indent int
+	scopes map[*Scope]int // scope IDs in order of appearance
+	vars   map[*Var]int   // scope IDs of declared variables
+}
+
+func (d *dumper) printf(format string, args ...interface{}) {
+	if d.err == nil {
+		_, d.err = fmt.Fprintf(d.w, format, args...)
+	}
+}
+
+func (d *dumper) newline() {
+	d.printf("\n")
+	for i := 0; i < d.indent; i++ {
+		d.printf(".  ")
+	}
+}
+
+// collect numbers all scopes and records in which scope variables are declared.
+func (d *dumper) collect(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() && v.Type() != reflect.PtrTo(varType) && v.Type() != reflect.PtrTo(scopeType) {
+			d.collect(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			d.collect(v.Index(i))
+		}
+	case reflect.Struct:
+		if v.Type() == scopeType {
+			if v.CanAddr() {
+				s := v.Addr().Interface().(*Scope)
+				if _, ok := d.scopes[s]; !ok {
+					d.scopes[s] = len(d.scopes)
+					for _, decl := range s.Declared {
+						d.vars[decl] = d.scopes[s]
+					}
+				}
+			}
+			return
+		}
+		// number scopes of a node before those of its children
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Type().Field(i); f.PkgPath == "" && f.Type == scopeType {
+				d.collect(v.Field(i))
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Type().Field(i); f.PkgPath == "" && f.Type != scopeType {
+				d.collect(v.Field(i))
+			}
+		}
+	}
+}
+
+func (d *dumper) scopeID(s *Scope) string {
+	if id, ok := d.scopes[s]; ok {
+		return strconv.Itoa(id)
+	}
+	return "?"
+}
+
+func (d *dumper) variable(v *Var) {
+	name := v.Data
+	links := 0
+	for v.Link != nil {
+		v = v.Link
+		links++
+	}
+	d.printf("*js.Var %s (%v", name, v.Decl)
+	if id, ok := d.vars[v]; ok {
+		d.printf(", scope %d", id)
+	} else if v.Decl == NoDecl {
+		d.printf(", undeclared")
+	}
+	d.printf(", uses %d", v.Uses)
+	if links != 0 {
+		d.printf(", linked")
+	}
+	d.printf(")")
+}
+
+func (d *dumper) variables(vs VarArray) {
+	d.printf("[")
+	for i, v := range vs {
+		if i != 0 {
+			d.printf(" ")
+		}
+		d.printf("%s", v.Data)
+	}
+	d.printf("]")
+}
+
+func (d *dumper) scope(s *Scope) {
+	d.printf("js.Scope (scope %s", d.scopeID(s))
+	if s.Parent != nil {
+		d.printf(", parent %s", d.scopeID(s.Parent))
+	}
+	if s.Func != nil && s.Func != s {
+		d.printf(", func %s", d.scopeID(s.Func))
+	}
+	d.printf(") {")
+	d.indent++
+	d.newline()
+	d.printf("Declared: ")
+	d.variables(s.Declared)
+	d.newline()
+	d.printf("Undeclared: ")
+	d.variables(s.Undeclared)
+	if s.IsGlobalOrFunc {
+		d.newline()
+		d.printf("IsGlobalOrFunc: true")
+	}
+	if s.HasWith {
+		d.newline()
+		d.printf("HasWith: true")
+	}
+	if s.IsStrict {
+		d.newline()
+		d.printf("IsStrict: true")
+	}
+	d.indent--
+	d.newline()
+	d.printf("}")
+}
+
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map:
+		return v.IsNil()
+	case reflect.Slice:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.String:
+		return v.Len() == 0
+	}
+	return false
+}
+
+func (d *dumper) dump(v reflect.Value) {
+	if !v.IsValid() {
+		d.printf("nil")
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			d.printf("nil")
+			return
+		}
+		d.dump(v.Elem())
+		return
+	case reflect.Ptr:
+		if v.IsNil() {
+			d.printf("nil")
+		} else if v.Type() == reflect.PtrTo(varType) {
+			d.variable(v.Interface().(*Var))
+		} else if v.Type() == reflect.PtrTo(scopeType) {
+			d.printf("*js.Scope (scope %s)", d.scopeID(v.Interface().(*Scope)))
+		} else {
+			d.printf("*")
+			d.dump(v.Elem())
+		}
+		return
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			d.printf("%q", v.Bytes())
+			return
+		}
+		fallthrough
+	case reflect.Array:
+		d.printf("%s (len = %d) {", v.Type(), v.Len())
+		d.indent++
+		for i := 0; i < v.Len(); i++ {
+			d.newline()
+			d.printf("%d: ", i)
+			d.dump(v.Index(i))
+		}
+		d.indent--
+		if v.Len() != 0 {
+			d.newline()
+		}
+		d.printf("}")
+		return
+	case reflect.Struct:
+		if v.Type() == varType {
+			if v.CanAddr() {
+				d.variable(v.Addr().Interface().(*Var))
+			} else {
+				w := v.Interface().(Var)
+				d.variable(&w)
+			}
+			return
+		} else if v.Type() == scopeType {
+			if v.CanAddr() {
+				d.scope(v.Addr().Interface().(*Scope))
+			} else {
+				s := v.Interface().(Scope)
+				d.scope(&s)
+			}
+			return
+		}
+		d.printf("%s {", v.Type())
+		d.indent++
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" || f.Type != scopeType && f.Name != "Offset" && isZero(v.Field(i)) {
+				continue
+			}
+			d.newline()
+			d.printf("%s: ", f.Name)
+			d.dump(v.Field(i))
+		}
+		d.indent--
+		d.newline()
+		d.printf("}")
+		return
+	}
+
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		d.printf("%s", s)
+	} else if v.Kind() == reflect.String {
+		d.printf("%q", v.String())
+	} else {
+		d.printf("%v", v.Interface())
+	}
+}
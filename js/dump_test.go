@@ -0,0 +1,54 @@
+package js
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestDump(t *testing.T) {
+	var tests = []struct {
+		n        interface{}
+		expected string
+	}{
+		{nil, "nil\n"},
+		{&Var{Data: []byte("a"), Decl: LexicalDecl, Uses: 2}, "*js.Var a (LexicalDecl, uses 2)\n"},
+		{&EmptyStmt{}, "*js.EmptyStmt {\n}\n"},
+		{&UnaryExpr{Op: NotToken, X: &LiteralExpr{TrueToken, []byte("true")}}, "*js.UnaryExpr {\n.  Op: !\n.  X: *js.LiteralExpr {\n.  .  TokenType: true\n.  .  Data: \"true\"\n.  }\n}\n"},
+		{[]IExpr{nil}, "[]js.IExpr (len = 1) {\n.  0: nil\n}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			test.String(t, Dump(tt.n), tt.expected)
+		})
+	}
+}
+
+// TestDumpGolden compares the dumps of the files in testdata/dump to their golden files, run with -update to regenerate them.
+func TestDumpGolden(t *testing.T) {
+	filenames, err := filepath.Glob("testdata/dump/*.js")
+	test.Error(t, err)
+	for _, filename := range filenames {
+		t.Run(filename, func(t *testing.T) {
+			src, err := ioutil.ReadFile(filename)
+			test.Error(t, err)
+			ast, err := Parse(parse.NewInputBytes(src))
+			test.Error(t, err)
+
+			golden := strings.TrimSuffix(filename, ".js") + ".golden"
+			if *update {
+				test.Error(t, ioutil.WriteFile(golden, []byte(Dump(ast)), 0644))
+			}
+			expected, err := ioutil.ReadFile(golden)
+			test.Error(t, err)
+			test.String(t, Dump(ast), string(expected))
+		})
+	}
+}
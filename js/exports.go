@@ -0,0 +1,486 @@
+package js
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// ValueType is the type of a value as inferred from its literal expression.
+type ValueType uint16
+
+// ValueType values.
+const (
+	UnknownValue ValueType = iota // cannot be inferred without evaluation
+	UndefinedValue
+	NullValue
+	BooleanValue
+	NumberValue
+	BigIntValue
+	StringValue
+	RegExpValue
+	ArrayValue
+	ObjectValue
+	FunctionValue
+	ClassValue
+)
+
+func (t ValueType) String() string {
+	switch t {
+	case UnknownValue:
+		return "unknown"
+	case UndefinedValue:
+		return "undefined"
+	case NullValue:
+		return "null"
+	case BooleanValue:
+		return "boolean"
+	case NumberValue:
+		return "number"
+	case BigIntValue:
+		return "bigint"
+	case StringValue:
+		return "string"
+	case RegExpValue:
+		return "RegExp"
+	case ArrayValue:
+		return "Array"
+	case ObjectValue:
+		return "object"
+	case FunctionValue:
+		return "function"
+	case ClassValue:
+		return "class"
+	}
+	return "Invalid(" + strconv.Itoa(int(t)) + ")"
+}
+
+// InferValueType returns the type of the value of an expression when it can be determined from its literals and operators alone. It returns UnknownValue otherwise, for example for variables and calls.
+func InferValueType(expr IExpr) ValueType {
+	switch n := expr.(type) {
+	case *LiteralExpr:
+		switch n.TokenType {
+		case StringToken:
+			return StringValue
+		case TrueToken, FalseToken:
+			return BooleanValue
+		case NullToken:
+			return NullValue
+		case RegExpToken:
+			return RegExpValue
+		case BigIntToken:
+			return BigIntValue
+		}
+		if IsNumeric(n.TokenType) {
+			return NumberValue
+		}
+	case *Var:
+		if n.Decl == NoDecl && bytes.Equal(n.Name(), []byte("undefined")) {
+			return UndefinedValue
+		}
+	case *GroupExpr:
+		return InferValueType(n.X)
+	case *ArrayExpr:
+		return ArrayValue
+	case *ObjectExpr:
+		return ObjectValue
+	case *TemplateExpr:
+		if n.Tag == nil {
+			return StringValue
+		}
+	case *FuncDecl, *ArrowFunc:
+		return FunctionValue
+	case *ClassDecl:
+		return ClassValue
+	case *UnaryExpr:
+		switch n.Op {
+		case NotToken, DeleteToken:
+			return BooleanValue
+		case TypeofToken:
+			return StringValue
+		case VoidToken:
+			return UndefinedValue
+		case PosToken:
+			return NumberValue
+		case NegToken, BitNotToken, PreIncrToken, PreDecrToken, PostIncrToken, PostDecrToken:
+			return numericValueType(InferValueType(n.X))
+		}
+	case *BinaryExpr:
+		switch n.Op {
+		case EqEqToken, NotEqToken, EqEqEqToken, NotEqEqToken, LtToken, LtEqToken, GtToken, GtEqToken, InToken, InstanceofToken:
+			return BooleanValue
+		case AddToken:
+			x, y := InferValueType(n.X), InferValueType(n.Y)
+			if x == StringValue || y == StringValue {
+				return StringValue
+			} else if x == NumberValue && y == NumberValue || x == BigIntValue && y == BigIntValue {
+				return x
+			}
+		case SubToken, MulToken, DivToken, ModToken, ExpToken, LtLtToken, GtGtToken, BitAndToken, BitOrToken, BitXorToken:
+			if x, y := numericValueType(InferValueType(n.X)), numericValueType(InferValueType(n.Y)); x == y {
+				return x
+			}
+		case GtGtGtToken:
+			return NumberValue
+		case EqToken, CommaToken:
+			return InferValueType(n.Y)
+		}
+	case *CondExpr:
+		if x, y := InferValueType(n.X), InferValueType(n.Y); x == y {
+			return x
+		}
+	}
+	return UnknownValue
+}
+
+// numericValueType returns the type of an operand after conversion to a number or bigint by a numeric operator. Objects and unknown values may convert to either.
+func numericValueType(t ValueType) ValueType {
+	switch t {
+	case UndefinedValue, NullValue, BooleanValue, NumberValue, StringValue:
+		return NumberValue
+	case BigIntValue:
+		return BigIntValue
+	}
+	return UnknownValue
+}
+
+////////////////////////////////////////////////////////////////
+
+// ExportKind specifies the kind of declaration of an exported binding.
+type ExportKind uint16
+
+// ExportKind values.
+const (
+	UnknownExport   ExportKind = iota // export {a} where a is not declared in the module
+	ExprExport                        // export default expression
+	FunctionExport                    // function
+	ClassExport                       // class
+	VarExport                         // var
+	LetExport                         // let
+	ConstExport                       // const
+	NamespaceExport                   // export * as a from "module"
+	ReExport                          // export {a} from "module"
+	StarExport                        // export * from "module"
+)
+
+func (kind ExportKind) String() string {
+	switch kind {
+	case UnknownExport:
+		return "UnknownExport"
+	case ExprExport:
+		return "ExprExport"
+	case FunctionExport:
+		return "FunctionExport"
+	case ClassExport:
+		return "ClassExport"
+	case VarExport:
+		return "VarExport"
+	case LetExport:
+		return "LetExport"
+	case ConstExport:
+		return "ConstExport"
+	case NamespaceExport:
+		return "NamespaceExport"
+	case ReExport:
+		return "ReExport"
+	case StarExport:
+		return "StarExport"
+	}
+	return "Invalid(" + strconv.Itoa(int(kind)) + ")"
+}
+
+// Param is a function parameter of an exported function.
+type Param struct {
+	Name        []byte   // nil for binding patterns
+	Binding     IBinding // can be nil (in case of ellision)
+	Default     IExpr    // can be nil
+	DefaultType ValueType
+	Rest        bool
+}
+
+// Export is an exported binding of a module.
+type Export struct {
+	Name   []byte // exported name, which is default for default exports and * for export * from "module"
+	Local  []byte // local or imported name, can be nil
+	Module []byte // module specifier including quotes for re-exports, can be nil
+	Kind   ExportKind
+	Type   ValueType // type of the initializer for variables, FunctionValue for functions, ClassValue for classes
+
+	// only for functions, which includes variables initialized by a function or arrow function expression
+	Async     bool
+	Generator bool
+	Params    []Param
+}
+
+func (e Export) String() string {
+	s := e.Kind.String() + "(" + string(e.Name)
+	if e.Local != nil && !bytes.Equal(e.Local, e.Name) {
+		s += " = " + string(e.Local)
+	}
+	if e.Module != nil {
+		s += " from " + string(e.Module)
+	}
+	if e.Type != UnknownValue {
+		s += ": " + e.Type.String()
+	}
+	if e.Type == FunctionValue {
+		s += " ("
+		for i, param := range e.Params {
+			if i != 0 {
+				s += ", "
+			}
+			if param.Rest {
+				s += "..."
+			}
+			if param.Name != nil {
+				s += string(param.Name)
+			} else if param.Binding != nil {
+				s += param.Binding.JS()
+			}
+			if param.Default != nil {
+				s += " = " + param.DefaultType.String()
+			}
+		}
+		s += ")"
+	}
+	return s + ")"
+}
+
+var (
+	defaultBytes = []byte("default")
+	starBytes    = []byte("*")
+)
+
+// Exports returns all exported bindings of a module in order of appearance. For `export {a}` the declaration of a is looked up in the top-level statements of the module.
+func Exports(ast *AST) []Export {
+	exports := []Export{}
+	for _, item := range ast.List {
+		exportStmt, ok := item.(*ExportStmt)
+		if !ok {
+			continue
+		}
+
+		if exportStmt.Default {
+			export := exportOfExpr(exportStmt.Decl)
+			export.Name = defaultBytes
+			export.Local = declName(exportStmt.Decl, export.Local)
+			exports = append(exports, export)
+		} else if exportStmt.Decl != nil {
+			exports = append(exports, exportsOfDecl(exportStmt.Decl)...)
+		} else if exportStmt.Module != nil {
+			for _, alias := range exportStmt.List {
+				if alias.Binding == nil {
+					continue // trailing comma
+				} else if alias.Name == nil && bytes.Equal(alias.Binding, starBytes) {
+					exports = append(exports, Export{Name: starBytes, Module: exportStmt.Module, Kind: StarExport})
+				} else if bytes.Equal(alias.Name, starBytes) {
+					exports = append(exports, Export{Name: alias.Binding, Module: exportStmt.Module, Kind: NamespaceExport, Type: ObjectValue})
+				} else {
+					local := alias.Name
+					if local == nil {
+						local = alias.Binding
+					}
+					exports = append(exports, Export{Name: alias.Binding, Local: local, Module: exportStmt.Module, Kind: ReExport})
+				}
+			}
+		} else {
+			for _, alias := range exportStmt.List {
+				if alias.Binding == nil {
+					continue // trailing comma
+				}
+				local := alias.Name
+				if local == nil {
+					local = alias.Binding
+				}
+				export := Export{Kind: UnknownExport}
+				if decl := findModuleDecl(ast.List, local); decl != nil {
+					for _, e := range exportsOfDecl(decl) {
+						if bytes.Equal(e.Local, local) {
+							export = e
+							break
+						}
+					}
+				}
+				export.Name = alias.Binding
+				export.Local = local
+				exports = append(exports, export)
+			}
+		}
+	}
+	return exports
+}
+
+// exportOfExpr returns the export of a default export, or of a variable with an initializer. The names of function and class expressions are not bindings of the module and are not set as Local. Parenthesized functions and classes are expressions, but their parameters are still listed.
+func exportOfExpr(expr IExpr) (export Export) {
+	export.Kind = ExprExport
+	export.Type = InferValueType(expr)
+	grouped := false
+	for {
+		if group, ok := expr.(*GroupExpr); ok {
+			expr = group.X
+			grouped = true
+		} else {
+			break
+		}
+	}
+	switch n := expr.(type) {
+	case *FuncDecl:
+		if !grouped {
+			export.Kind = FunctionExport
+		}
+		export.Async = n.Async
+		export.Generator = n.Generator
+		export.Params = exportParams(n.Params)
+	case *ArrowFunc:
+		export.Async = n.Async
+		export.Params = exportParams(n.Params)
+	case *ClassDecl:
+		if !grouped {
+			export.Kind = ClassExport
+		}
+	case *Var:
+		export.Local = n.Name()
+	}
+	return
+}
+
+// declName returns the name that a function or class declaration binds, or local for other expressions.
+func declName(decl IExpr, local []byte) []byte {
+	switch n := decl.(type) {
+	case *FuncDecl:
+		if n.Name != nil {
+			return n.Name.Data
+		}
+	case *ClassDecl:
+		if n.Name != nil {
+			return n.Name.Data
+		}
+	}
+	return local
+}
+
+// exportsOfDecl returns the exports of a function, class, or variable declaration.
+func exportsOfDecl(decl IExpr) []Export {
+	switch n := decl.(type) {
+	case *FuncDecl, *ClassDecl:
+		export := exportOfExpr(n)
+		export.Local = declName(n, nil)
+		export.Name = export.Local
+		return []Export{export}
+	case *VarDecl:
+		kind := VarExport
+		if n.TokenType == LetToken {
+			kind = LetExport
+		} else if n.TokenType == ConstToken {
+			kind = ConstExport
+		}
+
+		exports := []Export{}
+		for _, item := range n.List {
+			if v, ok := item.Binding.(*Var); ok {
+				export := Export{}
+				if item.Default != nil {
+					export = exportOfExpr(item.Default)
+				} else {
+					export.Type = UndefinedValue
+				}
+				export.Name = v.Data
+				export.Local = v.Data
+				export.Kind = kind
+				exports = append(exports, export)
+			} else {
+				for _, v := range bindingVars(item.Binding) {
+					exports = append(exports, Export{Name: v.Data, Local: v.Data, Kind: kind})
+				}
+			}
+		}
+		return exports
+	}
+	return nil
+}
+
+// exportParams returns the parameters of a function with the types of their default values.
+func exportParams(params Params) []Param {
+	list := make([]Param, 0, len(params.List)+1)
+	for _, item := range params.List {
+		param := Param{Binding: item.Binding, Default: item.Default}
+		if v, ok := item.Binding.(*Var); ok {
+			param.Name = v.Data
+		}
+		if item.Default != nil {
+			param.DefaultType = InferValueType(item.Default)
+		}
+		list = append(list, param)
+	}
+	if params.Rest != nil {
+		param := Param{Binding: params.Rest, Rest: true}
+		if v, ok := params.Rest.(*Var); ok {
+			param.Name = v.Data
+		}
+		list = append(list, param)
+	}
+	return list
+}
+
+// findModuleDecl returns the top-level declaration that declares the given name, either exported or not.
+func findModuleDecl(list []IStmt, name []byte) IExpr {
+	for _, item := range list {
+		var decl IExpr
+		switch n := item.(type) {
+		case *FuncDecl:
+			decl = n
+		case *ClassDecl:
+			decl = n
+		case *VarDecl:
+			decl = n
+		case *ExportStmt:
+			if n.Default {
+				continue
+			}
+			decl = n.Decl
+		}
+
+		switch n := decl.(type) {
+		case *FuncDecl:
+			if n.Name != nil && bytes.Equal(n.Name.Data, name) {
+				return n
+			}
+		case *ClassDecl:
+			if n.Name != nil && bytes.Equal(n.Name.Data, name) {
+				return n
+			}
+		case *VarDecl:
+			for _, item := range n.List {
+				for _, v := range bindingVars(item.Binding) {
+					if bytes.Equal(v.Data, name) {
+						return n
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// bindingVars returns all variables bound by a binding in order of appearance.
+func bindingVars(ibinding IBinding) []*Var {
+	switch n := ibinding.(type) {
+	case *Var:
+		return []*Var{n}
+	case *BindingArray:
+		vars := []*Var{}
+		for _, item := range n.List {
+			vars = append(vars, bindingVars(item.Binding)...)
+		}
+		return append(vars, bindingVars(n.Rest)...)
+	case *BindingObject:
+		vars := []*Var{}
+		for _, item := range n.List {
+			vars = append(vars, bindingVars(item.Value.Binding)...)
+		}
+		if n.Rest != nil {
+			vars = append(vars, n.Rest)
+		}
+		return vars
+	}
+	return nil
+}
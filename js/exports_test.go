@@ -0,0 +1,91 @@
+package js
+
+import (
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestExports(t *testing.T) {
+	var tests = []struct {
+		js       string
+		expected []string
+	}{
+		{"var a = 5", []string{}},
+		{"export var a", []string{"VarExport(a: undefined)"}},
+		{"export let a = 'str', b = [1]", []string{"LetExport(a: string)", "LetExport(b: Array)"}},
+		{"export const {a, b: [c]} = d", []string{"ConstExport(a)", "ConstExport(c)"}},
+		{"export const f = (a, b = 5, ...c) => a", []string{"ConstExport(f: function (a, b = number, ...c))"}},
+		{"export function f(a = {}, [b] = [], {c}) {}", []string{"FunctionExport(f: function (a = object, [b] = Array, { c }))"}},
+		{"export async function* f(a = `tpl`) {}", []string{"FunctionExport(f: function (a = string))"}},
+		{"export class A {}", []string{"ClassExport(A: class)"}},
+		{"export default function(a = !b) {}", []string{"FunctionExport(default: function (a = boolean))"}},
+		{"export default function f() {}", []string{"FunctionExport(default = f: function ())"}},
+		{"export default class A {}", []string{"ClassExport(default = A: class)"}},
+		{"export default 5 + 3", []string{"ExprExport(default: number)"}},
+		{"export default a", []string{"ExprExport(default = a)"}},
+		{"export default (function(a) {})", []string{"ExprExport(default: function (a))"}},
+		{"export default (function f() {})", []string{"ExprExport(default: function ())"}},
+		{"export default ((class A {}))", []string{"ExprExport(default: class)"}},
+		{"export const a = function f() {}", []string{"ConstExport(a: function ())"}},
+		{"function f(a) {}; const b = null; export {f, b as c, d}", []string{"FunctionExport(f: function (a))", "ConstExport(c = b: null)", "UnknownExport(d)"}},
+		{"export {a as b, c,} from 'mod'", []string{"ReExport(b = a from 'mod')", "ReExport(c from 'mod')"}},
+		{"export * from 'mod'", []string{"StarExport(* from 'mod')"}},
+		{"export * as ns from 'mod'", []string{"NamespaceExport(ns from 'mod': object)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(tt.js))
+			test.Error(t, err)
+
+			exports := []string{}
+			for _, export := range Exports(ast) {
+				exports = append(exports, export.String())
+			}
+			test.T(t, exports, tt.expected)
+		})
+	}
+}
+
+func TestInferValueType(t *testing.T) {
+	var tests = []struct {
+		js       string
+		expected ValueType
+	}{
+		{"a", UnknownValue},
+		{"undefined", UndefinedValue},
+		{"void 0", UndefinedValue},
+		{"null", NullValue},
+		{"a == b", BooleanValue},
+		{"0x5", NumberValue},
+		{"-5n", BigIntValue},
+		{"5n * 2n", BigIntValue},
+		{"-a", UnknownValue},
+		{"-'5'", NumberValue},
+		{"a++", UnknownValue},
+		{"a - b", UnknownValue},
+		{"a - 1", UnknownValue},
+		{"1 - null", NumberValue},
+		{"5n - 1", UnknownValue},
+		{"+a", NumberValue},
+		{"a >>> 1", NumberValue},
+		{"'a' + b", StringValue},
+		{"typeof a", StringValue},
+		{"/re/", RegExpValue},
+		{"[]", ArrayValue},
+		{"({})", ObjectValue},
+		{"tag`tpl`", UnknownValue},
+		{"a ? 1 : 2", NumberValue},
+		{"a ? 1 : ''", UnknownValue},
+		{"(function(){})", FunctionValue},
+		{"(class{})", ClassValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(tt.js))
+			test.Error(t, err)
+			test.T(t, InferValueType(ast.List[0].(*ExprStmt).Value), tt.expected)
+		})
+	}
+}
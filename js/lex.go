@@ -485,6 +485,20 @@ func (l *Lexer) consumeOperatorToken() TokenType {
 	return opTokens[c]
 }
 
+// consumeIdentifierEscape consumes a unicode escape sequence of a code point that is allowed at the start, or in the remainder, of an identifier.
+func (l *Lexer) consumeIdentifierEscape(start bool) bool {
+	mark := l.r.Pos()
+	if !l.consumeUnicodeEscape() {
+		return false
+	}
+	r, _ := decodeUnicodeEscape(l.r.Lexeme()[mark:])
+	if r == '$' || r == '_' || start && unicode.IsOneOf(identifierStart, r) || !start && (r == '\u200C' || r == '\u200D' || unicode.IsOneOf(identifierContinue, r)) {
+		return true
+	}
+	l.r.Rewind(mark)
+	return false
+}
+
 func (l *Lexer) consumeIdentifierToken() bool {
 	c := l.r.Peek(0)
 	if identifierStartTable[c] {
@@ -495,7 +509,7 @@ func (l *Lexer) consumeIdentifierToken() bool {
 		} else {
 			return false
 		}
-	} else if !l.consumeUnicodeEscape() {
+	} else if !l.consumeIdentifierEscape(true) {
 		return false
 	}
 	for {
@@ -508,7 +522,7 @@ func (l *Lexer) consumeIdentifierToken() bool {
 			} else {
 				break
 			}
-		} else {
+		} else if c != '\\' || !l.consumeIdentifierEscape(false) {
 			break
 		}
 	}
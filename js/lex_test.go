@@ -48,7 +48,7 @@ func TestTokens(t *testing.T) {
 		{"x=y-->10\n", TTs{IdentifierToken, EqToken, IdentifierToken, DecrToken, GtToken, DecimalToken, LineTerminatorToken}},
 		{"  /*comment*/ -->nothing\n", TTs{CommentToken, DecrToken, GtToken, IdentifierToken, LineTerminatorToken}},
 		{"1 /*comment\nmultiline*/ -->nothing\n", TTs{DecimalToken, CommentLineTerminatorToken, CommentToken, LineTerminatorToken}},
-		{"$ _\u200C \\u2000 \u200C", TTs{IdentifierToken, IdentifierToken, IdentifierToken, ErrorToken}},
+		{"$ _\u200C \\u2000 \u200C", TTs{IdentifierToken, IdentifierToken, ErrorToken}},
 		{">>>=>>>>=", TTs{GtGtGtEqToken, GtGtGtToken, GtEqToken}},
 		{"1/", TTs{DecimalToken, DivToken}},
 		{"1/=", TTs{DecimalToken, DivEqToken}},
@@ -100,8 +100,12 @@ func TestTokens(t *testing.T) {
 		{"Ø a〉", TTs{IdentifierToken, IdentifierToken, ErrorToken}},
 		{"\u00A0\uFEFF\u2000", TTs{}},
 		{"\u2028\u2029", TTs{LineTerminatorToken}},
-		{"\\u0029ident", TTs{IdentifierToken}},
+		{"\\u0061ident", TTs{IdentifierToken}},
+		{"a\\u{200C}b\\u0030", TTs{IdentifierToken}},
+		{"\\u0029ident", TTs{ErrorToken}},
+		{"\\u0030ident", TTs{ErrorToken}},
 		{"\\u{0029FEF}ident", TTs{IdentifierToken}},
+		{"a\\u0062c\\u{64}", TTs{IdentifierToken}},
 		{"\\u{}", TTs{ErrorToken}},
 		{"\\ugident", TTs{ErrorToken}},
 		{"'str\u2028ing'", TTs{ErrorToken}},
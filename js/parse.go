@@ -1,9 +1,12 @@
 package js
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/tdewolff/parse/v2"
 	"github.com/tdewolff/parse/v2/buffer"
@@ -17,6 +20,7 @@ type Parser struct {
 	data                   []byte
 	tt                     TokenType
 	prevLT                 bool
+	prevEnd                int // offset of the end of the previous token
 	inFor                  bool
 	async, generator       bool
 	assumeArrowFunc        bool
@@ -25,15 +29,136 @@ type Parser struct {
 	stmtLevel int
 	exprLevel int
 
+	o      Options
+	ctx    context.Context // nil if it can never be cancelled
+	tokens int
+	nodes  int
+
+	pure, prevPure bool // current and previous token are preceded by a #__PURE__ annotation
+	useStrict      bool // current function body has a "use strict" directive
+
 	scope *Scope
+	arena *arena // nil unless Options.Arena is set
+	asi   []ASI
+}
+
+// Options are the options of the parser. The Max fields are resource limits that protect against excessive memory and CPU usage for untrusted input, where a zero value means no limit, except for MaxNesting which defaults to 1000, and negative values are invalid. The remaining fields select the memory allocation, the source type, and the reporting of automatic semicolon insertion.
+type Options struct {
+	MaxNesting   int // maximum nesting depth of statements and of expressions
+	MaxTokens    int // maximum number of tokens, including whitespace and comments
+	MaxInputSize int // maximum input size in bytes, which is checked before parsing but after the input has been read into memory
+	MaxNodes     int // maximum number of statements and expressions
+
+	Arena     bool // allocate nodes from pooled memory that is reused after AST.Release
+	Module    bool // input is an ECMAScript module, which is strict mode code
+	ReportASI bool // record automatic semicolon insertions and hazards in AST.ASI
+}
+
+// Limit is a resource limit of the parser.
+type Limit int
+
+// Limit values.
+const (
+	NestingLimit   Limit = iota // Options.MaxNesting
+	TokenLimit                  // Options.MaxTokens
+	InputSizeLimit              // Options.MaxInputSize
+	NodeLimit                   // Options.MaxNodes
+	ContextLimit                // context cancelled or deadline exceeded
+)
+
+func (l Limit) String() string {
+	switch l {
+	case NestingLimit:
+		return "nesting"
+	case TokenLimit:
+		return "tokens"
+	case InputSizeLimit:
+		return "input size"
+	case NodeLimit:
+		return "nodes"
+	case ContextLimit:
+		return "context"
+	}
+	return "Invalid(" + strconv.Itoa(int(l)) + ")"
+}
+
+// LimitError is returned when parsing is aborted because a resource limit was exceeded or the context was done. For ContextLimit, Err is the error of the context so that errors.Is(err, context.Canceled) works.
+type LimitError struct {
+	Limit  Limit
+	Max    int   // value of the exceeded limit
+	Err    error // context error for ContextLimit
+	Offset int   // offset in the input at which parsing was aborted
+
+	msg string
+	err *parse.Error
+}
+
+func (e *LimitError) message() string {
+	switch e.Limit {
+	case NestingLimit:
+		return fmt.Sprintf("nesting depth of %s exceeds maximum of %d", e.msg, e.Max)
+	case ContextLimit:
+		return e.Err.Error()
+	case InputSizeLimit:
+		return fmt.Sprintf("input size exceeds maximum of %d bytes", e.Max)
+	}
+	return fmt.Sprintf("number of %s exceeds maximum of %d", e.Limit, e.Max)
+}
+
+// Position returns the line, column, and context of the error.
+func (e *LimitError) Position() (int, int, string) {
+	return e.err.Position()
 }
 
-// Parse returns a JS AST tree of.
+// Error returns the error string, containing the context and line + column number.
+func (e *LimitError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the context error for ContextLimit.
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}
+
+// Parse returns a JS AST tree of. It uses the default options, which limit the nesting depth to 1000 but set no other limits.
 func Parse(r *parse.Input) (*AST, error) {
+	return ParseContext(context.Background(), r, Options{})
+}
+
+// ParseContext returns a JS AST tree of, using the resource limits of the options. It aborts with a *LimitError when a limit is exceeded or when the context is cancelled or its deadline is exceeded. The context is checked every 256 tokens. Negative limits are invalid and return an error. Since r holds the entire input in memory, MaxInputSize bounds the work of the parser but not the memory used to read the input, use for example an io.LimitReader when creating r for the latter.
+func ParseContext(ctx context.Context, r *parse.Input, o Options) (*AST, error) {
+	for _, limit := range []struct {
+		Limit
+		max int
+	}{{NestingLimit, o.MaxNesting}, {TokenLimit, o.MaxTokens}, {InputSizeLimit, o.MaxInputSize}, {NodeLimit, o.MaxNodes}} {
+		if limit.max < 0 {
+			return &AST{}, fmt.Errorf("invalid negative maximum %d for %s", limit.max, limit.Limit)
+		}
+	}
+	if o.MaxNesting == 0 {
+		o.MaxNesting = 1000
+	}
+
 	ast := &AST{}
 	p := &Parser{
 		l:  NewLexer(r),
 		tt: WhitespaceToken, // trick so that next() works
+		o:  o,
+	}
+	if o.Arena {
+		p.arena = &arena{}
+		ast.arena = p.arena
+	}
+	if ctx.Done() != nil {
+		p.ctx = ctx
+	}
+
+	if 0 < o.MaxInputSize && o.MaxInputSize < r.Len() {
+		err := &LimitError{Limit: InputSizeLimit, Max: o.MaxInputSize, Offset: o.MaxInputSize}
+		err.err = parse.NewError(buffer.NewReader(r.Bytes()), err.Offset, err.message())
+		return ast, err
+	} else if err := p.checkContext(); err != nil {
+		return ast, err
 	}
 
 	// process shebang
@@ -43,22 +168,27 @@ func Parse(r *parse.Input) (*AST, error) {
 		ast.Comments = append(ast.Comments, r.Shift())
 	}
 
-	p.tt, p.data = p.l.Next()
+	p.tt, p.data = p.nextToken()
 	for p.tt == CommentToken || p.tt == CommentLineTerminatorToken {
 		ast.Comments = append(ast.Comments, p.data)
-		p.tt, p.data = p.l.Next()
+		p.pure = p.pure || isPureAnnotation(p.data)
+		p.tt, p.data = p.nextToken()
 		if p.tt == WhitespaceToken || p.tt == LineTerminatorToken {
-			p.tt, p.data = p.l.Next()
+			p.tt, p.data = p.nextToken()
 		}
 	}
 	if p.tt == WhitespaceToken || p.tt == LineTerminatorToken {
 		p.next()
 	}
 	// prevLT may be wrong but that is not a problem
-	ast.BlockStmt = p.parseModule()
+	p.parseModule(&ast.BlockStmt)
 
+	ast.ASI = p.asi
 	if p.err == nil {
 		p.err = p.l.Err()
+	} else if err, ok := p.err.(*LimitError); ok {
+		err.Offset = p.l.r.Offset() - len(p.data)
+		err.err = parse.NewError(buffer.NewReader(p.l.r.Bytes()), err.Offset, err.message())
 	} else {
 		offset := p.l.r.Offset() - len(p.data)
 		p.err = parse.NewError(buffer.NewReader(p.l.r.Bytes()), offset, p.err.Error())
@@ -69,16 +199,116 @@ func Parse(r *parse.Input) (*AST, error) {
 	return ast, p.err
 }
 
+// checkContext returns a *LimitError if the context is done.
+func (p *Parser) checkContext() error {
+	if p.ctx == nil {
+		return nil
+	} else if err := p.ctx.Err(); err != nil {
+		limitErr := &LimitError{Limit: ContextLimit, Err: err, Offset: p.l.r.Offset()}
+		limitErr.err = parse.NewError(buffer.NewReader(p.l.r.Bytes()), limitErr.Offset, limitErr.message())
+		return limitErr
+	}
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////
 
 func (p *Parser) next() {
+	if p.err != nil {
+		// keep failing after an error, for checks that don't return immediately
+		p.tt = ErrorToken
+		return
+	}
 	p.prevLT = false
-	p.tt, p.data = p.l.Next()
+	p.prevEnd = p.l.r.Offset()
+	p.prevPure, p.pure = p.pure, false
+	p.tt, p.data = p.nextToken()
 	for p.tt == WhitespaceToken || p.tt == LineTerminatorToken || p.tt == CommentToken || p.tt == CommentLineTerminatorToken {
 		if p.tt == LineTerminatorToken || p.tt == CommentLineTerminatorToken {
 			p.prevLT = true
 		}
-		p.tt, p.data = p.l.Next()
+		if p.tt != WhitespaceToken && p.tt != LineTerminatorToken && isPureAnnotation(p.data) {
+			p.pure = true
+		}
+		p.tt, p.data = p.nextToken()
+	}
+}
+
+// nextToken returns the next token from the lexer, while enforcing the token limit and checking the context periodically.
+func (p *Parser) nextToken() (TokenType, []byte) {
+	p.tokens++
+	if 0 < p.o.MaxTokens && p.o.MaxTokens < p.tokens {
+		p.failLimit(TokenLimit, p.o.MaxTokens)
+		return ErrorToken, nil
+	} else if p.ctx != nil && p.tokens%256 == 0 {
+		if err := p.ctx.Err(); err != nil {
+			if p.err == nil {
+				p.err = &LimitError{Limit: ContextLimit, Err: err}
+			}
+			return ErrorToken, nil
+		}
+	}
+	return p.l.Next()
+}
+
+// addNode counts a statement or expression node and enforces the node limit. Expressions are counted for each operand and operator in parseExpressionSuffix.
+func (p *Parser) addNode() bool {
+	p.nodes++
+	if 0 < p.o.MaxNodes && p.o.MaxNodes < p.nodes {
+		p.failLimit(NodeLimit, p.o.MaxNodes)
+		return false
+	}
+	return true
+}
+
+// checkEscapedKeyword fails for a binding, reference, or label that is a reserved word written with unicode escapes, which is only allowed as a property name.
+func (p *Parser) checkEscapedKeyword(name []byte) {
+	if bytes.IndexByte(name, '\\') == -1 {
+		return
+	} else if tt, ok := Keywords[string(DecodeIdentifier(name))]; ok && IsReservedWord(tt) && tt != AwaitToken && tt != YieldToken {
+		p.failMessage("unexpected escaped keyword %s", string(name))
+	}
+}
+
+// isPureAnnotation returns true if the comment is a /*#__PURE__*/ or /*@__PURE__*/ annotation, which marks the following call or new expression as free of side effects.
+func isPureAnnotation(comment []byte) bool {
+	if len(comment) < 12 || comment[1] != '*' {
+		return false
+	}
+	i := bytes.Index(comment, []byte("__PURE__"))
+	if i < 1 || comment[i-1] != '#' && comment[i-1] != '@' {
+		return false
+	}
+	return len(bytes.TrimSpace(comment[2:i-1])) == 0 && len(bytes.TrimSpace(comment[i+8:len(comment)-2])) == 0
+}
+
+// markPure marks the call or new expression starting at the beginning of an expression as pure, as in `/*#__PURE__*/ a() + b`.
+func markPure(expr IExpr) {
+	switch n := expr.(type) {
+	case *CallExpr:
+		n.Pure = true
+	case *NewExpr:
+		n.Pure = true
+	case *GroupExpr:
+		markPure(n.X)
+	case *BinaryExpr:
+		markPure(n.X)
+	case *CondExpr:
+		markPure(n.Cond)
+	}
+}
+
+func (p *Parser) failLimit(limit Limit, max int) {
+	if p.err == nil {
+		p.err = &LimitError{Limit: limit, Max: max}
+		p.tt = ErrorToken
+	}
+}
+
+func (p *Parser) failNesting(in string) {
+	if p.err == nil {
+		p.err = &LimitError{Limit: NestingLimit, Max: p.o.MaxNesting, msg: in}
+		p.tt = ErrorToken
 	}
 }
 
@@ -157,6 +387,7 @@ func (p *Parser) enterScope(scope *Scope, isFunc bool) *Scope {
 		NumVarDecls:  0,
 		NumArguments: 0,
 		HasWith:      false,
+		IsStrict:     parent != nil && parent.IsStrict,
 	}
 	if isFunc {
 		scope.Func = scope
@@ -167,46 +398,57 @@ func (p *Parser) enterScope(scope *Scope, isFunc bool) *Scope {
 }
 
 func (p *Parser) exitScope(parent *Scope) {
-	p.scope.HoistUndeclared()
+	p.scope.hoistUndeclared(p.arena)
 	p.scope = parent
 }
 
-func (p *Parser) parseModule() (module BlockStmt) {
+func (p *Parser) parseModule(module *BlockStmt) {
 	p.enterScope(&module.Scope, true)
+	module.Scope.IsStrict = p.o.Module
 	p.allowDirectivePrologue = true
 	for {
 		switch p.tt {
 		case ErrorToken:
 			return
 		case ImportToken:
+			p.allowDirectivePrologue = false
 			p.next()
 			if p.tt == OpenParenToken {
 				// could be an import call expression
-				left := &LiteralExpr{ImportToken, []byte("import")}
+				left := p.arena.newLiteralExpr(LiteralExpr{ImportToken, []byte("import")})
 				p.exprLevel++
 				suffix := p.parseExpressionSuffix(left, OpExpr, OpCall)
 				p.exprLevel--
-				module.List = append(module.List, &ExprStmt{suffix})
+				stmt := p.arena.newExprStmt(ExprStmt{suffix})
+				p.endStmt(stmt)
+				module.List = p.arena.appendStmt(module.List, stmt)
 			} else {
 				importStmt := p.parseImportStmt()
-				module.List = append(module.List, &importStmt)
+				module.List = p.arena.appendStmt(module.List, &importStmt)
 			}
 		case ExportToken:
+			p.allowDirectivePrologue = false
 			exportStmt := p.parseExportStmt()
-			module.List = append(module.List, &exportStmt)
+			module.List = p.arena.appendStmt(module.List, &exportStmt)
 		default:
-			module.List = append(module.List, p.parseStmt(true))
+			module.List = p.arena.appendStmt(module.List, p.parseStmt(true))
 		}
 	}
 }
 
 func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 	p.stmtLevel++
-	if 1000 < p.stmtLevel {
-		p.failMessage("too many nested statements")
+	if p.o.MaxNesting < p.stmtLevel {
+		p.failNesting("statements")
+		return nil
+	} else if !p.addNode() {
 		return nil
 	}
 
+	// directives can only be preceded by other directives
+	allowDirectivePrologue := p.allowDirectivePrologue
+	p.allowDirectivePrologue = false
+
 	switch tt := p.tt; tt {
 	case OpenBraceToken:
 		stmt = p.parseBlockStmt("block statement")
@@ -216,8 +458,7 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 			return
 		}
 		p.next()
-		varDecl := p.parseVarDecl(tt)
-		stmt = &varDecl
+		stmt = p.arena.newVarDecl(p.parseVarDecl(tt))
 		if !p.prevLT && p.tt != SemicolonToken && p.tt != CloseBraceToken && p.tt != ErrorToken {
 			if tt == ConstToken {
 				p.fail("const declaration")
@@ -230,15 +471,14 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 		let := p.data
 		p.next()
 		if allowDeclaration && (IsIdentifier(p.tt) || p.tt == YieldToken || p.tt == AwaitToken || p.tt == OpenBracketToken || p.tt == OpenBraceToken) {
-			varDecl := p.parseVarDecl(tt)
-			stmt = &varDecl
+			stmt = p.arena.newVarDecl(p.parseVarDecl(tt))
 			if !p.prevLT && p.tt != SemicolonToken && p.tt != CloseBraceToken && p.tt != ErrorToken {
 				p.fail("let declaration")
 				return
 			}
 		} else {
 			// expression
-			stmt = &ExprStmt{p.parseIdentifierExpression(OpExpr, let)}
+			stmt = p.arena.newExprStmt(ExprStmt{p.parseIdentifierExpression(OpExpr, let)})
 			if !p.prevLT && p.tt != SemicolonToken && p.tt != CloseBraceToken && p.tt != ErrorToken {
 				p.fail("expression")
 				return
@@ -260,7 +500,7 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 			p.next()
 			elseBody = p.parseStmt(false)
 		}
-		stmt = &IfStmt{cond, body, elseBody}
+		stmt = p.arena.newIfStmt(IfStmt{cond, body, elseBody})
 	case ContinueToken, BreakToken:
 		tt := p.tt
 		p.next()
@@ -276,7 +516,7 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 		if !p.prevLT && p.tt != SemicolonToken && p.tt != CloseBraceToken && p.tt != ErrorToken {
 			value = p.parseExpression(OpExpr)
 		}
-		stmt = &ReturnStmt{value}
+		stmt = p.arena.newReturnStmt(ReturnStmt{value})
 	case WithToken:
 		p.next()
 		if !p.consume("with statement", OpenParenToken) {
@@ -285,6 +525,9 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 		cond := p.parseExpression(OpExpr)
 		if !p.consume("with statement", CloseParenToken) {
 			return
+		} else if p.scope.IsStrict {
+			p.failMessage("with statement not allowed in strict mode")
+			return
 		}
 
 		p.scope.Func.HasWith = true
@@ -323,7 +566,7 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 			return
 		}
 
-		body := &BlockStmt{}
+		body := p.arena.newBlockStmt(BlockStmt{})
 		parent := p.enterScope(&body.Scope, false)
 
 		var init IExpr
@@ -379,6 +622,7 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 				p.fail("for statement", OfToken)
 				return
 			}
+			p.checkStrictAssign(init)
 			p.next()
 			value := p.parseExpression(OpExpr)
 			if !p.consume("for statement", CloseParenToken) {
@@ -392,6 +636,7 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 			}
 			stmt = &ForInStmt{init, value, body}
 		} else if p.tt == OfToken {
+			p.checkStrictAssign(init)
 			p.next()
 			value := p.parseExpression(OpAssign)
 			if !p.consume("for statement", CloseParenToken) {
@@ -452,7 +697,7 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 
 			var stmts []IStmt
 			for p.tt != CaseToken && p.tt != DefaultToken && p.tt != CloseBraceToken && p.tt != ErrorToken {
-				stmts = append(stmts, p.parseStmt(true))
+				stmts = p.arena.appendStmt(stmts, p.parseStmt(true))
 			}
 			switchStmt.List = append(switchStmt.List, CaseClause{clause, list, stmts})
 		}
@@ -475,7 +720,7 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 			stmt = p.parseAsyncFuncDecl()
 		} else {
 			// expression
-			stmt = &ExprStmt{p.parseAsyncExpression(OpExpr, async)}
+			stmt = p.arena.newExprStmt(ExprStmt{p.parseAsyncExpression(OpExpr, async)})
 			if !p.prevLT && p.tt != SemicolonToken && p.tt != CloseBraceToken && p.tt != ErrorToken {
 				p.fail("expression")
 				return
@@ -501,7 +746,7 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 		var catch, finally *BlockStmt
 		if p.tt == CatchToken {
 			p.next()
-			catch = &BlockStmt{}
+			catch = p.arena.newBlockStmt(BlockStmt{})
 			parent := p.enterScope(&catch.Scope, false)
 			if p.tt == OpenParenToken {
 				p.next()
@@ -532,11 +777,16 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 			label := p.data
 			p.next()
 			if p.tt == ColonToken {
+				p.checkEscapedKeyword(label)
 				p.next()
+				if p.tt == FunctionToken && p.scope.IsStrict {
+					p.failMessage("labelled function declaration not allowed in strict mode")
+					return
+				}
 				stmt = &LabelledStmt{label, p.parseStmt(true)} // allows illegal async function, generator function, let, const, or class declarations
 			} else {
 				// expression
-				stmt = &ExprStmt{p.parseIdentifierExpression(OpExpr, label)}
+				stmt = p.arena.newExprStmt(ExprStmt{p.parseIdentifierExpression(OpExpr, label)})
 				if !p.prevLT && p.tt != SemicolonToken && p.tt != CloseBraceToken && p.tt != ErrorToken {
 					p.fail("expression")
 					return
@@ -544,23 +794,24 @@ func (p *Parser) parseStmt(allowDeclaration bool) (stmt IStmt) {
 			}
 		} else {
 			// expression
-			stmt = &ExprStmt{p.parseExpression(OpExpr)}
+			stmt = p.arena.newExprStmt(ExprStmt{p.parseExpression(OpExpr)})
 			if !p.prevLT && p.tt != SemicolonToken && p.tt != CloseBraceToken && p.tt != ErrorToken {
 				p.fail("expression")
 				return
 			}
-			if p.allowDirectivePrologue {
+			if allowDirectivePrologue {
 				if lit, ok := stmt.(*ExprStmt).Value.(*LiteralExpr); ok && lit.TokenType == StringToken {
 					stmt = &DirectivePrologueStmt{lit.Data}
-				} else {
-					p.allowDirectivePrologue = false
+					p.allowDirectivePrologue = true
+					if isUseStrict(lit.Data) {
+						p.scope.IsStrict = true
+						p.useStrict = true
+					}
 				}
 			}
 		}
 	}
-	if p.tt == SemicolonToken {
-		p.next()
-	}
+	p.endStmt(stmt)
 	p.stmtLevel--
 	return
 }
@@ -577,13 +828,13 @@ func (p *Parser) parseStmtList(in string) (list []IStmt) {
 			p.next()
 			break
 		}
-		list = append(list, p.parseStmt(true))
+		list = p.arena.appendStmt(list, p.parseStmt(true))
 	}
 	return
 }
 
 func (p *Parser) parseBlockStmt(in string) (blockStmt *BlockStmt) {
-	blockStmt = &BlockStmt{}
+	blockStmt = p.arena.newBlockStmt(BlockStmt{})
 	parent := p.enterScope(&blockStmt.Scope, false)
 	blockStmt.List = p.parseStmtList(in)
 	p.exitScope(parent)
@@ -598,6 +849,7 @@ func (p *Parser) parseImportStmt() (importStmt ImportStmt) {
 	} else {
 		if IsIdentifier(p.tt) || p.tt == YieldToken || p.tt == AwaitToken {
 			importStmt.Default = p.data
+			p.checkEscapedKeyword(p.data)
 			p.next()
 			if p.tt == CommaToken {
 				p.next()
@@ -614,6 +866,7 @@ func (p *Parser) parseImportStmt() (importStmt ImportStmt) {
 				return
 			}
 			importStmt.List = []Alias{Alias{star, p.data}}
+			p.checkEscapedKeyword(p.data)
 			p.next()
 		} else if p.tt == OpenBraceToken {
 			p.next()
@@ -630,6 +883,7 @@ func (p *Parser) parseImportStmt() (importStmt ImportStmt) {
 					binding = p.data
 					p.next()
 				}
+				p.checkEscapedKeyword(binding)
 				importStmt.List = append(importStmt.List, Alias{name, binding})
 				if p.tt == CommaToken {
 					p.next()
@@ -658,9 +912,7 @@ func (p *Parser) parseImportStmt() (importStmt ImportStmt) {
 		importStmt.Module = p.data
 		p.next()
 	}
-	if p.tt == SemicolonToken {
-		p.next()
-	}
+	p.endStmt(&importStmt)
 	return
 }
 
@@ -762,9 +1014,7 @@ func (p *Parser) parseExportStmt() (exportStmt ExportStmt) {
 		p.fail("export statement", MulToken, OpenBraceToken, VarToken, LetToken, ConstToken, FunctionToken, AsyncToken, ClassToken, DefaultToken)
 		return
 	}
-	if p.tt == SemicolonToken {
-		p.next()
-	}
+	p.endStmt(&exportStmt)
 	return
 }
 
@@ -791,7 +1041,7 @@ func (p *Parser) parseVarDecl(tt TokenType) (varDecl VarDecl) {
 			return
 		}
 
-		varDecl.List = append(varDecl.List, bindingElement)
+		varDecl.List = p.arena.appendBindingElement(varDecl.List, bindingElement)
 		if p.tt == CommaToken {
 			p.next()
 		} else {
@@ -861,7 +1111,7 @@ func (p *Parser) parseAnyFunc(async, inExpr bool) (funcDecl *FuncDecl) {
 	if inExpr && (IsIdentifier(p.tt) || p.tt == YieldToken || p.tt == AwaitToken) || !inExpr && p.isIdentifierReference(p.tt) {
 		name = p.data
 		if !inExpr {
-			funcDecl.Name, ok = p.scope.Declare(FunctionDecl, p.data)
+			funcDecl.Name, ok = p.declare(FunctionDecl, p.data)
 			if !ok {
 				p.failMessage("identifier %s has already been declared", string(p.data))
 				return
@@ -880,11 +1130,15 @@ func (p *Parser) parseAnyFunc(async, inExpr bool) (funcDecl *FuncDecl) {
 	p.async, p.generator = funcDecl.Async, funcDecl.Generator
 
 	if inExpr && name != nil {
-		funcDecl.Name, _ = p.scope.Declare(ExprDecl, name) // cannot fail
+		funcDecl.Name, _ = p.declare(ExprDecl, name) // cannot fail
 	}
 	funcDecl.Params = p.parseFuncParams("function declaration")
+	parentUseStrict := p.useStrict
+	p.useStrict = false
 	p.allowDirectivePrologue = true
 	funcDecl.Body.List = p.parseStmtList("function declaration")
+	p.checkStrictFunc(funcDecl.Name, funcDecl.Params)
+	p.useStrict = parentUseStrict
 
 	p.async, p.generator = parentAsync, parentGenerator
 	p.exitScope(parent)
@@ -903,17 +1157,26 @@ func (p *Parser) parseAnyClass(inExpr bool) (classDecl *ClassDecl) {
 	// assume we're at class
 	p.next()
 	classDecl = &ClassDecl{}
+
+	// class declarations and expressions are strict mode code
+	parentStrict := p.scope.IsStrict
+	p.scope.IsStrict = true
+	defer func() {
+		p.scope.IsStrict = parentStrict
+	}()
+
 	if IsIdentifier(p.tt) || p.tt == YieldToken || p.tt == AwaitToken {
 		if !inExpr {
 			var ok bool
-			classDecl.Name, ok = p.scope.Declare(LexicalDecl, p.data)
+			classDecl.Name, ok = p.declare(LexicalDecl, p.data)
 			if !ok {
 				p.failMessage("identifier %s has already been declared", string(p.data))
 				return
 			}
 		} else {
-			//classDecl.Name, ok = p.scope.Declare(ExprDecl, p.data) // classes do not register vars
+			//classDecl.Name, ok = p.scope.declare(p.arena, ExprDecl, p.data) // classes do not register vars
 			classDecl.Name = &Var{p.data, nil, 1, ExprDecl}
+			p.checkStrictBinding(p.data)
 		}
 		p.next()
 	} else if !inExpr {
@@ -1013,6 +1276,11 @@ func (p *Parser) parseClassElement() (method *MethodDecl, definition FieldDefini
 			p.next()
 			definition.Init = p.parseExpression(OpAssign)
 		}
+		if p.tt == SemicolonToken {
+			p.next()
+		} else {
+			p.insertSemicolon()
+		}
 		method = nil
 		return
 	}
@@ -1022,8 +1290,12 @@ func (p *Parser) parseClassElement() (method *MethodDecl, definition FieldDefini
 	p.async, p.generator = method.Async, method.Generator
 
 	method.Params = p.parseFuncParams("method definition")
+	parentUseStrict := p.useStrict
+	p.useStrict = false
 	p.allowDirectivePrologue = true
 	method.Body.List = p.parseStmtList("method definition")
+	p.checkStrictFunc(nil, method.Params)
+	p.useStrict = parentUseStrict
 
 	p.async, p.generator = parentAsync, parentGenerator
 	p.exitScope(parent)
@@ -1035,6 +1307,7 @@ func (p *Parser) parsePropertyName(in string) (propertyName PropertyName) {
 		propertyName.Literal = LiteralExpr{IdentifierToken, p.data}
 		p.next()
 	} else if p.tt == StringToken {
+		p.checkStrictString(p.data)
 		// reinterpret string as identifier or number if we can, except for empty strings
 		if isIdent := AsIdentifierName(p.data[1 : len(p.data)-1]); isIdent {
 			propertyName.Literal = LiteralExpr{IdentifierToken, p.data[1 : len(p.data)-1]}
@@ -1074,7 +1347,7 @@ func (p *Parser) parseBinding(decl DeclType) (binding IBinding) {
 	// binding identifier or binding pattern
 	if IsIdentifier(p.tt) || !p.generator && p.tt == YieldToken || !p.async && p.tt == AwaitToken {
 		var ok bool
-		binding, ok = p.scope.Declare(decl, p.data)
+		binding, ok = p.declare(decl, p.data)
 		if !ok {
 			p.failMessage("identifier %s has already been declared", string(p.data))
 			return
@@ -1131,7 +1404,7 @@ func (p *Parser) parseBinding(decl DeclType) (binding IBinding) {
 					return
 				}
 				var ok bool
-				object.Rest, ok = p.scope.Declare(decl, p.data)
+				object.Rest, ok = p.declare(decl, p.data)
 				if !ok {
 					p.failMessage("identifier %s has already been declared", string(p.data))
 					return
@@ -1157,7 +1430,7 @@ func (p *Parser) parseBinding(decl DeclType) (binding IBinding) {
 					// single name binding
 					var ok bool
 					item.Key.Literal.Data = parse.Copy(item.Key.Literal.Data) // copy so that renaming doesn't rename the key
-					item.Value.Binding, ok = p.scope.Declare(decl, name)
+					item.Value.Binding, ok = p.declare(decl, name)
 					if !ok {
 						p.failMessage("identifier %s has already been declared", string(name))
 						return
@@ -1317,13 +1590,14 @@ func (p *Parser) parseObjectLiteral() (object ObjectExpr) {
 				property.Name = &method.Name                                    // set key explicitly so after renaming the original is still known
 				if p.assumeArrowFunc {
 					var ok bool
-					property.Value, ok = p.scope.Declare(ArgumentDecl, name)
+					p.checkEscapedKeyword(name)
+					property.Value, ok = p.scope.declare(p.arena, ArgumentDecl, name)
 					if !ok {
-						property.Value = p.scope.Use(name)
+						property.Value = p.use(name)
 						p.assumeArrowFunc = false
 					}
 				} else {
-					property.Value = p.scope.Use(name)
+					property.Value = p.use(name)
 				}
 				if p.tt == EqToken {
 					p.next()
@@ -1368,7 +1642,11 @@ func (p *Parser) parseTemplateLiteral(precLeft OpPrec) (template TemplateExpr) {
 func (p *Parser) parseArguments() (args Args) {
 	// assume we're on (
 	p.next()
-	args.List = make([]Arg, 0, 4)
+	if p.arena == nil {
+		args.List = make([]Arg, 0, 4)
+	} else {
+		args.List = []Arg{} // allocated from the arena when appending
+	}
 	for {
 		rest := p.tt == EllipsisToken
 		if rest {
@@ -1378,7 +1656,7 @@ func (p *Parser) parseArguments() (args Args) {
 		if p.tt == CloseParenToken || p.tt == ErrorToken {
 			break
 		}
-		args.List = append(args.List, Arg{
+		args.List = p.arena.appendArg(args.List, Arg{
 			Value: p.parseExpression(OpAssign),
 			Rest:  rest,
 		})
@@ -1398,7 +1676,8 @@ func (p *Parser) parseAsyncArrowFunc() (arrowFunc *ArrowFunc) {
 	p.async, p.generator = true, false
 
 	if IsIdentifier(p.tt) || !p.generator && p.tt == YieldToken {
-		ref, _ := p.scope.Declare(ArgumentDecl, p.data)
+		p.checkEscapedKeyword(p.data)
+		ref, _ := p.scope.declare(p.arena, ArgumentDecl, p.data)
 		p.next()
 		arrowFunc.Params.List = []BindingElement{{Binding: ref}}
 	} else {
@@ -1410,7 +1689,7 @@ func (p *Parser) parseAsyncArrowFunc() (arrowFunc *ArrowFunc) {
 	}
 
 	arrowFunc.Async = true
-	arrowFunc.Body.List = p.parseArrowFuncBody()
+	arrowFunc.Body.List = p.parseArrowFuncBody(arrowFunc.Params)
 
 	p.async, p.generator = parentAsync, parentGenerator
 	p.exitScope(parent)
@@ -1426,23 +1705,23 @@ func (p *Parser) parseIdentifierArrowFunc(v *Var) (arrowFunc *ArrowFunc) {
 
 	if 1 < v.Uses {
 		v.Uses--
-		v, _ = p.scope.Declare(ArgumentDecl, v.Data) // cannot fail
+		v, _ = p.scope.declare(p.arena, ArgumentDecl, v.Data) // cannot fail
 	} else {
 		// if v.Uses==1 it must be undeclared and be the last added
 		p.scope.Parent.Undeclared = p.scope.Parent.Undeclared[:len(p.scope.Parent.Undeclared)-1]
 		v.Decl = ArgumentDecl
-		p.scope.Declared = append(p.scope.Declared, v)
+		p.scope.Declared = p.arena.appendVar(p.scope.Declared, v)
 	}
 
 	arrowFunc.Params.List = []BindingElement{{v, nil}}
-	arrowFunc.Body.List = p.parseArrowFuncBody()
+	arrowFunc.Body.List = p.parseArrowFuncBody(arrowFunc.Params)
 
 	p.async, p.generator = parentAsync, parentGenerator
 	p.exitScope(parent)
 	return
 }
 
-func (p *Parser) parseArrowFuncBody() (list []IStmt) {
+func (p *Parser) parseArrowFuncBody(params Params) (list []IStmt) {
 	// expect we're at arrow
 	if p.tt != ArrowToken {
 		p.fail("arrow function", ArrowToken)
@@ -1459,23 +1738,35 @@ func (p *Parser) parseArrowFuncBody() (list []IStmt) {
 	if p.tt == OpenBraceToken {
 		parentInFor := p.inFor
 		p.inFor = false
+		parentUseStrict := p.useStrict
+		p.useStrict = false
 		p.allowDirectivePrologue = true
 		list = p.parseStmtList("arrow function")
+		p.checkStrictFunc(nil, params)
+		p.useStrict = parentUseStrict
 		p.inFor = parentInFor
 	} else {
-		list = []IStmt{&ReturnStmt{p.parseExpression(OpAssign)}}
+		p.checkStrictFunc(nil, params)
+		list = []IStmt{p.arena.newReturnStmt(ReturnStmt{p.parseExpression(OpAssign)})}
 	}
 	return
 }
 
 func (p *Parser) parseIdentifierExpression(prec OpPrec, ident []byte) IExpr {
+	// assume we're at a token after the identifier
+	pure := p.prevPure
 	var left IExpr
-	left = p.scope.Use(ident)
-	return p.parseExpressionSuffix(left, prec, OpPrimary)
+	left = p.use(ident)
+	left = p.parseExpressionSuffix(left, prec, OpPrimary)
+	if pure {
+		markPure(left)
+	}
+	return left
 }
 
 func (p *Parser) parseAsyncExpression(prec OpPrec, async []byte) IExpr {
 	// assume we're at a token after async
+	pure := p.prevPure
 	var left IExpr
 	precLeft := OpPrimary
 	if !p.prevLT && p.tt == FunctionToken {
@@ -1487,23 +1778,32 @@ func (p *Parser) parseAsyncExpression(prec OpPrec, async []byte) IExpr {
 			p.fail("arrow function")
 			return nil
 		} else if p.tt == OpenParenToken {
-			return p.parseParenthesizedExpressionOrArrowFunc(prec, async)
+			left = p.parseParenthesizedExpressionOrArrowFunc(prec, async)
+			if pure {
+				markPure(left)
+			}
+			return left
 		}
 		left = p.parseAsyncArrowFunc()
 		precLeft = OpAssign
 	} else {
-		left = p.scope.Use(async)
+		left = p.use(async)
 	}
-	return p.parseExpressionSuffix(left, prec, precLeft)
+	left = p.parseExpressionSuffix(left, prec, precLeft)
+	if pure {
+		markPure(left)
+	}
+	return left
 }
 
 // parseExpression parses an expression that has a precedence of prec or higher.
 func (p *Parser) parseExpression(prec OpPrec) IExpr {
 	p.exprLevel++
-	if 1000 < p.exprLevel {
-		p.failMessage("too many nested expressions")
+	if p.o.MaxNesting < p.exprLevel {
+		p.failNesting("expressions")
 		return nil
 	}
+	pure := p.pure
 
 	// reparse input if we have / or /= as the beginning of a new expression, this should be a regular expression!
 	if p.tt == DivToken || p.tt == DivEqToken {
@@ -1518,22 +1818,31 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 	precLeft := OpPrimary
 
 	if IsIdentifier(p.tt) && p.tt != AsyncToken {
-		left = p.scope.Use(p.data)
+		left = p.use(p.data)
 		p.next()
 		suffix := p.parseExpressionSuffix(left, prec, precLeft)
+		if pure {
+			markPure(suffix)
+		}
 		p.exprLevel--
 		return suffix
 	} else if IsNumeric(p.tt) {
-		left = &LiteralExpr{p.tt, p.data}
+		left = p.arena.newLiteralExpr(LiteralExpr{p.tt, p.data})
 		p.next()
 		suffix := p.parseExpressionSuffix(left, prec, precLeft)
+		if pure {
+			markPure(suffix)
+		}
 		p.exprLevel--
 		return suffix
 	}
 
 	switch tt := p.tt; tt {
 	case StringToken, ThisToken, NullToken, TrueToken, FalseToken, RegExpToken:
-		left = &LiteralExpr{p.tt, p.data}
+		if tt == StringToken {
+			p.checkStrictString(p.data)
+		}
+		left = p.arena.newLiteralExpr(LiteralExpr{p.tt, p.data})
 		p.next()
 	case OpenBracketToken:
 		parentInFor := p.inFor
@@ -1562,6 +1871,9 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 			break
 		}
 		suffix := p.parseParenthesizedExpressionOrArrowFunc(prec, nil)
+		if pure {
+			markPure(suffix)
+		}
 		p.exprLevel--
 		return suffix
 	case NotToken, BitNotToken, TypeofToken, VoidToken, DeleteToken:
@@ -1570,7 +1882,14 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 			return nil
 		}
 		p.next()
-		left = &UnaryExpr{tt, p.parseExpression(OpUnary)}
+		unaryExpr := p.arena.newUnaryExpr(UnaryExpr{tt, p.parseExpression(OpUnary)})
+		if tt == DeleteToken && p.scope.IsStrict {
+			if v, ok := unaryExpr.X.(*Var); ok {
+				p.failMessage("cannot delete unqualified identifier %s in strict mode", string(v.Data))
+				return nil
+			}
+		}
+		left = unaryExpr
 		precLeft = OpUnary
 	case AddToken:
 		if OpUnary < prec {
@@ -1578,7 +1897,7 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 			return nil
 		}
 		p.next()
-		left = &UnaryExpr{PosToken, p.parseExpression(OpUnary)}
+		left = p.arena.newUnaryExpr(UnaryExpr{PosToken, p.parseExpression(OpUnary)})
 		precLeft = OpUnary
 	case SubToken:
 		if OpUnary < prec {
@@ -1586,7 +1905,7 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 			return nil
 		}
 		p.next()
-		left = &UnaryExpr{NegToken, p.parseExpression(OpUnary)}
+		left = p.arena.newUnaryExpr(UnaryExpr{NegToken, p.parseExpression(OpUnary)})
 		precLeft = OpUnary
 	case IncrToken:
 		if OpUpdate < prec {
@@ -1594,7 +1913,8 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 			return nil
 		}
 		p.next()
-		left = &UnaryExpr{PreIncrToken, p.parseExpression(OpUnary)}
+		left = p.arena.newUnaryExpr(UnaryExpr{PreIncrToken, p.parseExpression(OpUnary)})
+		p.checkStrictAssign(left.(*UnaryExpr).X)
 		precLeft = OpUnary
 	case DecrToken:
 		if OpUpdate < prec {
@@ -1602,19 +1922,20 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 			return nil
 		}
 		p.next()
-		left = &UnaryExpr{PreDecrToken, p.parseExpression(OpUnary)}
+		left = p.arena.newUnaryExpr(UnaryExpr{PreDecrToken, p.parseExpression(OpUnary)})
+		p.checkStrictAssign(left.(*UnaryExpr).X)
 		precLeft = OpUnary
 	case AwaitToken:
 		// either accepted as IdentifierReference or as AwaitExpression
 		if p.async && prec <= OpUnary {
 			p.next()
-			left = &UnaryExpr{tt, p.parseExpression(OpUnary)}
+			left = p.arena.newUnaryExpr(UnaryExpr{tt, p.parseExpression(OpUnary)})
 			precLeft = OpUnary
 		} else if p.async {
 			p.fail("expression")
 			return nil
 		} else {
-			left = p.scope.Use(p.data)
+			left = p.use(p.data)
 			p.next()
 		}
 	case NewToken:
@@ -1627,7 +1948,7 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 			left = &NewTargetExpr{}
 			precLeft = OpMember
 		} else {
-			newExpr := &NewExpr{p.parseExpression(OpNew), nil}
+			newExpr := &NewExpr{p.parseExpression(OpNew), nil, false}
 			if p.tt == OpenParenToken {
 				args := p.parseArguments()
 				if len(args.List) != 0 {
@@ -1641,7 +1962,7 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 		}
 	case ImportToken:
 		// OpMember < prec does never happen
-		left = &LiteralExpr{p.tt, p.data}
+		left = p.arena.newLiteralExpr(LiteralExpr{p.tt, p.data})
 		p.next()
 		if p.tt == DotToken {
 			p.next()
@@ -1661,7 +1982,7 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 		}
 	case SuperToken:
 		// OpMember < prec does never happen
-		left = &LiteralExpr{p.tt, p.data}
+		left = p.arena.newLiteralExpr(LiteralExpr{p.tt, p.data})
 		p.next()
 		if OpCall < prec && p.tt != DotToken && p.tt != OpenBracketToken {
 			p.fail("super expression", OpenBracketToken, DotToken)
@@ -1696,7 +2017,7 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 			p.fail("expression")
 			return nil
 		} else {
-			left = p.scope.Use(p.data)
+			left = p.use(p.data)
 			p.next()
 		}
 	case AsyncToken:
@@ -1724,14 +2045,19 @@ func (p *Parser) parseExpression(prec OpPrec) IExpr {
 		return nil
 	}
 	suffix := p.parseExpressionSuffix(left, prec, precLeft)
+	if pure {
+		markPure(suffix)
+	}
 	p.exprLevel--
 	return suffix
 }
 
 func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr {
 	for i := 0; ; i++ {
-		if 1000 < p.exprLevel+i {
-			p.failMessage("too many nested expressions")
+		if p.o.MaxNesting < p.exprLevel+i {
+			p.failNesting("expressions")
+			return nil
+		} else if !p.addNode() {
 			return nil
 		}
 
@@ -1743,8 +2069,10 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				p.fail("expression")
 				return nil
 			}
+			p.checkStrictAssign(left)
+			p.checkASIHazard()
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpAssign)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpAssign)})
 			precLeft = OpAssign
 		case LtToken, LtEqToken, GtToken, GtEqToken, InToken, InstanceofToken:
 			if OpCompare < prec || p.inFor && tt == InToken {
@@ -1755,7 +2083,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpShift)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpShift)})
 			precLeft = OpCompare
 		case EqEqToken, NotEqToken, EqEqEqToken, NotEqEqToken:
 			if OpEquals < prec {
@@ -1766,7 +2094,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpCompare)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpCompare)})
 			precLeft = OpEquals
 		case AndToken:
 			if OpAnd < prec {
@@ -1776,7 +2104,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpBitOr)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpBitOr)})
 			precLeft = OpAnd
 		case OrToken:
 			if OpOr < prec {
@@ -1786,7 +2114,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpAnd)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpAnd)})
 			precLeft = OpOr
 		case NullishToken:
 			if OpCoalesce < prec {
@@ -1796,7 +2124,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpBitOr)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpBitOr)})
 			precLeft = OpCoalesce
 		case DotToken:
 			// OpMember < prec does never happen
@@ -1816,7 +2144,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 			if p.tt != PrivateIdentifierToken {
 				p.tt = IdentifierToken
 			}
-			left = &DotExpr{left, LiteralExpr{p.tt, p.data}, exprPrec}
+			left = p.arena.newDotExpr(DotExpr{left, LiteralExpr{p.tt, p.data}, exprPrec})
 			p.next()
 			if precLeft < OpMember {
 				precLeft = OpCall
@@ -1829,6 +2157,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				p.fail("expression")
 				return nil
 			}
+			p.checkASIHazard()
 			p.next()
 			exprPrec := OpMember
 			if precLeft < OpMember {
@@ -1836,7 +2165,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 			}
 			parentInFor := p.inFor
 			p.inFor = false
-			left = &IndexExpr{left, p.parseExpression(OpExpr), exprPrec}
+			left = p.arena.newIndexExpr(IndexExpr{left, p.parseExpression(OpExpr), exprPrec})
 			p.inFor = parentInFor
 			if !p.consume("index expression", CloseBracketToken) {
 				return nil
@@ -1853,9 +2182,10 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				p.fail("expression")
 				return nil
 			}
+			p.checkASIHazard()
 			parentInFor := p.inFor
 			p.inFor = false
-			left = &CallExpr{left, p.parseArguments()}
+			left = p.arena.newCallExpr(CallExpr{left, p.parseArguments(), false})
 			precLeft = OpCall
 			p.inFor = parentInFor
 		case TemplateToken, TemplateStartToken:
@@ -1864,6 +2194,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				p.fail("expression")
 				return nil
 			}
+			p.checkASIHazard()
 			parentInFor := p.inFor
 			p.inFor = false
 			template := p.parseTemplateLiteral(precLeft)
@@ -1881,10 +2212,10 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 			}
 			p.next()
 			if p.tt == OpenParenToken {
-				left = &OptChainExpr{left, &CallExpr{nil, p.parseArguments()}}
+				left = &OptChainExpr{left, p.arena.newCallExpr(CallExpr{nil, p.parseArguments(), false})}
 			} else if p.tt == OpenBracketToken {
 				p.next()
-				left = &OptChainExpr{left, &IndexExpr{nil, p.parseExpression(OpExpr), OpCall}}
+				left = &OptChainExpr{left, p.arena.newIndexExpr(IndexExpr{nil, p.parseExpression(OpExpr), OpCall})}
 				if !p.consume("optional chaining expression", CloseBracketToken) {
 					return nil
 				}
@@ -1892,10 +2223,10 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				template := p.parseTemplateLiteral(precLeft)
 				left = &OptChainExpr{left, &template}
 			} else if IsIdentifierName(p.tt) {
-				left = &OptChainExpr{left, &LiteralExpr{IdentifierToken, p.data}}
+				left = &OptChainExpr{left, p.arena.newLiteralExpr(LiteralExpr{IdentifierToken, p.data})}
 				p.next()
 			} else if p.tt == PrivateIdentifierToken {
-				left = &OptChainExpr{left, &LiteralExpr{p.tt, p.data}}
+				left = &OptChainExpr{left, p.arena.newLiteralExpr(LiteralExpr{p.tt, p.data})}
 				p.next()
 			} else {
 				p.fail("optional chaining expression", IdentifierToken, OpenParenToken, OpenBracketToken, TemplateToken)
@@ -1909,8 +2240,9 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				p.fail("expression")
 				return nil
 			}
+			p.checkStrictAssign(left)
 			p.next()
-			left = &UnaryExpr{PostIncrToken, left}
+			left = p.arena.newUnaryExpr(UnaryExpr{PostIncrToken, left})
 			precLeft = OpUpdate
 		case DecrToken:
 			if p.prevLT || OpUpdate < prec {
@@ -1919,8 +2251,9 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				p.fail("expression")
 				return nil
 			}
+			p.checkStrictAssign(left)
 			p.next()
-			left = &UnaryExpr{PostDecrToken, left}
+			left = p.arena.newUnaryExpr(UnaryExpr{PostDecrToken, left})
 			precLeft = OpUpdate
 		case ExpToken:
 			if OpExp < prec {
@@ -1930,7 +2263,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpExp)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpExp)})
 			precLeft = OpExp
 		case MulToken, DivToken, ModToken:
 			if OpMul < prec {
@@ -1939,8 +2272,9 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				p.fail("expression")
 				return nil
 			}
+			p.checkASIHazard()
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpExp)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpExp)})
 			precLeft = OpMul
 		case AddToken, SubToken:
 			if OpAdd < prec {
@@ -1949,8 +2283,9 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				p.fail("expression")
 				return nil
 			}
+			p.checkASIHazard()
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpMul)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpMul)})
 			precLeft = OpAdd
 		case LtLtToken, GtGtToken, GtGtGtToken:
 			if OpShift < prec {
@@ -1960,7 +2295,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpAdd)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpAdd)})
 			precLeft = OpShift
 		case BitAndToken:
 			if OpBitAnd < prec {
@@ -1970,7 +2305,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpEquals)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpEquals)})
 			precLeft = OpBitAnd
 		case BitXorToken:
 			if OpBitXor < prec {
@@ -1980,7 +2315,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpBitAnd)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpBitAnd)})
 			precLeft = OpBitXor
 		case BitOrToken:
 			if OpBitOr < prec {
@@ -1990,7 +2325,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return nil
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpBitXor)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpBitXor)})
 			precLeft = OpBitOr
 		case QuestionToken:
 			if OpAssign < prec {
@@ -2012,7 +2347,7 @@ func (p *Parser) parseExpressionSuffix(left IExpr, prec, precLeft OpPrec) IExpr
 				return left
 			}
 			p.next()
-			left = &BinaryExpr{tt, left, p.parseExpression(OpAssign)}
+			left = p.arena.newBinaryExpr(BinaryExpr{tt, left, p.parseExpression(OpAssign)})
 			precLeft = OpExpr
 		case ArrowToken:
 			// handle identifier => ..., where identifier could also be yield or await
@@ -2045,7 +2380,7 @@ func (p *Parser) parseAssignmentExpression() IExpr {
 		p.next()
 		if p.tt == EqToken || p.tt == CommaToken || p.tt == CloseParenToken || p.tt == CloseBraceToken || p.tt == CloseBracketToken {
 			var left IExpr
-			left, _ = p.scope.Declare(ArgumentDecl, data) // cannot fail
+			left, _ = p.scope.declare(p.arena, ArgumentDecl, data) // cannot fail
 			p.assumeArrowFunc = false
 			left = p.parseExpressionSuffix(left, OpAssign, OpPrimary)
 			p.assumeArrowFunc = true
@@ -2088,7 +2423,7 @@ func (p *Parser) parseParenthesizedExpressionOrArrowFunc(prec OpPrec, async []by
 					p.next()
 				}
 			} else if p.isIdentifierReference(p.tt) {
-				rest, _ = p.scope.Declare(ArgumentDecl, p.data) // cannot fail
+				rest, _ = p.scope.declare(p.arena, ArgumentDecl, p.data) // cannot fail
 				p.next()
 			} else if p.tt == OpenBracketToken {
 				array := p.parseArrayLiteral()
@@ -2128,7 +2463,7 @@ func (p *Parser) parseParenthesizedExpressionOrArrowFunc(prec OpPrec, async []by
 		}
 		arrowFunc.Async = isAsync
 		arrowFunc.Params.Rest = p.exprToBinding(rest)
-		arrowFunc.Body.List = p.parseArrowFuncBody()
+		arrowFunc.Body.List = p.parseArrowFuncBody(arrowFunc.Params)
 
 		p.async, p.generator = parentAsync, parentGenerator
 		p.exitScope(parent)
@@ -2154,14 +2489,14 @@ func (p *Parser) parseParenthesizedExpressionOrArrowFunc(prec OpPrec, async []by
 			if rest != nil {
 				args.List = append(args.List, Arg{Value: rest, Rest: true})
 			}
-			left = p.scope.Use(async)
-			left = &CallExpr{left, args}
+			left = p.use(async)
+			left = p.arena.newCallExpr(CallExpr{left, args, false})
 			precLeft = OpCall
 		} else {
 			// parenthesized expression
 			left = list[0]
 			for _, item := range list[1:] {
-				left = &BinaryExpr{CommaToken, left, item}
+				left = p.arena.newBinaryExpr(BinaryExpr{CommaToken, left, item})
 			}
 			left = &GroupExpr{left}
 		}
@@ -2222,3 +2557,112 @@ func (p *Parser) exprToBindingElement(expr IExpr) (bindingElement BindingElement
 func (p *Parser) isIdentifierReference(tt TokenType) bool {
 	return IsIdentifier(tt) || tt == YieldToken && !p.generator || tt == AwaitToken && !p.async
 }
+
+// declare declares a binding identifier in the current scope and checks it when in strict mode.
+func (p *Parser) declare(decl DeclType, name []byte) (*Var, bool) {
+	p.checkEscapedKeyword(name)
+	v, ok := p.scope.declare(p.arena, decl, name)
+	if ok && p.scope.IsStrict {
+		p.checkStrictBinding(name)
+	}
+	return v, ok
+}
+
+// use adds an identifier reference to the current scope and checks it when in strict mode.
+func (p *Parser) use(name []byte) *Var {
+	p.checkEscapedKeyword(name)
+	if p.scope.IsStrict && isStrictReservedWord(DecodeIdentifier(name)) {
+		p.failMessage("unexpected %s in strict mode", string(name))
+	}
+	return p.scope.use(p.arena, name)
+}
+
+// checkStrictBinding fails for binding identifiers that are not allowed in strict mode.
+func (p *Parser) checkStrictBinding(name []byte) {
+	decoded := DecodeIdentifier(name)
+	if isStrictReservedWord(decoded) {
+		p.failMessage("unexpected %s in strict mode", string(name))
+	} else if bytes.Equal(decoded, []byte("eval")) || bytes.Equal(decoded, []byte("arguments")) {
+		p.failMessage("cannot declare %s in strict mode", string(name))
+	}
+}
+
+// checkStrictAssign fails for assignments to eval or arguments in strict mode, including the targets in array and object destructuring assignments.
+func (p *Parser) checkStrictAssign(left IExpr) {
+	if !p.scope.IsStrict {
+		return
+	}
+	switch left := left.(type) {
+	case *Var:
+		if decoded := DecodeIdentifier(left.Data); bytes.Equal(decoded, []byte("eval")) || bytes.Equal(decoded, []byte("arguments")) {
+			p.failMessage("cannot assign to %s in strict mode", string(left.Data))
+		}
+	case *GroupExpr:
+		p.checkStrictAssign(left.X)
+	case *BinaryExpr:
+		// target with default value
+		if left.Op == EqToken {
+			p.checkStrictAssign(left.X)
+		}
+	case *ArrayExpr:
+		for _, item := range left.List {
+			p.checkStrictAssign(item.Value)
+		}
+	case *ObjectExpr:
+		for _, item := range left.List {
+			p.checkStrictAssign(item.Value)
+		}
+	}
+}
+
+// checkStrictString fails for string literals with octal escape sequences in strict mode.
+func (p *Parser) checkStrictString(s []byte) {
+	if p.scope.IsStrict && hasOctalEscape(s) {
+		p.failMessage("octal escape sequences are not allowed in strict mode")
+	}
+}
+
+// checkStrictFunc checks the function name and parameters after parsing the function body of the current scope, since a "use strict" directive in the body applies to them as well.
+func (p *Parser) checkStrictFunc(name *Var, params Params) {
+	if p.useStrict && !params.isSimple() {
+		p.failMessage("\"use strict\" not allowed in function with non-simple parameters")
+		return
+	} else if !p.scope.IsStrict {
+		return
+	}
+	if name != nil {
+		p.checkStrictBinding(name.Data)
+	}
+	vars := params.vars()
+	for i, v := range vars {
+		p.checkStrictBinding(v.Data)
+		for _, w := range vars[:i] {
+			if equalIdentifier(v.Data, w.Data) {
+				p.failMessage("duplicate parameter %s not allowed in strict mode", string(v.Data))
+				return
+			}
+		}
+	}
+}
+
+// isSimple returns true if all parameters are identifiers without default values and there is no rest parameter.
+func (n Params) isSimple() bool {
+	if n.Rest != nil {
+		return false
+	}
+	for _, item := range n.List {
+		if _, ok := item.Binding.(*Var); !ok || item.Default != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// vars returns all bound variables of the parameters.
+func (n Params) vars() []*Var {
+	vars := []*Var{}
+	for _, item := range n.List {
+		vars = append(vars, bindingVars(item.Binding)...)
+	}
+	return append(vars, bindingVars(n.Rest)...)
+}
@@ -1,6 +1,8 @@
 package js
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sort"
@@ -386,6 +388,13 @@ func TestParse(t *testing.T) {
 		{"return //comment\n a", "Stmt(return) Stmt(a)"},
 		{"a?.b\n`c`", "Stmt((a?.b)`c`)"},
 
+		// unicode escapes
+		{`var \u0061b = a\u{62}`, `Decl(var Binding(\u0061b = \u0061b))`},
+		{`import {\u0069f as x} from 'a'`, `Stmt(import { \u0069f as x } from 'a')`},
+		{`a.\u0069f; ({\u0069f: b}).\u{69}f?.\u0069f`, `Stmt(a.\u0069f) Stmt((({\u0069f: b}).\u{69}f)?.\u0069f)`},
+		{`export {a as \u0069f}`, `Stmt(export { a as \u0069f })`},
+		{`class A { \u0069f() {} }`, `Decl(class A Method(\u0069f Params() Stmt({ })))`},
+
 		{"() => { const v=6; x={v} }", "Stmt(Params() => Stmt({ Decl(const Binding(v = 6)) Stmt(x={v}) }))"},
 		{`([]=l=>{let{e}={e}})`, `Stmt(([]=(Params(Binding(l)) => Stmt({ Decl(let Binding({ Binding(e) } = {e})) }))))`}, // go-fuzz
 	}
@@ -412,6 +421,80 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseStrict(t *testing.T) {
+	var tests = []struct {
+		js     string
+		module bool
+		strict string
+	}{
+		{"a; function f() {} { let b }", false, "0/0/0"},
+		{"a; function f() {} { let b }", true, "1/1/1"},
+		{"'use strict'; function f() {} { let b }", false, "1/1/1"},
+		{"function f() { 'use strict'; { let b } } function g() {}", false, "0/1/1/0"},
+		{"'a'; 'use strict'; function f() {}", false, "1/1"},
+		{"a; 'use strict'; function f() {}", false, "0/0"},
+		{"\"use\\x20strict\"; function f() {}", false, "0/0"},
+		{"class A { m() {} }; function f() {}", false, "0/1/0"},
+		{"x => { 'use strict' }; () => 0", false, "0/1/0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := ParseContext(context.Background(), parse.NewInputString(tt.js), Options{Module: tt.module})
+			test.Error(t, err)
+
+			strict := strictCollector{[]*Scope{&ast.BlockStmt.Scope}}
+			Walk(&strict, ast)
+			test.String(t, strings.Join(strict.flags(), "/"), tt.strict)
+		})
+	}
+
+	// sloppy mode code is allowed outside of strict mode
+	for _, js := range []string{"with (a) b", "var let, static, yield, eval; arguments = 1; delete a; '\\01'", "function f(a, a) {}", "[eval, {a: arguments}] = b; for (arguments in a);", "l: function f() {}"} {
+		_, err := Parse(parse.NewInputString(js))
+		test.Error(t, err, js)
+	}
+}
+
+type strictCollector struct {
+	scopes []*Scope
+}
+
+func (c *strictCollector) Enter(n INode) IVisitor {
+	switch n := n.(type) {
+	case *BlockStmt:
+		if n.Scope.Parent != nil {
+			c.scopes = append(c.scopes, &n.Scope)
+		}
+	case *FuncDecl:
+		c.scopes = append(c.scopes, &n.Body.Scope)
+		for _, stmt := range n.Body.List {
+			Walk(c, stmt)
+		}
+		return nil
+	case *MethodDecl:
+		c.scopes = append(c.scopes, &n.Body.Scope)
+		return nil
+	case *ArrowFunc:
+		c.scopes = append(c.scopes, &n.Body.Scope)
+		return nil
+	}
+	return c
+}
+
+func (c *strictCollector) Exit(n INode) {}
+
+func (c *strictCollector) flags() []string {
+	flags := []string{}
+	for _, scope := range c.scopes {
+		if scope.IsStrict {
+			flags = append(flags, "1")
+		} else {
+			flags = append(flags, "0")
+		}
+	}
+	return flags
+}
+
 func TestParseError(t *testing.T) {
 	var tests = []struct {
 		js  string
@@ -669,6 +752,50 @@ func TestParseError(t *testing.T) {
 		{"a=\u2010", "unexpected \u2010 in expression"},
 		{"/", "unexpected EOF or newline in regular expression"},
 		{"({...[]})=>a", "unexpected => in expression"}, // go-fuzz
+		{"\\u0069f (a) b", "unexpected escaped keyword \\u0069f"},
+		{"\\u{76}ar a", "unexpected escaped keyword \\u{76}ar"},
+		{"var \\u0069f", "unexpected escaped keyword \\u0069f"},
+		{"import {\\u0069f} from 'a'", "unexpected escaped keyword \\u0069f"},
+		{"import \\u0069f from 'a'", "unexpected escaped keyword \\u0069f"},
+		{"\\u0069f: a", "unexpected escaped keyword \\u0069f"},
+		{"a \\u0069nstanceof b", "unexpected \\u0069nstanceof in expression"},
+		{"x = n\\u0075ll", "unexpected escaped keyword n\\u0075ll"},
+		{"var \\u{2F}x = 1", "unexpected \\ in binding"},
+		{"var a\\u{2F}x = 1", "unexpected \\"},
+		{"let \\u0061; let a", "identifier a has already been declared"},
+
+		// strict mode
+		{"'use strict'; with (a) b", "with statement not allowed in strict mode"},
+		{"function f() { 'use strict'; with (a) b }", "with statement not allowed in strict mode"},
+		{"class A { m() { with (a) b } }", "with statement not allowed in strict mode"},
+		{"'use strict'; var let", "unexpected let in strict mode"},
+		{"'use strict'; static = 1", "unexpected static in strict mode"},
+		{"'use strict'; yield", "unexpected yield in strict mode"},
+		{"'use strict'; var \\u0069mplements", "unexpected \\u0069mplements in strict mode"},
+		{"'use strict'; function eval() {}", "cannot declare eval in strict mode"},
+		{"'use strict'; let [arguments] = a", "cannot declare arguments in strict mode"},
+		{"'use strict'; eval = 1", "cannot assign to eval in strict mode"},
+		{"'use strict'; arguments++", "cannot assign to arguments in strict mode"},
+		{"'use strict'; --eval", "cannot assign to eval in strict mode"},
+		{"'use strict'; [eval] = a", "cannot assign to eval in strict mode"},
+		{"'use strict'; [a, ...eval] = b", "cannot assign to eval in strict mode"},
+		{"'use strict'; [[eval = 1]] = a", "cannot assign to eval in strict mode"},
+		{"'use strict'; ({a: arguments} = b)", "cannot assign to arguments in strict mode"},
+		{"'use strict'; ({arguments} = b)", "cannot assign to arguments in strict mode"},
+		{"'use strict'; ({a: {b: (eval)}} = c)", "cannot assign to eval in strict mode"},
+		{"'use strict'; for (arguments in a);", "cannot assign to arguments in strict mode"},
+		{"'use strict'; for ([eval] of a);", "cannot assign to eval in strict mode"},
+		{"'use strict'; l: function f(){}", "labelled function declaration not allowed in strict mode"},
+		{"function g() { 'use strict'; l: m: function f(){} }", "labelled function declaration not allowed in strict mode"},
+		{"'use strict'; delete a", "cannot delete unqualified identifier a in strict mode"},
+		{"'use strict'; '\\01'", "octal escape sequences are not allowed in strict mode"},
+		{"'use strict'; ({'\\8': 1})", "octal escape sequences are not allowed in strict mode"},
+		{"function f(a, a) { 'use strict' }", "duplicate parameter a not allowed in strict mode"},
+		{"function eval() { 'use strict' }", "cannot declare eval in strict mode"},
+		{"function f(a = 1) { 'use strict' }", "\"use strict\" not allowed in function with non-simple parameters"},
+		{"(a, a) => { 'use strict' }", "duplicate parameter a not allowed in strict mode"},
+		{"class let {}", "unexpected let in strict mode"},
+		{"(class { m(a, a) {} })", "duplicate parameter a not allowed in strict mode"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.js, func(t *testing.T) {
@@ -983,6 +1110,11 @@ func TestParseScope(t *testing.T) {
 		{`for(let b of c){let b;{b}}`, "/b=2,b=3/", "c=1/c=1/b=3"},
 		{`for(var b of c){let b;{b}}`, "b=1/b=3/", "c=2/b=1,c=2/b=3"},
 		{`for(var b of c){var b;{b}}`, "b=1//", "c=2/b=1,c=2/b=1"},
+		{`var \u0061b; ab`, "\\u0061b=1", ""},
+		{`var ab; a\u{62}; {let \u0061b; ab}`, "ab=1/\\u0061b=2", "/"},
+		{`function \u0061(){} a(); \u{00061}`, "\\u0061=1/", "/"},
+		{`var \u4e2d; 中; {\u{4E2D}}`, "\\u4e2d=1/", "/\\u4e2d=1"},
+		{`var \u00aa; ª`, "\\u00aa=1", ""},
 	}
 	for _, tt := range tests {
 		t.Run(tt.js, func(t *testing.T) {
@@ -1029,3 +1161,88 @@ func TestParseInputError(t *testing.T) {
 	_, err = Parse(parse.NewInput(test.NewErrorReader(1)))
 	test.T(t, err, test.ErrPlain)
 }
+
+func TestParseLimits(t *testing.T) {
+	var tests = []struct {
+		js    string
+		o     Options
+		limit Limit
+		err   string
+	}{
+		{"a(b(c))", Options{MaxNesting: 1}, NestingLimit, "nesting depth of expressions exceeds maximum of 1"},
+		{"{{a}}", Options{MaxNesting: 2}, NestingLimit, "nesting depth of statements exceeds maximum of 2"},
+		{"a + b + c", Options{MaxTokens: 8}, TokenLimit, "number of tokens exceeds maximum of 8"},
+		{"var a = 5", Options{MaxInputSize: 8}, InputSizeLimit, "input size exceeds maximum of 8 bytes"},
+		{"a; b + c", Options{MaxNodes: 5}, NodeLimit, "number of nodes exceeds maximum of 5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			_, err := ParseContext(context.Background(), parse.NewInputString(tt.js), tt.o)
+			limitErr, ok := err.(*LimitError)
+			test.That(t, ok, "must be a LimitError")
+			test.T(t, limitErr.Limit, tt.limit)
+
+			e := err.Error()
+			if len(tt.err) < len(err.Error()) {
+				e = e[:len(tt.err)]
+			}
+			test.String(t, e, tt.err)
+		})
+	}
+
+	// default nesting limit
+	_, err := Parse(parse.NewInputString(strings.Repeat("[", 1001) + strings.Repeat("]", 1001)))
+	limitErr, ok := err.(*LimitError)
+	test.That(t, ok, "must be a LimitError")
+	test.T(t, limitErr.Max, 1000)
+
+	// within limits
+	_, err = ParseContext(context.Background(), parse.NewInputString("a(b(c))"), Options{MaxNesting: 2, MaxTokens: 8, MaxInputSize: 7, MaxNodes: 6})
+	test.Error(t, err)
+
+	// cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ParseContext(ctx, parse.NewInputString("var a = 5"), Options{})
+	test.That(t, errors.Is(err, context.Canceled), "must be context.Canceled")
+
+	_, err = ParseContext(ctx, parse.NewInputString(strings.Repeat("a;", 1000)), Options{})
+	test.That(t, errors.Is(err, context.Canceled), "must be context.Canceled")
+
+	// cancelled while parsing
+	js := strings.Repeat("a;", 1000)
+	_, err = ParseContext(&cancelAfter{Context: context.Background(), n: 2}, parse.NewInputString(js), Options{})
+	test.That(t, errors.Is(err, context.Canceled), "must be context.Canceled")
+	limitErr, ok = err.(*LimitError)
+	test.That(t, ok, "must be a LimitError")
+	test.T(t, limitErr.Limit, ContextLimit)
+	test.That(t, 0 < limitErr.Offset && limitErr.Offset < len(js), "must abort mid-parse")
+
+	// negative limits
+	for _, o := range []Options{{MaxNesting: -1}, {MaxTokens: -1}, {MaxInputSize: -1}, {MaxNodes: -1}} {
+		_, err = ParseContext(context.Background(), parse.NewInputString("a"), o)
+		test.That(t, err != nil, "must fail")
+		_, ok := err.(*LimitError)
+		test.That(t, !ok, "must not be a LimitError")
+	}
+	_, err = ParseContext(context.Background(), parse.NewInputString("a"), Options{MaxInputSize: -1})
+	test.String(t, err.Error(), "invalid negative maximum -1 for input size")
+}
+
+// cancelAfter is a context that is cancelled after its error has been checked n times.
+type cancelAfter struct {
+	context.Context
+	n int
+}
+
+func (ctx *cancelAfter) Done() <-chan struct{} {
+	return make(chan struct{})
+}
+
+func (ctx *cancelAfter) Err() error {
+	if ctx.n == 0 {
+		return context.Canceled
+	}
+	ctx.n--
+	return nil
+}
@@ -0,0 +1,246 @@
+package js
+
+import "bytes"
+
+// HasSideEffects returns true if evaluating the statement or expression may have side effects, which includes function calls, property accesses on unknown objects that may invoke getters, assignments, and references to undeclared variables or to let, const, and class bindings, which may throw. It is conservative: it returns false only if the node can be removed without changing the program's behaviour. Calls and new expressions annotated by /*#__PURE__*/ are considered free of side effects apart from their arguments.
+func HasSideEffects(n INode) bool {
+	switch n := n.(type) {
+	case nil:
+		return false
+	case *AST:
+		return HasSideEffects(&n.BlockStmt)
+	case *Var:
+		for n.Link != nil {
+			n = n.Link
+		}
+		if n.Decl == LexicalDecl {
+			return true // may throw a ReferenceError in its temporal dead zone
+		} else if n.Decl != NoDecl {
+			return false
+		}
+		// referencing an undeclared variable throws a ReferenceError, except for some well-known globals
+		return !bytes.Equal(n.Data, []byte("undefined")) && !bytes.Equal(n.Data, []byte("NaN")) && !bytes.Equal(n.Data, []byte("Infinity"))
+	case *LiteralExpr, *NewTargetExpr, *ImportMetaExpr, *FuncDecl, *ArrowFunc, *EmptyStmt, *DirectivePrologueStmt:
+		return false
+	case *GroupExpr:
+		return HasSideEffects(n.X)
+	case *ArrayExpr:
+		for _, item := range n.List {
+			if item.Spread || HasSideEffects(item.Value) {
+				return true // spread invokes the iterator
+			}
+		}
+		return false
+	case *ObjectExpr:
+		for _, item := range n.List {
+			if item.Spread || item.Name != nil && propertyNameHasSideEffects(*item.Name) {
+				return true // spread invokes getters
+			} else if method, ok := item.Value.(*MethodDecl); ok {
+				if propertyNameHasSideEffects(method.Name) {
+					return true
+				}
+			} else if HasSideEffects(item.Value) {
+				return true
+			}
+		}
+		return false
+	case *TemplateExpr:
+		if n.Tag != nil {
+			return true
+		}
+		for _, item := range n.List {
+			// conversion to string may call toString or valueOf
+			if HasSideEffects(item.Expr) || !isPrimitive(item.Expr) {
+				return true
+			}
+		}
+		return false
+	case *DotExpr:
+		return !hasNoGetters(n.X)
+	case *IndexExpr:
+		return !hasNoGetters(n.X) || HasSideEffects(n.Y) || !isPrimitive(n.Y)
+	case *CallExpr:
+		return !n.Pure || argsHaveSideEffects(n.Args)
+	case *NewExpr:
+		return !n.Pure || n.Args != nil && argsHaveSideEffects(*n.Args)
+	case *UnaryExpr:
+		switch n.Op {
+		case TypeofToken:
+			if v, ok := n.X.(*Var); ok {
+				for v.Link != nil {
+					v = v.Link
+				}
+				if v.Decl != LexicalDecl {
+					return false // typeof does not throw for undeclared variables
+				}
+			}
+			return HasSideEffects(n.X)
+		case NotToken, VoidToken:
+			return HasSideEffects(n.X)
+		case PosToken, NegToken, BitNotToken:
+			// conversion to number may call valueOf
+			return HasSideEffects(n.X) || !isPrimitive(n.X)
+		}
+		return true // delete, await, increments and decrements
+	case *BinaryExpr:
+		switch n.Op {
+		case CommaToken, AndToken, OrToken, NullishToken, EqEqEqToken, NotEqEqToken:
+			return HasSideEffects(n.X) || HasSideEffects(n.Y)
+		case EqToken, MulEqToken, DivEqToken, ModEqToken, ExpEqToken, AddEqToken, SubEqToken, LtLtEqToken, GtGtEqToken, GtGtGtEqToken, BitAndEqToken, BitXorEqToken, BitOrEqToken, AndEqToken, OrEqToken, NullishEqToken, InToken, InstanceofToken:
+			return true // assignments, or operators that throw for non-objects
+		}
+		// conversion to primitives may call toString or valueOf
+		return HasSideEffects(n.X) || HasSideEffects(n.Y) || !isPrimitive(n.X) || !isPrimitive(n.Y)
+	case *CondExpr:
+		return HasSideEffects(n.Cond) || HasSideEffects(n.X) || HasSideEffects(n.Y)
+	case *ClassDecl:
+		if n.Extends != nil {
+			return true // throws if not a constructor
+		}
+		for _, item := range n.Definitions {
+			// static and instance fields are not distinguished in the AST
+			if propertyNameHasSideEffects(item.Name) || HasSideEffects(item.Init) {
+				return true
+			}
+		}
+		for _, item := range n.Methods {
+			if propertyNameHasSideEffects(item.Name) {
+				return true
+			}
+		}
+		return false
+	case *VarDecl:
+		for _, item := range n.List {
+			if _, ok := item.Binding.(*Var); !ok || HasSideEffects(item.Default) {
+				return true // destructuring may invoke getters and iterators
+			}
+		}
+		return false
+	case *ExprStmt:
+		return HasSideEffects(n.Value)
+	case *BlockStmt:
+		for _, item := range n.List {
+			if HasSideEffects(item) {
+				return true
+			}
+		}
+		return false
+	case *IfStmt:
+		return HasSideEffects(n.Cond) || HasSideEffects(n.Body) || n.Else != nil && HasSideEffects(n.Else)
+	case *ExportStmt:
+		return n.Decl != nil && HasSideEffects(n.Decl)
+	}
+	// loops may not terminate, other statements change control flow or load modules
+	return true
+}
+
+// UnusedDecl is a top-level declaration of a variable that is never referenced. Variables that are declared multiple times are reported for each declaration.
+type UnusedDecl struct {
+	Var  *Var
+	Stmt IStmt // *VarDecl, *FuncDecl, or *ClassDecl
+}
+
+// UnusedDecls returns the top-level declarations of a module whose variables are never referenced nor exported, and whose initialization has no side effects. These can be removed by tree shaking. A variable is unused if its Uses equals its number of declarations, since each declaration counts as a use in the scope analysis.
+func UnusedDecls(ast *AST) []UnusedDecl {
+	exported := map[string]bool{}
+	for _, export := range Exports(ast) {
+		if export.Module == nil && export.Local != nil {
+			exported[string(export.Local)] = true
+		}
+	}
+
+	decls := declCounter{}
+	Walk(decls, ast)
+
+	unused := []UnusedDecl{}
+	isUnused := func(v *Var) bool {
+		return v.Link == nil && int(v.Uses) <= decls[v] && !exported[string(v.Data)]
+	}
+	for _, item := range ast.List {
+		switch n := item.(type) {
+		case *FuncDecl:
+			if n.Name != nil && isUnused(n.Name) {
+				unused = append(unused, UnusedDecl{n.Name, n})
+			}
+		case *ClassDecl:
+			if n.Name != nil && isUnused(n.Name) && !HasSideEffects(n) {
+				unused = append(unused, UnusedDecl{n.Name, n})
+			}
+		case *VarDecl:
+			for _, binding := range n.List {
+				if v, ok := binding.Binding.(*Var); ok && isUnused(v) && !HasSideEffects(binding.Default) {
+					unused = append(unused, UnusedDecl{v, n})
+				}
+			}
+		}
+	}
+	return unused
+}
+
+// declCounter counts the number of declarations of each variable.
+type declCounter map[*Var]int
+
+func (decls declCounter) Enter(n INode) IVisitor {
+	switch n := n.(type) {
+	case *VarDecl:
+		for _, item := range n.List {
+			for _, v := range bindingVars(item.Binding) {
+				decls[v]++
+			}
+		}
+	case *FuncDecl:
+		if n.Name != nil {
+			decls[n.Name]++
+		}
+	case *ClassDecl:
+		if n.Name != nil {
+			decls[n.Name]++
+		}
+	}
+	return decls
+}
+
+func (decls declCounter) Exit(n INode) {}
+
+func propertyNameHasSideEffects(name PropertyName) bool {
+	// computed names are converted to property keys, which may call toString
+	return name.IsComputed() && (HasSideEffects(name.Computed) || !isPrimitive(name.Computed))
+}
+
+func argsHaveSideEffects(args Args) bool {
+	for _, item := range args.List {
+		if item.Rest || HasSideEffects(item.Value) {
+			return true // spread invokes the iterator
+		}
+	}
+	return false
+}
+
+// isPrimitive returns true if the expression evaluates to a primitive value, whose conversions have no side effects.
+func isPrimitive(expr IExpr) bool {
+	switch InferValueType(expr) {
+	case UndefinedValue, NullValue, BooleanValue, NumberValue, BigIntValue, StringValue:
+		return true
+	}
+	return false
+}
+
+// hasNoGetters returns true if accessing properties of the expression cannot invoke getters, which is only known for literals.
+func hasNoGetters(expr IExpr) bool {
+	switch n := expr.(type) {
+	case *GroupExpr:
+		return hasNoGetters(n.X)
+	case *LiteralExpr:
+		return n.TokenType != NullToken && n.TokenType != ThisToken && n.TokenType != SuperToken && n.TokenType != ImportToken
+	case *ArrayExpr, *TemplateExpr:
+		return !HasSideEffects(n)
+	case *ObjectExpr:
+		for _, item := range n.List {
+			if method, ok := item.Value.(*MethodDecl); ok && (method.Get || method.Set) {
+				return false
+			}
+		}
+		return !HasSideEffects(n)
+	}
+	return false
+}
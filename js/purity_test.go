@@ -0,0 +1,114 @@
+package js
+
+import (
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestHasSideEffects(t *testing.T) {
+	var tests = []struct {
+		js          string
+		sideEffects bool
+	}{
+		{"", false},
+		{"5", false},
+		{"'use strict'", false},
+		{"var a = 5, b = [1, 'a'], c = {d: 1, [2]: 3, e() {}}", false},
+		{"var a = {[b]: 1}", true},
+		{"var [a] = b", true},
+		{"var a; a", false},
+		{"let a; a", true}, // temporal dead zone
+		{"a; const a = 5", true},
+		{"class A {} A", true},
+		{"let a; typeof a", true},
+		{"undefined, NaN, Infinity", false},
+		{"a", true},
+		{"typeof a", false},
+		{"typeof a.b", true},
+		{"f()", true},
+		{"/*#__PURE__*/ f()", false},
+		{"/* @__PURE__ */ new F(1, 'a')", false},
+		{"/*#__PURE__*/ f(g())", true},
+		{"/*#__PURE__*/ f(...a)", true},
+		{"var a = /*#__PURE__*/ f(), b = /*#__PURE__*/ g()", false},
+		{"/*#__PURE__*/ f(), 1", false},
+		{"/*#__PURE__*/ f() + 1", true},
+		{"/* PURE */ f()", true},
+		{"a = 5", true},
+		{"let a; a.b", true},
+		{"({a: 1}).a", false},
+		{"({get a() { x() }}).a", true},
+		{"'str'.length", false},
+		{"var a; a === 5", false},
+		{"let a; a == 5", true},
+		{"1 + 2 * 3", false},
+		{"let a; -a", true},
+		{"!0 ? `a${1}` : void 0", false},
+		{"let a; `a${a}`", true},
+		{"tag`a`", true},
+		{"[...a]", true},
+		{"({...a})", true},
+		{"delete a.b", true},
+		{"x => x; (function() { x() })", false},
+		{"class A { a = 5; [1]() {} }", false},
+		{"class A { a = f() }", true},
+		{"class A extends B {}", true},
+		{"if (1) { 2 } else 3", false},
+		{"while (1) {}", true},
+		{"export var a = 5", false},
+		{"export default f()", true},
+		{"import 'mod'", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(tt.js))
+			test.Error(t, err)
+			test.T(t, HasSideEffects(ast), tt.sideEffects)
+		})
+	}
+}
+
+func TestUnusedDecls(t *testing.T) {
+	var tests = []struct {
+		js     string
+		unused string
+	}{
+		{"var a = 5", "a"},
+		{"var a = 5; a", ""},
+		{"var a = 5; var a", "a a"},
+		{"function f() {}", "f"},
+		{"function f() {} f()", ""},
+		{"function f() {} function g() { f() }", "g"},
+		{"class A {} class B extends A {}", ""},
+		{"let a = f(), b = 5", "b"},
+		{"let a = /*#__PURE__*/ f()", "a"},
+		{"let a = 5; export {a}", ""},
+		{"export let a = 5", ""},
+		{"let a = 5; export {b as a}", "a"},
+		{"let a = 5; { a }", ""},
+		{"let a = 5; function f() { return a }; export default f", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			ast, err := Parse(parse.NewInputString(tt.js))
+			test.Error(t, err)
+
+			unused := ""
+			for _, decl := range UnusedDecls(ast) {
+				if unused != "" {
+					unused += " "
+				}
+				unused += string(decl.Var.Data)
+			}
+			test.String(t, unused, tt.unused)
+		})
+	}
+}
+
+func TestPureAnnotation(t *testing.T) {
+	ast, err := Parse(parse.NewInputString("a = /*#__PURE__*/ f(); /*@__PURE__*/ new G; b = f()"))
+	test.Error(t, err)
+	test.String(t, ast.JS(), "a = /*#__PURE__*/ f(); /*#__PURE__*/ new G(); b = f(); ")
+}
@@ -78,6 +78,72 @@ func (prec OpPrec) String() string {
 	return "Invalid(" + strconv.Itoa(int(prec)) + ")"
 }
 
+// ExprPrec returns the precedence of an expression, which is used to determine whether it needs to be parenthesized to be an operand of an operator with a given precedence. Note that the JS methods of the nodes do not add parentheses.
+func ExprPrec(expr IExpr) OpPrec {
+	switch n := expr.(type) {
+	case *DotExpr:
+		return n.Prec
+	case *IndexExpr:
+		return n.Prec
+	case *TemplateExpr:
+		if n.Tag == nil {
+			return OpPrimary
+		}
+		return n.Prec
+	case *NewTargetExpr, *ImportMetaExpr:
+		return OpMember
+	case *CallExpr, *OptChainExpr:
+		return OpCall
+	case *NewExpr:
+		return OpMember // always printed with arguments
+	case *UnaryExpr:
+		if n.Op == PostIncrToken || n.Op == PostDecrToken {
+			return OpUpdate
+		}
+		return OpUnary
+	case *BinaryExpr:
+		return BinaryPrec(n.Op)
+	case *CondExpr, *YieldExpr, *ArrowFunc:
+		return OpAssign
+	case *VarDecl:
+		return OpExpr
+	}
+	return OpPrimary
+}
+
+// BinaryPrec returns the precedence of a binary operator, assignment operators return OpAssign.
+func BinaryPrec(op TokenType) OpPrec {
+	switch op {
+	case CommaToken:
+		return OpExpr
+	case NullishToken:
+		return OpCoalesce
+	case OrToken:
+		return OpOr
+	case AndToken:
+		return OpAnd
+	case BitOrToken:
+		return OpBitOr
+	case BitXorToken:
+		return OpBitXor
+	case BitAndToken:
+		return OpBitAnd
+	case EqEqToken, NotEqToken, EqEqEqToken, NotEqEqToken:
+		return OpEquals
+	case LtToken, GtToken, LtEqToken, GtEqToken, InstanceofToken, InToken:
+		return OpCompare
+	case LtLtToken, GtGtToken, GtGtGtToken:
+		return OpShift
+	case AddToken, SubToken:
+		return OpAdd
+	case MulToken, DivToken, ModToken:
+		return OpMul
+	case ExpToken:
+		return OpExp
+	}
+	return OpAssign
+}
+
 // Keywords is a map of reserved, strict, and other keywords
 var Keywords = map[string]TokenType{
 	// reserved
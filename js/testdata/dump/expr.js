@@ -0,0 +1,5 @@
+const {x, y: [z = 1]} = obj;
+class A extends B {
+	m(...args) { return super.m(`t${args}`); }
+}
+a?.b[c] ?? new A(() => !x, async function* g() { yield* z; });
@@ -0,0 +1,8 @@
+var a = 5;
+let b;
+function f(c) {
+	{
+		let a;
+		return a + b + c + d;
+	}
+}
@@ -0,0 +1,5 @@
+label: for (let i = 0; i < 10; i++) {
+	if (i % 2) continue label;
+	else switch (i) { case 4: break label; default: throw i; }
+}
+try { for (const k in o) with (k) x(); } catch ({message}) { } finally { do ; while (0) }
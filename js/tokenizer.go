@@ -0,0 +1,218 @@
+package js
+
+import (
+	"unicode/utf8"
+
+	"github.com/tdewolff/parse/v2"
+)
+
+// Trivia is a whitespace, line terminator, or comment token surrounding a significant token.
+type Trivia struct {
+	TokenType
+	Data   []byte
+	Offset int
+}
+
+// Token is a significant token with its position and its surrounding trivia. Leading trivia are all trivia since the trailing trivia of the previous token, trailing trivia are the trivia on the same line following the token up to and including the first line terminator.
+type Token struct {
+	TokenType
+	Data     []byte
+	Offset   int // byte offset into the input
+	Line     int // 1-based
+	Column   int // 1-based, counted in runes
+	Leading  []Trivia
+	Trailing []Trivia
+}
+
+// Tokenizer is a lexer that yields significant tokens only, attaching positions and trivia (whitespace, line terminators, and comments) to them. It resolves the ambiguity between the division operator and regular expressions by looking at the preceding tokens, which makes it suitable for syntax highlighters and formatters that don't run a full parse.
+type Tokenizer struct {
+	l *Lexer
+	r *parse.Input
+
+	line, col int
+	started   bool
+	next      Token // lookahead token with its leading trivia
+
+	prev    TokenType // previous significant token
+	prevDot bool      // previous significant token is preceded by . or ?.
+
+	parens          []bool // parenthesis is the head of an if, for, while, or with statement
+	braces          []bool // brace opens a block instead of an object literal
+	closedStmtParen bool
+	closedBlock     bool
+}
+
+// NewTokenizer returns a new Tokenizer for a given input.
+func NewTokenizer(r *parse.Input) *Tokenizer {
+	z := &Tokenizer{
+		l:    NewLexer(r),
+		r:    r,
+		line: 1,
+		col:  1,
+		prev: ErrorToken,
+	}
+
+	// process shebang
+	if r.Peek(0) == '#' && r.Peek(1) == '!' {
+		r.Move(2)
+		z.l.consumeSingleLineComment() // consume till end-of-line
+		data := r.Shift()
+		z.next.Leading = append(z.next.Leading, Trivia{CommentToken, data, 0})
+		z.advance(data)
+	}
+	return z
+}
+
+// Err returns the error encountered during tokenization, this is often io.EOF but also other errors can be returned.
+func (z *Tokenizer) Err() error {
+	return z.l.Err()
+}
+
+// Next returns the next significant token. It returns a token of type ErrorToken at the end of the input or when an error was encountered, its leading trivia contain any trailing whitespace or comments of the input. Using Err() one can retrieve the error message.
+func (z *Tokenizer) Next() Token {
+	if !z.started {
+		z.started = true
+		z.readLeading()
+	}
+	t := z.next
+	if t.TokenType == ErrorToken {
+		z.next.Leading = nil
+		return t
+	}
+	z.update(t.TokenType)
+
+	z.next = Token{}
+	for {
+		trivia, ok := z.lex()
+		if !ok {
+			return t
+		}
+		if trivia.TokenType == CommentLineTerminatorToken {
+			// multi-line comments belong to the next token
+			z.next.Leading = append(z.next.Leading, trivia)
+			break
+		}
+		t.Trailing = append(t.Trailing, trivia)
+		if trivia.TokenType == LineTerminatorToken {
+			break
+		}
+	}
+	z.readLeading()
+	return t
+}
+
+// readLeading reads trivia into the lookahead token until a significant token is found.
+func (z *Tokenizer) readLeading() {
+	for {
+		trivia, ok := z.lex()
+		if !ok {
+			return
+		}
+		z.next.Leading = append(z.next.Leading, trivia)
+	}
+}
+
+// lex reads the next token. If the token is trivia it is returned, otherwise it is stored as the lookahead token.
+func (z *Tokenizer) lex() (Trivia, bool) {
+	tt, data := z.l.Next()
+	if (tt == DivToken || tt == DivEqToken) && z.regExpAllowed() {
+		tt, data = z.l.RegExp()
+	}
+	offset := z.r.Offset() - len(data)
+	if tt == WhitespaceToken || tt == LineTerminatorToken || tt == CommentToken || tt == CommentLineTerminatorToken {
+		z.advance(data)
+		return Trivia{tt, data, offset}, true
+	}
+	z.next.TokenType = tt
+	z.next.Data = data
+	z.next.Offset = offset
+	z.next.Line = z.line
+	z.next.Column = z.col
+	z.advance(data)
+	return Trivia{}, false
+}
+
+// advance updates the line and column for the given consumed bytes.
+func (z *Tokenizer) advance(b []byte) {
+	for i := 0; i < len(b); {
+		c := b[i]
+		if c == '\n' || c == '\r' {
+			if c == '\r' && i+1 < len(b) && b[i+1] == '\n' {
+				i++
+			}
+			z.line++
+			z.col = 1
+			i++
+		} else if c < 0x80 {
+			z.col++
+			i++
+		} else {
+			r, n := utf8.DecodeRune(b[i:])
+			if r == '\u2028' || r == '\u2029' {
+				z.line++
+				z.col = 1
+			} else {
+				z.col++
+			}
+			i += n
+		}
+	}
+}
+
+// update keeps track of the context of parentheses and braces, which is needed to distinguish regular expressions from divisions.
+func (z *Tokenizer) update(tt TokenType) {
+	switch tt {
+	case OpenParenToken:
+		stmt := !z.prevDot && (z.prev == IfToken || z.prev == ForToken || z.prev == WhileToken || z.prev == WithToken)
+		z.parens = append(z.parens, stmt)
+	case CloseParenToken:
+		z.closedStmtParen = false
+		if 0 < len(z.parens) {
+			z.closedStmtParen = z.parens[len(z.parens)-1]
+			z.parens = z.parens[:len(z.parens)-1]
+		}
+	case OpenBraceToken:
+		z.braces = append(z.braces, z.opensBlock())
+	case CloseBraceToken:
+		z.closedBlock = true
+		if 0 < len(z.braces) {
+			z.closedBlock = z.braces[len(z.braces)-1]
+			z.braces = z.braces[:len(z.braces)-1]
+		}
+	}
+	z.prevDot = z.prev == DotToken || z.prev == OptChainToken
+	z.prev = tt
+}
+
+// opensBlock returns true if an opening brace following the previous token starts a block statement, class body, or function body rather than an object literal.
+func (z *Tokenizer) opensBlock() bool {
+	if z.prevDot {
+		return false
+	}
+	switch z.prev {
+	case ErrorToken, SemicolonToken, OpenBraceToken, CloseBraceToken, CloseParenToken, ArrowToken, ElseToken, DoToken, TryToken, FinallyToken:
+		return true
+	case ColonToken:
+		// labelled statement or case clause versus property value
+		return len(z.braces) == 0 || z.braces[len(z.braces)-1]
+	}
+	return IsIdentifier(z.prev) // class name or heritage
+}
+
+// regExpAllowed returns true if a / or /= following the previous token starts a regular expression.
+func (z *Tokenizer) regExpAllowed() bool {
+	switch z.prev {
+	case ErrorToken:
+		return true
+	case CloseParenToken:
+		return z.closedStmtParen
+	case CloseBraceToken:
+		return z.closedBlock
+	case CloseBracketToken, IncrToken, DecrToken, StringToken, RegExpToken, TemplateToken, TemplateEndToken, PrivateIdentifierToken, ThisToken, SuperToken, NullToken, TrueToken, FalseToken:
+		return false
+	}
+	if IsNumeric(z.prev) || IsIdentifier(z.prev) || z.prevDot && IsIdentifierName(z.prev) {
+		return false
+	}
+	return true
+}
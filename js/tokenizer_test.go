@@ -0,0 +1,116 @@
+package js
+
+import (
+	"io"
+	"testing"
+
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/test"
+)
+
+func TestTokenizer(t *testing.T) {
+	var tests = []struct {
+		js       string
+		expected []TokenType
+	}{
+		{"a = /re/g", TTs{IdentifierToken, EqToken, RegExpToken}},
+		{"a / b / c", TTs{IdentifierToken, DivToken, IdentifierToken, DivToken, IdentifierToken}},
+		{"/re/.test(a)", TTs{RegExpToken, DotToken, IdentifierToken, OpenParenToken, IdentifierToken, CloseParenToken}},
+		{"(a) / 2", TTs{OpenParenToken, IdentifierToken, CloseParenToken, DivToken, DecimalToken}},
+		{"if (a) /re/.exec(b)", TTs{IfToken, OpenParenToken, IdentifierToken, CloseParenToken, RegExpToken, DotToken, IdentifierToken, OpenParenToken, IdentifierToken, CloseParenToken}},
+		{"a[0] /= 2", TTs{IdentifierToken, OpenBracketToken, DecimalToken, CloseBracketToken, DivEqToken, DecimalToken}},
+		{"a++ / 2", TTs{IdentifierToken, IncrToken, DivToken, DecimalToken}},
+		{"return /=/", TTs{ReturnToken, RegExpToken}},
+		{"typeof /re/", TTs{TypeofToken, RegExpToken}},
+		{"this / 2", TTs{ThisToken, DivToken, DecimalToken}},
+		{"a.return / 2", TTs{IdentifierToken, DotToken, ReturnToken, DivToken, DecimalToken}},
+		{"{} /re/", TTs{OpenBraceToken, CloseBraceToken, RegExpToken}},
+		{"x = {} / 2", TTs{IdentifierToken, EqToken, OpenBraceToken, CloseBraceToken, DivToken, DecimalToken}},
+		{"function f() {} /re/", TTs{FunctionToken, IdentifierToken, OpenParenToken, CloseParenToken, OpenBraceToken, CloseBraceToken, RegExpToken}},
+		{"`a${b}` / 2", TTs{TemplateStartToken, IdentifierToken, TemplateEndToken, DivToken, DecimalToken}},
+		{"`${/re/}`", TTs{TemplateStartToken, RegExpToken, TemplateEndToken}},
+		{"a = b\n/re/g.exec(c)", TTs{IdentifierToken, EqToken, IdentifierToken, DivToken, IdentifierToken, DivToken, IdentifierToken, DotToken, IdentifierToken, OpenParenToken, IdentifierToken, CloseParenToken}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.js, func(t *testing.T) {
+			z := NewTokenizer(parse.NewInputString(tt.js))
+			tokens := []TokenType{}
+			for {
+				token := z.Next()
+				if token.TokenType == ErrorToken {
+					test.T(t, z.Err(), io.EOF)
+					break
+				}
+				tokens = append(tokens, token.TokenType)
+			}
+			test.T(t, tokens, tt.expected, "token types must match")
+		})
+	}
+}
+
+func TestTokenizerTrivia(t *testing.T) {
+	js := "#!node\n/* a */ var x = 5; // b\n\n  x\r\n/* c\n */ y"
+	z := NewTokenizer(parse.NewInputString(js))
+
+	var tokens []Token
+	for {
+		token := z.Next()
+		tokens = append(tokens, token)
+		if token.TokenType == ErrorToken {
+			break
+		}
+	}
+	test.T(t, len(tokens), 8)
+
+	var_ := tokens[0]
+	test.T(t, var_.TokenType, VarToken)
+	test.T(t, var_.Offset, 15)
+	test.T(t, var_.Line, 2)
+	test.T(t, var_.Column, 9)
+	test.T(t, len(var_.Leading), 4)
+	test.String(t, string(var_.Leading[0].Data), "#!node")
+	test.T(t, var_.Leading[2].TokenType, CommentToken)
+	test.T(t, var_.Leading[2].Offset, 7)
+	test.T(t, len(var_.Trailing), 1)
+
+	semicolon := tokens[4]
+	test.T(t, semicolon.TokenType, SemicolonToken)
+	test.T(t, len(semicolon.Trailing), 3)
+	test.String(t, string(semicolon.Trailing[1].Data), "// b")
+	test.T(t, semicolon.Trailing[2].TokenType, LineTerminatorToken)
+
+	x := tokens[5]
+	test.T(t, x.Line, 4)
+	test.T(t, x.Column, 3)
+	test.T(t, len(x.Leading), 1)
+	test.T(t, len(x.Trailing), 1)
+
+	y := tokens[6]
+	test.T(t, y.Line, 6)
+	test.T(t, y.Column, 5)
+	test.T(t, len(y.Leading), 2)
+	test.T(t, y.Leading[0].TokenType, CommentLineTerminatorToken)
+
+	// trivia and tokens cover the entire input
+	s := ""
+	for _, token := range tokens {
+		for _, trivia := range token.Leading {
+			s += string(trivia.Data)
+		}
+		s += string(token.Data)
+		for _, trivia := range token.Trailing {
+			s += string(trivia.Data)
+		}
+	}
+	test.String(t, s, js)
+}
+
+func TestTokenizerError(t *testing.T) {
+	z := NewTokenizer(parse.NewInputString("a\n@"))
+	test.T(t, z.Next().TokenType, IdentifierToken)
+	token := z.Next()
+	test.T(t, token.TokenType, ErrorToken)
+	test.T(t, z.Err().(*parse.Error).Message, "unexpected @")
+	test.T(t, z.Next().TokenType, ErrorToken)
+}
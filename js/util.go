@@ -1,5 +1,10 @@
 package js
 
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
 // AsIdentifierName returns true if a valid identifier name is given.
 func AsIdentifierName(b []byte) bool {
 	if len(b) == 0 || !identifierStartTable[b[0]] {
@@ -36,3 +41,99 @@ func AsDecimalLiteral(b []byte) bool {
 	}
 	return i == len(b)
 }
+
+// DecodeIdentifier returns the identifier name with its unicode escape sequences (\uXXXX and \u{X}) replaced by their UTF-8 encoded code points. It returns the given slice if there are no escape sequences. Two spellings of an identifier refer to the same binding when their decoded names are equal.
+func DecodeIdentifier(b []byte) []byte {
+	i := bytes.IndexByte(b, '\\')
+	if i == -1 {
+		return b
+	}
+
+	name := make([]byte, 0, len(b))
+	for i != -1 {
+		name = append(name, b[:i]...)
+		r, n := decodeUnicodeEscape(b[i:])
+		if n == 0 {
+			// invalid escape sequence, keep as is
+			name = append(name, '\\')
+			n = 1
+		} else {
+			var buf [utf8.UTFMax]byte
+			name = append(name, buf[:utf8.EncodeRune(buf[:], r)]...)
+		}
+		b = b[i+n:]
+		i = bytes.IndexByte(b, '\\')
+	}
+	return append(name, b...)
+}
+
+// equalIdentifier returns true if both identifier names are equal after decoding their unicode escape sequences.
+func equalIdentifier(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	} else if bytes.IndexByte(a, '\\') == -1 && bytes.IndexByte(b, '\\') == -1 {
+		return false
+	}
+	return bytes.Equal(DecodeIdentifier(a), DecodeIdentifier(b))
+}
+
+// decodeUnicodeEscape decodes a \uXXXX or \u{X} escape sequence at the start of b and returns its code point and length, or a zero length if it is invalid.
+func decodeUnicodeEscape(b []byte) (rune, int) {
+	if len(b) < 6 || b[0] != '\\' || b[1] != 'u' {
+		return utf8.RuneError, 0
+	}
+	i, end := 2, 6
+	if b[2] == '{' {
+		i, end = 3, bytes.IndexByte(b, '}')
+		if end < 4 {
+			return utf8.RuneError, 0
+		}
+	}
+
+	var r rune
+	for _, c := range b[i:end] {
+		if '0' <= c && c <= '9' {
+			c -= '0'
+		} else if 'a' <= c && c <= 'f' {
+			c -= 'a' - 10
+		} else if 'A' <= c && c <= 'F' {
+			c -= 'A' - 10
+		} else {
+			return utf8.RuneError, 0
+		}
+		if r = r<<4 | rune(c); utf8.MaxRune < r {
+			return utf8.RuneError, 0
+		}
+	}
+	if b[2] == '{' {
+		end++
+	}
+	return r, end
+}
+
+// isUseStrict returns true if the directive is "use strict" or 'use strict' without escapes.
+func isUseStrict(directive []byte) bool {
+	return len(directive) == 12 && string(directive[1:11]) == "use strict"
+}
+
+// isStrictReservedWord returns true for identifiers that are reserved words in strict mode code.
+func isStrictReservedWord(name []byte) bool {
+	switch string(name) {
+	case "implements", "interface", "let", "package", "private", "protected", "public", "static", "yield":
+		return true
+	}
+	return false
+}
+
+// hasOctalEscape returns true if the string literal contains a legacy octal escape sequence or \8 or \9.
+func hasOctalEscape(s []byte) bool {
+	for i := 0; i+1 < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			if '1' <= s[i] && s[i] <= '9' || s[i] == '0' && i+1 < len(s) && '0' <= s[i+1] && s[i+1] <= '9' {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -24,3 +24,11 @@ func TestAsDecimalLiteral(t *testing.T) {
 	test.That(t, AsDecimalLiteral([]byte("0")))
 	test.That(t, !AsDecimalLiteral([]byte("00")))
 }
+
+func TestDecodeIdentifier(t *testing.T) {
+	test.String(t, string(DecodeIdentifier([]byte("abc"))), "abc")
+	test.String(t, string(DecodeIdentifier([]byte("\\u0061bc"))), "abc")
+	test.String(t, string(DecodeIdentifier([]byte("a\\u{62}\\u{000063}"))), "abc")
+	test.String(t, string(DecodeIdentifier([]byte("\\u00e9\\u{1D49C}"))), "\u00e9\U0001D49C")
+	test.String(t, string(DecodeIdentifier([]byte("a\\u{110000}"))), "a\\u{110000}")
+}
@@ -42,6 +42,20 @@ func TestWalk(t *testing.T) {
 		test.String(t, ast.JS(), "if (true) { for (i = 0; i < 1; i++) { obj.y = i; }; }; ")
 	})
 }
+func TestWalkClass(t *testing.T) {
+	js := `class A { a = x; f() { return x } }`
+
+	ast, err := Parse(parse.NewInputString(js))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Walk(&walker{}, ast)
+
+	t.Run("TestWalkClass", func(t *testing.T) {
+		test.String(t, ast.JS(), "class A { a = obj; f () { return obj; }; }; ")
+	})
+}
 
 func TestWalkNilNode(t *testing.T) {
 	nodes := []INode{